@@ -57,9 +57,17 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Bastion.AMI = restored.Spec.Bastion.AMI
 	dst.Spec.Bastion.DisableIngressRules = restored.Spec.Bastion.DisableIngressRules
 	dst.Spec.Bastion.InstanceType = restored.Spec.Bastion.InstanceType
+	dst.Spec.Bastion.Mode = restored.Spec.Bastion.Mode
+	dst.Spec.Bastion.ImageLookupFormat = restored.Spec.Bastion.ImageLookupFormat
+	dst.Spec.Bastion.ImageLookupOrg = restored.Spec.Bastion.ImageLookupOrg
+	dst.Spec.Bastion.ImageLookupBaseOS = restored.Spec.Bastion.ImageLookupBaseOS
+	dst.Spec.Bastion.UserData = restored.Spec.Bastion.UserData
+	dst.Spec.SSHAccess = restored.Spec.SSHAccess
+	dst.Spec.ManagedSSHKeyPair = restored.Spec.ManagedSSHKeyPair
 	dst.Spec.ImageLookupFormat = restored.Spec.ImageLookupFormat
 	dst.Spec.ImageLookupOrg = restored.Spec.ImageLookupOrg
 	dst.Spec.ImageLookupBaseOS = restored.Spec.ImageLookupBaseOS
+	dst.Spec.DedicatedHosts = restored.Spec.DedicatedHosts
 
 	// If src ControlPlaneLoadBalancer is nil, do not copy restored ControlPlaneLoadBalancer into it.
 	if src.Spec.ControlPlaneLoadBalancer != nil {
@@ -74,6 +82,9 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.NetworkSpec.CNI = restored.Spec.NetworkSpec.CNI
 	dst.Status.FailureDomains = restored.Status.FailureDomains
+	dst.Status.DedicatedHosts = restored.Status.DedicatedHosts
+	dst.Status.BastionEndpoint = restored.Status.BastionEndpoint
+	dst.Status.BastionMode = restored.Status.BastionMode
 	dst.Status.Network.APIServerELB.AvailabilityZones = restored.Status.Network.APIServerELB.AvailabilityZones
 	dst.Status.Network.APIServerELB.Attributes.CrossZoneLoadBalancing = restored.Status.Network.APIServerELB.Attributes.CrossZoneLoadBalancing
 	dst.Spec.NetworkSpec.SecurityGroupOverrides = restored.Spec.NetworkSpec.SecurityGroupOverrides
@@ -115,6 +126,12 @@ func restoreInstance(restored, dst *infrav1alpha3.Instance) {
 		}
 
 		dst.Tenancy = restored.Tenancy
+		dst.CapacityReservationTarget = restored.CapacityReservationTarget
+		dst.HostPlacement = restored.HostPlacement
+		dst.LaunchTemplate = restored.LaunchTemplate
+		dst.AdditionalNetworkInterfaces = restored.AdditionalNetworkInterfaces
+		dst.AttachedNetworkInterfaces = restored.AttachedNetworkInterfaces
+		dst.AttachedVolumes = restored.AttachedVolumes
 	}
 }
 
@@ -86,6 +86,19 @@ func restoreAWSMachineSpec(restored, dst *infrav1alpha3.AWSMachineSpec, src *AWS
 	}
 
 	dst.Tenancy = restored.Tenancy
+	dst.HostPlacement = restored.HostPlacement
+	dst.CapacityReservationTarget = restored.CapacityReservationTarget
+	dst.LaunchTemplate = restored.LaunchTemplate
+	dst.Fleet = restored.Fleet
+	dst.FallbackInstanceTypes = restored.FallbackInstanceTypes
+	dst.FallbackToOtherFailureDomains = restored.FallbackToOtherFailureDomains
+	dst.AdditionalNetworkInterfaces = restored.AdditionalNetworkInterfaces
+	dst.ElasticIP = restored.ElasticIP
+	dst.StoppedInstanceRecoveryPolicy = restored.StoppedInstanceRecoveryPolicy
+	dst.TerminationProtection = restored.TerminationProtection
+	dst.DeletionPolicy = restored.DeletionPolicy
+	dst.AllowVolumeExpansion = restored.AllowVolumeExpansion
+	dst.AMI.SSMParameter = restored.AMI.SSMParameter
 
 	if restored.CloudInit.SecureSecretsBackend != "" {
 		if src.CloudInit != nil {
@@ -96,6 +109,10 @@ func restoreAWSMachineSpec(restored, dst *infrav1alpha3.AWSMachineSpec, src *AWS
 
 func restoreAWSMachineStatus(restored, dst *infrav1alpha3.AWSMachineStatus) {
 	dst.Interruptible = restored.Interruptible
+	dst.InstanceType = restored.InstanceType
+	dst.AvailabilityZone = restored.AvailabilityZone
+	dst.NetworkInterfaces = restored.NetworkInterfaces
+	dst.TerminationProtection = restored.TerminationProtection
 }
 
 // ConvertFrom converts from the Hub version (v1alpha3) to this version.
@@ -259,3 +276,14 @@ func Convert_v1alpha3_CloudInit_To_v1alpha2_CloudInit(in *infrav1alpha3.CloudIni
 
 	return nil
 }
+
+// Convert_v1alpha2_AWSResourceReference_To_v1alpha3_AMIReference converts this AWSResourceReference to the Hub version (v1alpha3) AMIReference.
+func Convert_v1alpha2_AWSResourceReference_To_v1alpha3_AMIReference(in *AWSResourceReference, out *infrav1alpha3.AMIReference, s apiconversion.Scope) error {
+	return Convert_v1alpha2_AWSResourceReference_To_v1alpha3_AWSResourceReference(in, &out.AWSResourceReference, s)
+}
+
+// Convert_v1alpha3_AMIReference_To_v1alpha2_AWSResourceReference converts from the Hub version (v1alpha3) AMIReference to this AWSResourceReference.
+// Requires manual conversion as infrav1alpha3.AMIReference.SSMParameter does not exist in AWSResourceReference.
+func Convert_v1alpha3_AMIReference_To_v1alpha2_AWSResourceReference(in *infrav1alpha3.AMIReference, out *AWSResourceReference, s apiconversion.Scope) error {
+	return Convert_v1alpha3_AWSResourceReference_To_v1alpha2_AWSResourceReference(&in.AWSResourceReference, out, s)
+}
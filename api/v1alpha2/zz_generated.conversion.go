@@ -264,6 +264,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*AWSResourceReference)(nil), (*v1alpha3.AMIReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AWSResourceReference_To_v1alpha3_AMIReference(a.(*AWSResourceReference), b.(*v1alpha3.AMIReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*CloudInit)(nil), (*v1alpha3.CloudInit)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CloudInit_To_v1alpha3_CloudInit(a.(*CloudInit), b.(*v1alpha3.CloudInit), scope)
 	}); err != nil {
@@ -274,6 +279,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.AMIReference)(nil), (*AWSResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_AMIReference_To_v1alpha2_AWSResourceReference(a.(*v1alpha3.AMIReference), b.(*AWSResourceReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.AWSClusterSpec)(nil), (*AWSClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_AWSClusterSpec_To_v1alpha2_AWSClusterSpec(a.(*v1alpha3.AWSClusterSpec), b.(*AWSClusterSpec), scope)
 	}); err != nil {
@@ -451,6 +461,7 @@ func autoConvert_v1alpha3_AWSClusterSpec_To_v1alpha2_AWSClusterSpec(in *v1alpha3
 	// WARNING: in.ImageLookupOrg requires manual conversion: does not exist in peer-type
 	// WARNING: in.ImageLookupBaseOS requires manual conversion: does not exist in peer-type
 	// WARNING: in.Bastion requires manual conversion: does not exist in peer-type
+	// WARNING: in.DedicatedHosts requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -471,7 +482,10 @@ func autoConvert_v1alpha3_AWSClusterStatus_To_v1alpha2_AWSClusterStatus(in *v1al
 	}
 	// WARNING: in.FailureDomains requires manual conversion: does not exist in peer-type
 	// WARNING: in.Bastion requires manual conversion: inconvertible types (*sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3.Instance vs sigs.k8s.io/cluster-api-provider-aws/api/v1alpha2.Instance)
+	// WARNING: in.BastionEndpoint requires manual conversion: does not exist in peer-type
+	// WARNING: in.DedicatedHosts requires manual conversion: does not exist in peer-type
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
+	// WARNING: in.BastionMode requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -569,7 +583,7 @@ func Convert_v1alpha3_AWSMachineList_To_v1alpha2_AWSMachineList(in *v1alpha3.AWS
 
 func autoConvert_v1alpha2_AWSMachineSpec_To_v1alpha3_AWSMachineSpec(in *AWSMachineSpec, out *v1alpha3.AWSMachineSpec, s conversion.Scope) error {
 	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
-	if err := Convert_v1alpha2_AWSResourceReference_To_v1alpha3_AWSResourceReference(&in.AMI, &out.AMI, s); err != nil {
+	if err := Convert_v1alpha2_AWSResourceReference_To_v1alpha3_AMIReference(&in.AMI, &out.AMI, s); err != nil {
 		return err
 	}
 	out.ImageLookupOrg = in.ImageLookupOrg
@@ -592,16 +606,19 @@ func autoConvert_v1alpha2_AWSMachineSpec_To_v1alpha3_AWSMachineSpec(in *AWSMachi
 func autoConvert_v1alpha3_AWSMachineSpec_To_v1alpha2_AWSMachineSpec(in *v1alpha3.AWSMachineSpec, out *AWSMachineSpec, s conversion.Scope) error {
 	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
 	// WARNING: in.InstanceID requires manual conversion: does not exist in peer-type
-	if err := Convert_v1alpha3_AWSResourceReference_To_v1alpha2_AWSResourceReference(&in.AMI, &out.AMI, s); err != nil {
+	if err := Convert_v1alpha3_AMIReference_To_v1alpha2_AWSResourceReference(&in.AMI, &out.AMI, s); err != nil {
 		return err
 	}
 	// WARNING: in.ImageLookupFormat requires manual conversion: does not exist in peer-type
 	out.ImageLookupOrg = in.ImageLookupOrg
 	// WARNING: in.ImageLookupBaseOS requires manual conversion: does not exist in peer-type
 	out.InstanceType = in.InstanceType
+	// WARNING: in.FallbackInstanceTypes requires manual conversion: does not exist in peer-type
+	// WARNING: in.FallbackToOtherFailureDomains requires manual conversion: does not exist in peer-type
 	out.AdditionalTags = *(*Tags)(unsafe.Pointer(&in.AdditionalTags))
 	out.IAMInstanceProfile = in.IAMInstanceProfile
 	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
+	// WARNING: in.ElasticIP requires manual conversion: does not exist in peer-type
 	out.AdditionalSecurityGroups = *(*[]AWSResourceReference)(unsafe.Pointer(&in.AdditionalSecurityGroups))
 	// WARNING: in.FailureDomain requires manual conversion: does not exist in peer-type
 	out.Subnet = (*AWSResourceReference)(unsafe.Pointer(in.Subnet))
@@ -610,11 +627,20 @@ func autoConvert_v1alpha3_AWSMachineSpec_To_v1alpha2_AWSMachineSpec(in *v1alpha3
 	}
 	// WARNING: in.RootVolume requires manual conversion: does not exist in peer-type
 	// WARNING: in.NonRootVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.AllowVolumeExpansion requires manual conversion: does not exist in peer-type
 	out.NetworkInterfaces = *(*[]string)(unsafe.Pointer(&in.NetworkInterfaces))
+	// WARNING: in.AdditionalNetworkInterfaces requires manual conversion: does not exist in peer-type
 	// WARNING: in.UncompressedUserData requires manual conversion: does not exist in peer-type
 	// WARNING: in.CloudInit requires manual conversion: inconvertible types (sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3.CloudInit vs *sigs.k8s.io/cluster-api-provider-aws/api/v1alpha2.CloudInit)
 	// WARNING: in.SpotMarketOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.Fleet requires manual conversion: does not exist in peer-type
 	// WARNING: in.Tenancy requires manual conversion: does not exist in peer-type
+	// WARNING: in.HostPlacement requires manual conversion: does not exist in peer-type
+	// WARNING: in.CapacityReservationTarget requires manual conversion: does not exist in peer-type
+	// WARNING: in.LaunchTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.StoppedInstanceRecoveryPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.TerminationProtection requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -630,8 +656,12 @@ func autoConvert_v1alpha2_AWSMachineStatus_To_v1alpha3_AWSMachineStatus(in *AWSM
 func autoConvert_v1alpha3_AWSMachineStatus_To_v1alpha2_AWSMachineStatus(in *v1alpha3.AWSMachineStatus, out *AWSMachineStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	// WARNING: in.Interruptible requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceType requires manual conversion: does not exist in peer-type
+	// WARNING: in.AvailabilityZone requires manual conversion: does not exist in peer-type
 	out.Addresses = *(*[]apiv1alpha2.MachineAddress)(unsafe.Pointer(&in.Addresses))
+	// WARNING: in.NetworkInterfaces requires manual conversion: does not exist in peer-type
 	out.InstanceState = (*InstanceState)(unsafe.Pointer(in.InstanceState))
+	// WARNING: in.TerminationProtection requires manual conversion: does not exist in peer-type
 	// WARNING: in.FailureReason requires manual conversion: does not exist in peer-type
 	// WARNING: in.FailureMessage requires manual conversion: does not exist in peer-type
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
@@ -1020,10 +1050,16 @@ func autoConvert_v1alpha3_Instance_To_v1alpha2_Instance(in *v1alpha3.Instance, o
 	// WARNING: in.RootVolume requires manual conversion: does not exist in peer-type
 	// WARNING: in.NonRootVolumes requires manual conversion: does not exist in peer-type
 	out.NetworkInterfaces = *(*[]string)(unsafe.Pointer(&in.NetworkInterfaces))
+	// WARNING: in.AdditionalNetworkInterfaces requires manual conversion: does not exist in peer-type
+	// WARNING: in.AttachedNetworkInterfaces requires manual conversion: does not exist in peer-type
+	// WARNING: in.AttachedVolumes requires manual conversion: does not exist in peer-type
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	// WARNING: in.AvailabilityZone requires manual conversion: does not exist in peer-type
 	// WARNING: in.SpotMarketOptions requires manual conversion: does not exist in peer-type
 	// WARNING: in.Tenancy requires manual conversion: does not exist in peer-type
+	// WARNING: in.CapacityReservationTarget requires manual conversion: does not exist in peer-type
+	// WARNING: in.HostPlacement requires manual conversion: does not exist in peer-type
+	// WARNING: in.LaunchTemplate requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -39,6 +39,25 @@ type AWSClusterSpec struct {
 	// +optional
 	SSHKeyName *string `json:"sshKeyName,omitempty"`
 
+	// SSHAccess selects how SSH access to the bastion host and the machines of the cluster is granted.
+	// KeyPair launches the instances with the EC2 key pair named by SSHKeyName.
+	// InstanceConnect launches the bastion host and the machines that set no SSH key name themselves
+	// without a key pair, and relies on EC2 Instance Connect pushing short-lived SSH public keys to the
+	// instances instead. It requires AMIs with EC2 Instance Connect installed, and SSHKeyName to be
+	// empty or omitted.
+	// Defaults to KeyPair.
+	// +kubebuilder:validation:Enum=KeyPair;InstanceConnect
+	// +optional
+	SSHAccess SSHAccessMode `json:"sshAccess,omitempty"`
+
+	// ManagedSSHKeyPair makes the controller manage the EC2 key pair of the cluster, which the bastion host
+	// and the machines that set no SSH key name themselves are launched with. The key pair is either
+	// generated by EC2, in which case its private key is stored in the value key of the
+	// <cluster name>-ssh-key Secret, or imported from a public key. It is deleted with the cluster.
+	// SSHKeyName must be omitted when it is set.
+	// +optional
+	ManagedSSHKeyPair *ManagedSSHKeyPair `json:"managedSSHKeyPair,omitempty"`
+
 	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
 	// +optional
 	ControlPlaneEndpoint clusterv1.APIEndpoint `json:"controlPlaneEndpoint"`
@@ -82,6 +101,11 @@ type AWSClusterSpec struct {
 	// Bastion contains options to configure the bastion host.
 	// +optional
 	Bastion Bastion `json:"bastion"`
+
+	// DedicatedHosts configures a pool of Dedicated Hosts allocated for the cluster, on which
+	// machines with host tenancy run.
+	// +optional
+	DedicatedHosts *DedicatedHostPool `json:"dedicatedHosts,omitempty"`
 }
 
 type Bastion struct {
@@ -106,9 +130,94 @@ type Bastion struct {
 	InstanceType string `json:"instanceType,omitempty"`
 
 	// AMI will use the specified AMI to boot the bastion. If not specified,
-	// the AMI will default to one picked out in public space.
+	// the AMI will default to one picked out in public space, or to the one
+	// looked up with the image lookup fields if any is set.
 	// +optional
 	AMI string `json:"ami,omitempty"`
+
+	// ImageLookupFormat is the AMI naming format to look up the bastion AMI with when AMI is not set.
+	// It is used like the image lookup format of AWSMachines, except that {{.K8sVersion}} is empty.
+	// Defaults to capa-ami-{{.BaseOS}}-* when ImageLookupOrg or ImageLookupBaseOS is set.
+	// +optional
+	ImageLookupFormat string `json:"imageLookupFormat,omitempty"`
+
+	// ImageLookupOrg is the AWS Organization ID to look up the bastion AMI with when AMI is not set.
+	// Defaults to the owner of the AMIs published by the project when ImageLookupFormat or
+	// ImageLookupBaseOS is set.
+	// +optional
+	ImageLookupOrg string `json:"imageLookupOrg,omitempty"`
+
+	// ImageLookupBaseOS is the name of the base operating system to look up the bastion AMI with when
+	// AMI is not set. Defaults to ubuntu-18.04 when ImageLookupFormat or ImageLookupOrg is set.
+	// +optional
+	ImageLookupBaseOS string `json:"imageLookupBaseOS,omitempty"`
+
+	// UserData is the user data of the bastion host instance, replacing the default script that installs
+	// the bastion tooling from the internet. It is passed to the instance as is, so it can be any document
+	// the AMI understands, such as a cloud-init or an Ignition document.
+	// +optional
+	UserData *BastionUserData `json:"userData,omitempty"`
+
+	// Mode selects how the private network of the VPC is accessed when the bastion is enabled.
+	// Instance creates a bastion host instance with a public IP address that accepts SSH connections.
+	// SessionManager creates no instance, and instead ensures the VPC endpoints required by the
+	// AWS Systems Manager Session Manager exist, so nodes can be reached through Session Manager
+	// sessions without any public entry point.
+	// AutoScalingGroup runs the bastion host instance in an Auto Scaling group of one instance spanning
+	// all public subnets, and keeps an Elastic IP address associated with the instance of the group,
+	// so the bastion survives the loss of its instance or availability zone at the same address.
+	// Defaults to Instance.
+	// +kubebuilder:validation:Enum=Instance;SessionManager;AutoScalingGroup
+	// +optional
+	Mode BastionMode `json:"mode,omitempty"`
+}
+
+// BastionUserData references the user data of the bastion host instance. Exactly one of its fields must be set.
+type BastionUserData struct {
+	// SecretName is the name of a Secret in the namespace of the cluster holding the user data in its
+	// value key.
+	// +optional
+	SecretName *string `json:"secretName,omitempty"`
+
+	// Value is the inline user data.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// BastionMode defines how the bastion provides access to the private network of the VPC.
+type BastionMode string
+
+var (
+	// BastionModeInstance creates a bastion host instance with a public IP address.
+	BastionModeInstance = BastionMode("Instance")
+
+	// BastionModeSessionManager creates the VPC endpoints of the AWS Systems Manager Session Manager
+	// instead of a bastion host instance.
+	BastionModeSessionManager = BastionMode("SessionManager")
+
+	// BastionModeAutoScalingGroup runs the bastion host instance in an Auto Scaling group with an Elastic IP address.
+	BastionModeAutoScalingGroup = BastionMode("AutoScalingGroup")
+)
+
+// SSHAccessMode defines how SSH access to the instances of a cluster is granted.
+type SSHAccessMode string
+
+var (
+	// SSHAccessModeKeyPair launches the instances with an EC2 key pair.
+	SSHAccessModeKeyPair = SSHAccessMode("KeyPair")
+
+	// SSHAccessModeInstanceConnect launches the instances without an EC2 key pair, and relies on
+	// EC2 Instance Connect to push short-lived SSH public keys to them.
+	SSHAccessModeInstanceConnect = SSHAccessMode("InstanceConnect")
+)
+
+// ManagedSSHKeyPair configures the EC2 key pair the controller manages for a cluster.
+type ManagedSSHKeyPair struct {
+	// PublicKeySecretName is the name of a Secret in the namespace of the cluster holding, in its value
+	// key, the SSH public key to import as the key pair of the cluster. If omitted, the key pair is
+	// generated by EC2.
+	// +optional
+	PublicKeySecretName *string `json:"publicKeySecretName,omitempty"`
 }
 
 // AWSLoadBalancerSpec defines the desired state of an AWS load balancer
@@ -143,11 +252,16 @@ type AWSLoadBalancerSpec struct {
 // AWSClusterStatus defines the observed state of AWSCluster
 type AWSClusterStatus struct {
 	// +kubebuilder:default=false
-	Ready          bool                     `json:"ready"`
-	Network        Network                  `json:"network,omitempty"`
-	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
-	Bastion        *Instance                `json:"bastion,omitempty"`
-	Conditions     clusterv1.Conditions     `json:"conditions,omitempty"`
+	Ready           bool                     `json:"ready"`
+	Network         Network                  `json:"network,omitempty"`
+	FailureDomains  clusterv1.FailureDomains `json:"failureDomains,omitempty"`
+	Bastion         *Instance                `json:"bastion,omitempty"`
+	BastionEndpoint string                   `json:"bastionEndpoint,omitempty"`
+	DedicatedHosts  []DedicatedHost          `json:"dedicatedHosts,omitempty"`
+	Conditions      clusterv1.Conditions     `json:"conditions,omitempty"`
+
+	// BastionMode is the mode of the bastion that was last reconciled.
+	BastionMode BastionMode `json:"bastionMode,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -51,6 +51,9 @@ func (r *AWSCluster) ValidateCreate() error {
 
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.validateSSHKeyName()...)
+	allErrs = append(allErrs, r.Spec.SSHAccess.Validate(r.Spec.SSHKeyName)...)
+	allErrs = append(allErrs, r.Spec.ManagedSSHKeyPair.Validate(r.Spec.SSHKeyName, r.Spec.SSHAccess)...)
+	allErrs = append(allErrs, r.Spec.DedicatedHosts.Validate(field.NewPath("spec", "dedicatedHosts"))...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -96,7 +99,25 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 		)
 	}
 
+	// The key pair of the cluster is only created and deleted along with the cluster.
+	if !reflect.DeepEqual(oldC.Spec.ManagedSSHKeyPair, r.Spec.ManagedSSHKeyPair) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "managedSSHKeyPair"), r.Spec.ManagedSSHKeyPair, "field is immutable"),
+		)
+	}
+
+	// The hosts of the pool cannot be changed to support other instances once allocated.
+	if oldC.Spec.DedicatedHosts != nil && r.Spec.DedicatedHosts != nil &&
+		(oldC.Spec.DedicatedHosts.InstanceType != r.Spec.DedicatedHosts.InstanceType || oldC.Spec.DedicatedHosts.InstanceFamily != r.Spec.DedicatedHosts.InstanceFamily) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "dedicatedHosts"), r.Spec.DedicatedHosts, "instanceType and instanceFamily are immutable"),
+		)
+	}
+
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
+	allErrs = append(allErrs, r.Spec.SSHAccess.Validate(r.Spec.SSHKeyName)...)
+	allErrs = append(allErrs, r.Spec.ManagedSSHKeyPair.Validate(r.Spec.SSHKeyName, r.Spec.SSHAccess)...)
+	allErrs = append(allErrs, r.Spec.DedicatedHosts.Validate(field.NewPath("spec", "dedicatedHosts"))...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/gomega"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +34,69 @@ func TestAWSCluster_ValidateCreate(t *testing.T) {
 		wantErr bool
 	}{
 		// The SSHKeyName tests were moved to sshkeyname_test.go
+		{
+			name: "dedicated host pool requires an instance type or family",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: &DedicatedHostPool{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dedicated host pool can't have both an instance type and family",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: &DedicatedHostPool{
+						InstanceType:   "m5.large",
+						InstanceFamily: "m5",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dedicated host pool with an instance family",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: &DedicatedHostPool{
+						InstanceFamily: "m5",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "managed SSH key pair imported from a secret",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ManagedSSHKeyPair: &ManagedSSHKeyPair{
+						PublicKeySecretName: aws.String("ssh-public-key"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "managed SSH key pair not allowed with an SSH key name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SSHKeyName:        aws.String("my-key"),
+					ManagedSSHKeyPair: &ManagedSSHKeyPair{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "managed SSH key pair not allowed with EC2 Instance Connect ssh access",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SSHAccess:         SSHAccessModeInstanceConnect,
+					ManagedSSHKeyPair: &ManagedSSHKeyPair{},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -126,6 +190,55 @@ func TestAWSCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "dedicated host pool instance type is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: &DedicatedHostPool{
+						InstanceType: "m5.large",
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: &DedicatedHostPool{
+						InstanceType: "m5.xlarge",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dedicated host pool hosts per availability zone is mutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: &DedicatedHostPool{
+						InstanceType: "m5.large",
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DedicatedHosts: &DedicatedHostPool{
+						InstanceType:             "m5.large",
+						HostsPerAvailabilityZone: 2,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "managed SSH key pair is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ManagedSSHKeyPair: &ManagedSSHKeyPair{},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "controlPlaneEndpoint can be updated if it is empty",
 			oldCluster: &AWSCluster{
@@ -350,6 +463,72 @@ func TestAWSCluster_ValidateAllowedCIDRBlocks(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "session manager mode allowed without instance settings",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled: true,
+						Mode:    BastionModeSessionManager,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "session manager mode not allowed with an instance type",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled:      true,
+						Mode:         BastionModeSessionManager,
+						InstanceType: "t3.micro",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "user data allowed from a secret",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled: true,
+						UserData: &BastionUserData{
+							SecretName: aws.String("bastion-user-data"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "user data not allowed from both a secret and a value",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled: true,
+						UserData: &BastionUserData{
+							SecretName: aws.String("bastion-user-data"),
+							Value:      aws.String("#cloud-config"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "user data not allowed empty",
+			awsc: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion: Bastion{
+						Enabled:  true,
+						UserData: &BastionUserData{},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -26,6 +26,30 @@ const (
 	// MachineFinalizer allows ReconcileAWSMachine to clean up AWS resources associated with AWSMachine before
 	// removing it from the apiserver.
 	MachineFinalizer = "awsmachine.infrastructure.cluster.x-k8s.io"
+
+	// PowerStateAnnotation can be set on an AWSMachine to intentionally stop or start its instance,
+	// using PowerStateStopped or PowerStateRunning as value. An instance stopped this way is not
+	// treated as failed and is not subject to the StoppedInstanceRecoveryPolicy.
+	PowerStateAnnotation = "awsmachine.infrastructure.cluster.x-k8s.io/power-state"
+
+	// PowerStateRunning requests the instance of an AWSMachine to be running.
+	PowerStateRunning = "running"
+
+	// PowerStateStopped requests the instance of an AWSMachine to be stopped.
+	PowerStateStopped = "stopped"
+
+	// InterruptionAnnotation is set on an AWSMachine by the instance state controller when EC2 announced that its
+	// instance is going to be interrupted, using InterruptionSpotInterruption or InterruptionRebalanceRecommendation
+	// as value. The AWSMachine of an interrupted instance is then marked as failed so that the Machine gets replaced,
+	// while a rebalance recommendation is only reported by the NoRebalanceRecommendation condition.
+	InterruptionAnnotation = "awsmachine.infrastructure.cluster.x-k8s.io/interruption"
+
+	// InterruptionSpotInterruption means EC2 sent a two-minute warning before reclaiming the spot instance.
+	InterruptionSpotInterruption = "spot-interruption"
+
+	// InterruptionRebalanceRecommendation means EC2 recommended to rebalance the spot instance, as it is at an
+	// elevated risk of being interrupted.
+	InterruptionRebalanceRecommendation = "rebalance-recommendation"
 )
 
 // SecretBackend defines variants for backend secret storage.
@@ -39,6 +63,35 @@ var (
 	SecretBackendSecretsManager = SecretBackend("secrets-manager")
 )
 
+// StoppedInstanceRecoveryPolicy defines what happens when the instance of an AWSMachine is found stopped.
+type StoppedInstanceRecoveryPolicy string
+
+var (
+	// StoppedInstanceRecoveryPolicyLeave leaves the instance stopped and reports it in the InstanceReady condition.
+	StoppedInstanceRecoveryPolicyLeave = StoppedInstanceRecoveryPolicy("Leave")
+
+	// StoppedInstanceRecoveryPolicyStart starts the instance again.
+	StoppedInstanceRecoveryPolicyStart = StoppedInstanceRecoveryPolicy("Start")
+
+	// StoppedInstanceRecoveryPolicyFail marks the AWSMachine as failed, so that it can be replaced,
+	// for example by a MachineHealthCheck.
+	StoppedInstanceRecoveryPolicyFail = StoppedInstanceRecoveryPolicy("Fail")
+)
+
+// DeletionPolicy defines what happens to the instance of an AWSMachine when the AWSMachine is deleted.
+type DeletionPolicy string
+
+var (
+	// DeletionPolicyDelete terminates the instance.
+	DeletionPolicyDelete = DeletionPolicy("Delete")
+
+	// DeletionPolicyRetain leaves the instance and its volumes running, and tags them as orphaned.
+	DeletionPolicyRetain = DeletionPolicy("Retain")
+
+	// DeletionPolicyStop stops the instance, and tags it and its volumes as orphaned.
+	DeletionPolicyStop = DeletionPolicy("Stop")
+)
+
 // AWSMachineSpec defines the desired state of AWSMachine
 type AWSMachineSpec struct {
 	// ProviderID is the unique identifier as specified by the cloud provider.
@@ -48,7 +101,9 @@ type AWSMachineSpec struct {
 	InstanceID *string `json:"instanceID,omitempty"`
 
 	// AMI is the reference to the AMI from which to create the machine instance.
-	AMI AWSResourceReference `json:"ami,omitempty"`
+	// If it is not set, or only refers to an SSM parameter, the CPU architecture of the
+	// image is derived from the instance type.
+	AMI AMIReference `json:"ami,omitempty"`
 
 	// ImageLookupFormat is the AMI naming format to look up the image for this
 	// machine It will be ignored if an explicit AMI is set. Supports
@@ -74,6 +129,20 @@ type AWSMachineSpec struct {
 	// InstanceType is the type of instance to create. Example: m4.xlarge
 	InstanceType string `json:"instanceType,omitempty"`
 
+	// FallbackInstanceTypes is an ordered list of instance types to try, after InstanceType,
+	// when EC2 reports InsufficientInstanceCapacity for the requested type. They must share
+	// the CPU architecture of InstanceType, as the AMI is looked up for InstanceType only,
+	// which is checked on creation.
+	// +optional
+	FallbackInstanceTypes []string `json:"fallbackInstanceTypes,omitempty"`
+
+	// FallbackToOtherFailureDomains allows the instance to be launched in a subnet of another
+	// failure domain when none of the instance types have capacity in the selected one. The subnet
+	// is public if the instance gets a public IP or the selected subnet is public, and private otherwise.
+	// It has no effect when a failure domain, a subnet or network interfaces are set explicitly.
+	// +optional
+	FallbackToOtherFailureDomains bool `json:"fallbackToOtherFailureDomains,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to an instance, in addition to the ones added by default by the
 	// AWS provider. If both the AWSCluster and the AWSMachine specify the same tag name with different values, the
 	// AWSMachine's value takes precedence.
@@ -92,6 +161,12 @@ type AWSMachineSpec struct {
 	// +optional
 	PublicIP *bool `json:"publicIP,omitempty"`
 
+	// ElasticIP, if set, associates an Elastic IP with the instance once it is running, so that its
+	// public IP address does not change across stop/start. The Elastic IP is released, or returned
+	// to its pool if it was claimed, when the AWSMachine is deleted.
+	// +optional
+	ElasticIP *ElasticIP `json:"elasticIP,omitempty"`
+
 	// AdditionalSecurityGroups is an array of references to security groups that should be applied to the
 	// instance. These security groups would be set in addition to any security groups defined
 	// at the cluster level or in the actuator. It is possible to specify either IDs of Filters. Using Filters
@@ -121,12 +196,26 @@ type AWSMachineSpec struct {
 	// +optional
 	NonRootVolumes []*Volume `json:"nonRootVolumes,omitempty"`
 
+	// AllowVolumeExpansion allows the size of the root and non-root volumes to be increased after
+	// the instance was created, in which case the volumes are grown in place using ModifyVolume.
+	// Growing the file systems on the volumes is left to the operating system. As EC2 allows a
+	// volume to be modified once every six hours, further size increases wait for that long.
+	// +optional
+	AllowVolumeExpansion bool `json:"allowVolumeExpansion,omitempty"`
+
 	// NetworkInterfaces is a list of ENIs to associate with the instance.
 	// A maximum of 2 may be specified.
 	// +optional
 	// +kubebuilder:validation:MaxItems=2
 	NetworkInterfaces []string `json:"networkInterfaces,omitempty"`
 
+	// AdditionalNetworkInterfaces is a list of network interfaces to create and attach to the instance,
+	// in order, after the primary network interface. They are tagged like the instance and deleted
+	// when the instance is terminated. Cannot be used together with NetworkInterfaces, or with
+	// PublicIP as EC2 does not assign public IP addresses to instances with several network interfaces.
+	// +optional
+	AdditionalNetworkInterfaces []NetworkInterfaceSpec `json:"additionalNetworkInterfaces,omitempty"`
+
 	// UncompressedUserData specify whether the user data is gzip-compressed before it is sent to ec2 instance.
 	// cloud-init has built-in support for gzip-compressed user data
 	// user data stored in aws secret manager is always gzip-compressed.
@@ -143,10 +232,56 @@ type AWSMachineSpec struct {
 	// +optional
 	SpotMarketOptions *SpotMarketOptions `json:"spotMarketOptions,omitempty"`
 
+	// Fleet launches the spot instance through an EC2 Fleet in instant mode, which picks the best
+	// available spot capacity pool among several instance types and subnets. Requires
+	// SpotMarketOptions to be set.
+	// +optional
+	Fleet *FleetOptions `json:"fleet,omitempty"`
+
 	// Tenancy indicates if instance should run on shared or single-tenant hardware.
 	// +optional
 	// +kubebuilder:validation:Enum:=default;dedicated;host
 	Tenancy string `json:"tenancy,omitempty"`
+
+	// HostPlacement selects the Dedicated Host or host resource group the instance runs on.
+	// Requires Tenancy to be host.
+	// +optional
+	HostPlacement *HostPlacement `json:"hostPlacement,omitempty"`
+
+	// CapacityReservationTarget allows the instance to be launched into a specific On-Demand
+	// Capacity Reservation, a Capacity Reservation resource group, or to express an open/none
+	// reservation preference. If omitted, the EC2 default (open) applies.
+	// +optional
+	CapacityReservationTarget *CapacityReservationTarget `json:"capacityReservationTarget,omitempty"`
+
+	// LaunchTemplate refers to an existing launch template the instance is launched from. The
+	// fields of this spec, as well as the subnet, security groups, user data and tags managed by
+	// the controller, override the values of the launch template. The AMI and the instance type
+	// are taken from the launch template unless they are set in this spec. Launch templates that
+	// define network interfaces are not supported.
+	// +optional
+	LaunchTemplate *LaunchTemplateReference `json:"launchTemplate,omitempty"`
+
+	// StoppedInstanceRecoveryPolicy defines what to do when the instance is found stopped, unless it
+	// was stopped through the power-state annotation. Defaults to Leave.
+	// +optional
+	// +kubebuilder:validation:Enum=Leave;Start;Fail
+	StoppedInstanceRecoveryPolicy StoppedInstanceRecoveryPolicy `json:"stoppedInstanceRecoveryPolicy,omitempty"`
+
+	// TerminationProtection enables the termination protection of the instance, which prevents it
+	// from being terminated through the EC2 API. While it is enabled, deleting the AWSMachine with
+	// the Delete deletion policy is refused until the field is unset.
+	// +optional
+	TerminationProtection bool `json:"terminationProtection,omitempty"`
+
+	// DeletionPolicy defines what happens to the instance when the AWSMachine is deleted. With Retain
+	// or Stop the instance, its volumes and its Elastic IP are kept, and their cluster tags are replaced
+	// with an orphaned tag. The instance stays in its subnet and keeps the cluster security groups, so
+	// deleting the cluster is blocked until the instance is terminated or its security groups are
+	// changed. Defaults to Delete.
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Stop
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // CloudInit defines options related to the bootstrapping systems where
@@ -187,13 +322,30 @@ type AWSMachineStatus struct {
 	// +optional
 	Interruptible bool `json:"interruptible,omitempty"`
 
+	// InstanceType is the type of the instance backing this machine. It may differ from
+	// spec.instanceType when one of spec.fallbackInstanceTypes was used.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// AvailabilityZone is the availability zone of the instance backing this machine.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
 	// Addresses contains the AWS instance associated addresses.
 	Addresses []clusterv1.MachineAddress `json:"addresses,omitempty"`
 
+	// NetworkInterfaces reports the network interfaces attached to the AWS instance for this machine.
+	// +optional
+	NetworkInterfaces []NetworkInterfaceStatus `json:"networkInterfaces,omitempty"`
+
 	// InstanceState is the state of the AWS instance for this machine.
 	// +optional
 	InstanceState *InstanceState `json:"instanceState,omitempty"`
 
+	// TerminationProtection reports whether termination protection is enabled on the instance.
+	// +optional
+	TerminationProtection bool `json:"terminationProtection,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
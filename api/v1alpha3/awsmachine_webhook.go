@@ -54,6 +54,15 @@ func (r *AWSMachine) ValidateCreate() error {
 	allErrs = append(allErrs, r.validateNonRootVolumes()...)
 	allErrs = append(allErrs, r.validateSSHKeyName()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, r.validateAdditionalNetworkInterfaces()...)
+	allErrs = append(allErrs, r.Spec.AMI.Validate(field.NewPath("spec", "ami"))...)
+	allErrs = append(allErrs, r.Spec.CapacityReservationTarget.Validate(field.NewPath("spec", "capacityReservationTarget"))...)
+	allErrs = append(allErrs, r.Spec.HostPlacement.Validate(field.NewPath("spec", "hostPlacement"), r.Spec.Tenancy)...)
+	allErrs = append(allErrs, r.Spec.LaunchTemplate.Validate(field.NewPath("spec", "launchTemplate"))...)
+	allErrs = append(allErrs, validateFleetOptions(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateFallbackInstanceTypes(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, r.Spec.ElasticIP.Validate(field.NewPath("spec", "elasticIP"))...)
+	allErrs = append(allErrs, r.validatePowerStateAnnotation()...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -76,6 +85,7 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, r.validateCloudInitSecret()...)
+	allErrs = append(allErrs, r.validatePowerStateAnnotation()...)
 
 	newAWSMachineSpec := newAWSMachine["spec"].(map[string]interface{})
 	oldAWSMachineSpec := oldAWSMachine["spec"].(map[string]interface{})
@@ -96,6 +106,41 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	delete(oldAWSMachineSpec, "additionalSecurityGroups")
 	delete(newAWSMachineSpec, "additionalSecurityGroups")
 
+	// allow changes to stoppedInstanceRecoveryPolicy
+	delete(oldAWSMachineSpec, "stoppedInstanceRecoveryPolicy")
+	delete(newAWSMachineSpec, "stoppedInstanceRecoveryPolicy")
+
+	// allow changes to terminationProtection
+	delete(oldAWSMachineSpec, "terminationProtection")
+	delete(newAWSMachineSpec, "terminationProtection")
+
+	// allow changes to deletionPolicy
+	delete(oldAWSMachineSpec, "deletionPolicy")
+	delete(newAWSMachineSpec, "deletionPolicy")
+
+	// allow changes to allowVolumeExpansion
+	delete(oldAWSMachineSpec, "allowVolumeExpansion")
+	delete(newAWSMachineSpec, "allowVolumeExpansion")
+
+	// allow changes to the tags of volumes, and to their sizes if volume expansion is allowed
+	if r.Spec.AllowVolumeExpansion {
+		allErrs = append(allErrs, r.validateVolumeExpansion(old.(*AWSMachine))...)
+	}
+	for _, spec := range []map[string]interface{}{oldAWSMachineSpec, newAWSMachineSpec} {
+		volumes := []interface{}{spec["rootVolume"]}
+		if nonRootVolumes, ok := spec["nonRootVolumes"].([]interface{}); ok {
+			volumes = append(volumes, nonRootVolumes...)
+		}
+		for _, v := range volumes {
+			if volume, ok := v.(map[string]interface{}); ok {
+				delete(volume, "tags")
+				if r.Spec.AllowVolumeExpansion {
+					delete(volume, "size")
+				}
+			}
+		}
+	}
+
 	// allow changes to secretPrefix, secretCount, and secureSecretsBackend
 	if cloudInit, ok := oldAWSMachineSpec["cloudInit"].(map[string]interface{}); ok {
 		delete(cloudInit, "secretPrefix")
@@ -116,6 +161,27 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
+// validateVolumeExpansion checks that the sizes of the volumes of the machine are not decreased.
+func (r *AWSMachine) validateVolumeExpansion(old *AWSMachine) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.RootVolume != nil && old.Spec.RootVolume != nil && r.Spec.RootVolume.Size < old.Spec.RootVolume.Size {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "rootVolume", "size"), "cannot be decreased"))
+	}
+
+	if len(r.Spec.NonRootVolumes) != len(old.Spec.NonRootVolumes) {
+		return allErrs
+	}
+
+	for i, volume := range r.Spec.NonRootVolumes {
+		if volume.Size < old.Spec.NonRootVolumes[i].Size {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "nonRootVolumes").Index(i).Child("size"), "cannot be decreased"))
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachine) validateCloudInitSecret() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -149,6 +215,10 @@ func (r *AWSMachine) validateRootVolume() field.ErrorList {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec.rootVolumeOptions.iops"), "iops required if type is 'io1' or 'io2'"))
 	}
 
+	if r.Spec.RootVolume.Throughput != 0 && r.Spec.RootVolume.Type != "gp3" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.rootVolumeOptions.throughput"), "throughput is only supported if type is 'gp3'"))
+	}
+
 	if r.Spec.RootVolume.DeviceName != "" {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.rootVolumeOptions.deviceName"), "root volume shouldn't have device name"))
 	}
@@ -168,6 +238,10 @@ func (r *AWSMachine) validateNonRootVolumes() field.ErrorList {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec.nonRootVolumes.volumeOptions.iops"), "iops required if type is 'io1' or 'io2'"))
 		}
 
+		if volume.Throughput != 0 && volume.Type != "gp3" {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.nonRootVolumes.volumeOptions.throughput"), "throughput is only supported if type is 'gp3'"))
+		}
+
 		if volume.DeviceName == "" {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec.nonRootVolumes.volumeOptions.deviceName"), "non root volume should have device name"))
 		}
@@ -203,3 +277,45 @@ func (r *AWSMachine) validateAdditionalSecurityGroups() field.ErrorList {
 func (r *AWSMachine) validateSSHKeyName() field.ErrorList {
 	return validateSSHKeyName(r.Spec.SSHKeyName)
 }
+
+func (r *AWSMachine) validateAdditionalNetworkInterfaces() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(r.Spec.AdditionalNetworkInterfaces) == 0 {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "additionalNetworkInterfaces")
+
+	if len(r.Spec.NetworkInterfaces) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set together with spec.networkInterfaces"))
+	}
+
+	// EC2 does not assign public IP addresses to instances launched with more than one network interface.
+	if r.Spec.PublicIP != nil && *r.Spec.PublicIP {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set together with spec.publicIP"))
+	}
+
+	for i, eni := range r.Spec.AdditionalNetworkInterfaces {
+		if eni.Subnet != nil && eni.Subnet.ID != nil && len(eni.Subnet.Filters) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("subnet"), "only one of ID or Filters may be specified, specifying both is forbidden"))
+		}
+		for j, sg := range eni.SecurityGroups {
+			if sg.ID != nil && len(sg.Filters) > 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("securityGroups").Index(j), "only one of ID or Filters may be specified, specifying both is forbidden"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachine) validatePowerStateAnnotation() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if value, ok := r.Annotations[PowerStateAnnotation]; ok && value != PowerStateRunning && value != PowerStateStopped {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("metadata", "annotations", PowerStateAnnotation), value, []string{PowerStateRunning, PowerStateStopped}))
+	}
+
+	return allErrs
+}
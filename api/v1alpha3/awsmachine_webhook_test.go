@@ -155,6 +155,230 @@ func TestAWSMachine_Create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "additional network interfaces may reference subnets and security groups",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AdditionalNetworkInterfaces: []NetworkInterfaceSpec{
+						{
+							Subnet: &AWSResourceReference{
+								ID: aws.String("subnet-id"),
+							},
+							SecurityGroups: []AWSResourceReference{
+								{ID: aws.String("sg-id")},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additional network interfaces can't be set together with network interfaces",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					NetworkInterfaces: []string{"eni-id"},
+					AdditionalNetworkInterfaces: []NetworkInterfaceSpec{
+						{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additional network interfaces can't be set together with a public IP",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					PublicIP: aws.Bool(true),
+					AdditionalNetworkInterfaces: []NetworkInterfaceSpec{
+						{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additional network interface subnet can't have both id and filters",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AdditionalNetworkInterfaces: []NetworkInterfaceSpec{
+						{
+							Subnet: &AWSResourceReference{
+								ID: aws.String("subnet-id"),
+								Filters: []Filter{
+									{
+										Name:   "example-name",
+										Values: []string{"example-value"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ami can't have both an id and an SSM parameter",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMI: AMIReference{
+						AWSResourceReference: AWSResourceReference{
+							ID: aws.String("ami-id"),
+						},
+						SSMParameter: aws.String("/aws/service/bottlerocket/aws-k8s-{{.K8sMajorMinorVersion}}/{{.Arch}}/latest/image_id"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "elastic IP can't have both a public IPv4 pool and filters",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					ElasticIP: &ElasticIP{
+						PublicIPv4Pool: aws.String("ipv4pool-ec2-id"),
+						Filters: []Filter{
+							{
+								Name:   "tag:pool",
+								Values: []string{"example-value"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "host placement requires host tenancy",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					Tenancy: "dedicated",
+					HostPlacement: &HostPlacement{
+						ID: aws.String("h-id"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "host placement can't have both an id and a resource group",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					Tenancy: "host",
+					HostPlacement: &HostPlacement{
+						ID:               aws.String("h-id"),
+						ResourceGroupARN: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/hosts"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "host placement with a host id and host affinity",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					Tenancy: "host",
+					HostPlacement: &HostPlacement{
+						ID:       aws.String("h-id"),
+						Affinity: HostAffinityHost,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "launch template requires an id or a name",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchTemplate: &LaunchTemplateReference{
+						Version: aws.String("$Latest"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template can't have both an id and a name",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchTemplate: &LaunchTemplateReference{
+						ID:   aws.String("lt-id"),
+						Name: aws.String("lt-name"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template with a name and version",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchTemplate: &LaunchTemplateReference{
+						Name:    aws.String("lt-name"),
+						Version: aws.String("2"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fleet requires spot market options",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					Fleet: &FleetOptions{
+						InstanceTypes: []string{"m5.large"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fleet can't be used with a launch template",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					SpotMarketOptions: &SpotMarketOptions{},
+					Fleet:             &FleetOptions{},
+					LaunchTemplate: &LaunchTemplateReference{
+						Name: aws.String("lt-name"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fallback instance types with the architecture of the instance type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:          "m6g.large",
+					FallbackInstanceTypes: []string{"c6gn.large", "t4g.large"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fallback instance type with another architecture than the instance type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:          "m5.large",
+					FallbackInstanceTypes: []string{"m5a.large", "m6g.large"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fleet with spot market options",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					SpotMarketOptions: &SpotMarketOptions{},
+					Fleet: &FleetOptions{
+						InstanceTypes:      []string{"m5.large", "m5a.large"},
+						AllocationStrategy: FleetAllocationStrategyPriceCapacityOptimized,
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -233,6 +457,98 @@ func TestAWSMachine_Update(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "change in stopped instance recovery policy and power state",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{},
+			},
+			newMachine: &AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						PowerStateAnnotation: PowerStateStopped,
+					},
+				},
+				Spec: AWSMachineSpec{
+					StoppedInstanceRecoveryPolicy: StoppedInstanceRecoveryPolicyStart,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change in termination protection and deletion policy",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					TerminationProtection: true,
+					DeletionPolicy:        DeletionPolicyRetain,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unsupported power state",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{},
+			},
+			newMachine: &AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						PowerStateAnnotation: "hibernated",
+					},
+				},
+				Spec: AWSMachineSpec{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "increase volume sizes with volume expansion allowed",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RootVolume:     &Volume{Size: 8},
+					NonRootVolumes: []*Volume{{DeviceName: "/dev/sdb", Size: 16}},
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RootVolume:           &Volume{Size: 16, Tags: Tags{"key-1": "value-1"}},
+					NonRootVolumes:       []*Volume{{DeviceName: "/dev/sdb", Size: 32}},
+					AllowVolumeExpansion: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "decrease volume size with volume expansion allowed",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RootVolume:           &Volume{Size: 16},
+					AllowVolumeExpansion: true,
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RootVolume:           &Volume{Size: 8},
+					AllowVolumeExpansion: true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "increase volume size without volume expansion allowed",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RootVolume: &Volume{Size: 8},
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RootVolume: &Volume{Size: 16},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		ctx := context.TODO()
@@ -245,6 +561,7 @@ func TestAWSMachine_Update(t *testing.T) {
 			if err := testEnv.Create(ctx, machine); err != nil {
 				t.Errorf("failed to create machine: %v", err)
 			}
+			machine.Annotations = tt.newMachine.Annotations
 			machine.Spec = tt.newMachine.Spec
 			if err := testEnv.Update(ctx, machine); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
@@ -55,6 +55,14 @@ func (r *AWSMachineTemplate) ValidateCreate() error {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "providerID"), "cannot be set in templates"))
 	}
 
+	allErrs = append(allErrs, spec.AMI.Validate(field.NewPath("spec", "template", "spec", "ami"))...)
+	allErrs = append(allErrs, spec.CapacityReservationTarget.Validate(field.NewPath("spec", "template", "spec", "capacityReservationTarget"))...)
+	allErrs = append(allErrs, spec.HostPlacement.Validate(field.NewPath("spec", "template", "spec", "hostPlacement"), spec.Tenancy)...)
+	allErrs = append(allErrs, spec.LaunchTemplate.Validate(field.NewPath("spec", "template", "spec", "launchTemplate"))...)
+	allErrs = append(allErrs, validateFleetOptions(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateFallbackInstanceTypes(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, spec.ElasticIP.Validate(field.NewPath("spec", "template", "spec", "elasticIP"))...)
+
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
@@ -86,6 +86,14 @@ const (
 	BastionHostFailedReason = "BastionHostFailed"
 )
 
+const (
+	// DedicatedHostsReadyCondition reports whether the dedicated host pool of a cluster is allocated. Depending on the
+	// configuration, a cluster may not have a dedicated host pool and this condition will be skipped
+	DedicatedHostsReadyCondition clusterv1.ConditionType = "DedicatedHostsReady"
+	// DedicatedHostsFailedReason used when an error occurs during the allocation or release of dedicated hosts
+	DedicatedHostsFailedReason = "DedicatedHostsFailed"
+)
+
 const (
 	// LoadBalancerReadyCondition reports on whether a control plane load balancer was successfully reconciled.
 	LoadBalancerReadyCondition clusterv1.ConditionType = "LoadBalancerReady"
@@ -107,18 +115,65 @@ const (
 	InstanceTerminatedReason = "InstanceTerminated"
 	// InstanceStoppedReason instance is in a stopped state.
 	InstanceStoppedReason = "InstanceStopped"
+	// InstanceStoppedByRequestReason instance was intentionally stopped through the power-state annotation.
+	InstanceStoppedByRequestReason = "InstanceStoppedByRequest"
 	// InstanceNotReadyReason used when the instance is in a pending state.
 	InstanceNotReadyReason = "InstanceNotReady"
 	// InstanceProvisionStartedReason set when the provisioning of an instance started.
 	InstanceProvisionStartedReason = "InstanceProvisionStarted"
 	// InstanceProvisionFailedReason used for failures during instance provisioning.
 	InstanceProvisionFailedReason = "InstanceProvisionFailed"
+	// InstanceCapacityReservationExhaustedReason used when the targeted capacity reservation has no available capacity left.
+	InstanceCapacityReservationExhaustedReason = "InstanceCapacityReservationExhausted"
+	// InstanceTerminationProtectedReason used when the deletion of an instance is refused because of its termination protection.
+	InstanceTerminationProtectedReason = "InstanceTerminationProtected"
+	// InstanceOrphanedReason used when the instance was kept according to the deletion policy and tagged as orphaned.
+	InstanceOrphanedReason = "InstanceOrphaned"
 	// WaitingForClusterInfrastructureReason used when machine is waiting for cluster infrastructure to be ready before proceeding.
 	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
 	// WaitingForBootstrapDataReason used when machine is waiting for bootstrap data to be ready before proceeding.
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
 )
 
+const (
+	// InstanceHealthyCondition reports on the EC2 status checks of the instance. It is only set when the controller
+	// polls the instance health.
+	InstanceHealthyCondition clusterv1.ConditionType = "InstanceHealthy"
+
+	// InstanceStatusCheckFailedReason used when the system or instance status check of the instance is impaired.
+	InstanceStatusCheckFailedReason = "InstanceStatusCheckFailed"
+	// InstanceStatusCheckPendingReason used while the status checks of the instance are initializing or lack data.
+	InstanceStatusCheckPendingReason = "InstanceStatusCheckPending"
+	// InstanceHealthUnknownReason used when the health of the instance could not be determined.
+	InstanceHealthUnknownReason = "InstanceHealthUnknown"
+
+	// NoScheduledEventsCondition is false when AWS scheduled an event, e.g. a retirement or maintenance, for the
+	// instance. It is not part of the Ready summary, as the instance keeps working until the event takes place.
+	NoScheduledEventsCondition clusterv1.ConditionType = "NoScheduledEvents"
+
+	// InstanceEventScheduledReason used when an event is scheduled for the instance.
+	InstanceEventScheduledReason = "InstanceEventScheduled"
+)
+
+const (
+	// NoRebalanceRecommendationCondition is set on the AWSMachines of spot instances, and is false when EC2
+	// recommended to rebalance the instance, as it is at an elevated risk of being interrupted. It is not part of the
+	// Ready summary, as the instance keeps working until it is interrupted.
+	NoRebalanceRecommendationCondition clusterv1.ConditionType = "NoRebalanceRecommendation"
+
+	// RebalanceRecommendedReason used when EC2 recommended to rebalance the spot instance.
+	RebalanceRecommendedReason = "RebalanceRecommended"
+)
+
+const (
+	// BootstrapSucceededCondition reports whether the instance joined the cluster as a Node. It is set to false when
+	// the instance has been running for longer than the bootstrap failure timeout of the controller without a Node.
+	BootstrapSucceededCondition clusterv1.ConditionType = "BootstrapSucceeded"
+
+	// BootstrapFailedReason used when the instance did not join the cluster within the bootstrap failure timeout.
+	BootstrapFailedReason = "BootstrapFailed"
+)
+
 const (
 	// SecurityGroupsReadyCondition indicates the security groups are up to date on the AWSMachine.
 	SecurityGroupsReadyCondition clusterv1.ConditionType = "SecurityGroupsReady"
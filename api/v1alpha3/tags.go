@@ -109,6 +109,10 @@ const (
 
 	NameAWSSubnetAssociation = NameAWSProviderPrefix + "association"
 
+	// NameAWSProviderOrphaned is the tag name we use to mark resources that were released by
+	// a cluster instead of being deleted. The tag value is the name of the cluster.
+	NameAWSProviderOrphaned = NameAWSProviderPrefix + "orphaned"
+
 	SecondarySubnetTagValue = "secondary"
 
 	// APIServerRoleTagValue describes the value for the apiserver role
@@ -117,6 +121,12 @@ const (
 	// BastionRoleTagValue describes the value for the bastion role
 	BastionRoleTagValue = "bastion"
 
+	// DedicatedHostRoleTagValue describes the value for the dedicated host role
+	DedicatedHostRoleTagValue = "dedicated-host"
+
+	// SSHKeyPairRoleTagValue describes the value for the SSH key pair role
+	SSHKeyPairRoleTagValue = "ssh-key-pair"
+
 	// CommonRoleTagValue describes the value for the common role
 	CommonRoleTagValue = "common"
 
@@ -21,6 +21,7 @@ import (
 	"sort"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 )
@@ -49,6 +50,21 @@ type AWSResourceReference struct {
 	Filters []Filter `json:"filters,omitempty"`
 }
 
+// AMIReference is a reference to a specific AMI by ID, ARN, or filters, or to an SSM parameter
+// holding the ID of the AMI.
+type AMIReference struct {
+	AWSResourceReference `json:",inline"`
+
+	// SSMParameter is the name of an SSM parameter holding the ID of the AMI, for example one of the
+	// public parameters published for Ubuntu, Flatcar or Bottlerocket images. It cannot be set
+	// together with ID. Supports substitutions for {{.BaseOS}}, {{.K8sVersion}}, {{.K8sMajorMinorVersion}},
+	// {{.Arch}} and {{.GoArch}}, where Arch is the EC2 architecture of the instance type (x86_64 or
+	// arm64) and GoArch the matching Go architecture name (amd64 or arm64). For example
+	// /aws/service/bottlerocket/aws-k8s-{{.K8sMajorMinorVersion}}/{{.Arch}}/latest/image_id
+	// +optional
+	SSMParameter *string `json:"ssmParameter,omitempty"`
+}
+
 // AWSMachineTemplateResource describes the data needed to create am AWSMachine from a template
 type AWSMachineTemplateResource struct {
 	// Spec is the specification of the desired behavior of the machine.
@@ -660,6 +676,19 @@ type Instance struct {
 	// Specifies ENIs attached to instance
 	NetworkInterfaces []string `json:"networkInterfaces,omitempty"`
 
+	// AdditionalNetworkInterfaces are the network interfaces to create and attach to the instance
+	// in addition to the primary one.
+	// +optional
+	AdditionalNetworkInterfaces []NetworkInterfaceSpec `json:"additionalNetworkInterfaces,omitempty"`
+
+	// AttachedNetworkInterfaces reports the network interfaces attached to the instance, ordered by device index.
+	// +optional
+	AttachedNetworkInterfaces []NetworkInterfaceStatus `json:"attachedNetworkInterfaces,omitempty"`
+
+	// AttachedVolumes reports the EBS volumes attached to the instance.
+	// +optional
+	AttachedVolumes []VolumeAttachmentStatus `json:"attachedVolumes,omitempty"`
+
 	// The tags associated with the instance.
 	Tags map[string]string `json:"tags,omitempty"`
 
@@ -672,6 +701,265 @@ type Instance struct {
 	// Tenancy indicates if instance should run on shared or single-tenant hardware.
 	// +optional
 	Tenancy string `json:"tenancy,omitempty"`
+
+	// CapacityReservationTarget describes the On-Demand Capacity Reservation targeted by the instance.
+	// +optional
+	CapacityReservationTarget *CapacityReservationTarget `json:"capacityReservationTarget,omitempty"`
+
+	// HostPlacement describes the Dedicated Host the instance runs on.
+	// +optional
+	HostPlacement *HostPlacement `json:"hostPlacement,omitempty"`
+
+	// LaunchTemplate is the launch template the instance was launched from.
+	// +optional
+	LaunchTemplate *LaunchTemplateReference `json:"launchTemplate,omitempty"`
+}
+
+// LaunchTemplateReference refers to a version of an existing EC2 launch template.
+// Only one of ID or Name may be specified.
+type LaunchTemplateReference struct {
+	// ID is the ID of the launch template.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Name is the name of the launch template.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Version is the version of the launch template: a version number, $Latest or $Default.
+	// Defaults to $Default.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// CapacityReservationPreference describes the preferred capacity reservation behaviour of an instance.
+type CapacityReservationPreference string
+
+var (
+	// CapacityReservationPreferenceOpen lets the instance run in any open Capacity Reservation
+	// that has matching attributes (instance type, platform, Availability Zone).
+	CapacityReservationPreferenceOpen = CapacityReservationPreference("open")
+
+	// CapacityReservationPreferenceNone prevents the instance from running in a Capacity Reservation,
+	// even if one is available. The instance runs as an On-Demand Instance.
+	CapacityReservationPreferenceNone = CapacityReservationPreference("none")
+)
+
+// CapacityReservationTarget describes the On-Demand Capacity Reservation an instance should be launched into.
+// Only one of ID, ResourceGroupARN or Preference may be specified.
+type CapacityReservationTarget struct {
+	// ID is the ID of the Capacity Reservation in which to run the instance.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// ResourceGroupARN is the ARN of the Capacity Reservation resource group in which to run the instance.
+	// +optional
+	ResourceGroupARN *string `json:"resourceGroupARN,omitempty"`
+
+	// Preference indicates the instance's Capacity Reservation preferences when no explicit
+	// reservation or resource group is targeted.
+	// +optional
+	// +kubebuilder:validation:Enum:=open;none
+	Preference CapacityReservationPreference `json:"preference,omitempty"`
+}
+
+// HostAffinity describes the affinity between an instance and the Dedicated Host it runs on.
+type HostAffinity string
+
+var (
+	// HostAffinityDefault lets a stopped instance restart on any available Dedicated Host
+	// with auto-placement enabled.
+	HostAffinityDefault = HostAffinity("default")
+
+	// HostAffinityHost makes a stopped instance always restart on the same Dedicated Host.
+	HostAffinityHost = HostAffinity("host")
+)
+
+// HostPlacement describes the Dedicated Host an instance with host tenancy runs on.
+// Only one of ID or ResourceGroupARN may be specified. If neither is, the instance runs on any
+// available Dedicated Host with auto-placement enabled, such as the hosts of the cluster's
+// dedicated host pool.
+type HostPlacement struct {
+	// ID is the ID of the Dedicated Host on which to run the instance.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// ResourceGroupARN is the ARN of the host resource group in which to run the instance.
+	// Host resource groups are managed by AWS License Manager, which allocates and releases
+	// the hosts of the group as needed.
+	// +optional
+	ResourceGroupARN *string `json:"resourceGroupARN,omitempty"`
+
+	// Affinity is the affinity between the instance and its Dedicated Host. Defaults to default.
+	// +optional
+	// +kubebuilder:validation:Enum:=default;host
+	Affinity HostAffinity `json:"affinity,omitempty"`
+}
+
+// DedicatedHostPool defines a pool of Dedicated Hosts that are allocated for a cluster, and released
+// when the cluster is deleted. The hosts are allocated with auto-placement enabled, so that instances
+// with host tenancy that do not target a specific host or host resource group run on them.
+// Only one of InstanceType or InstanceFamily may be specified.
+type DedicatedHostPool struct {
+	// InstanceType is the instance type that the hosts support, for example m5.large.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// InstanceFamily is the instance family that the hosts support, for example m5, allowing
+	// instances of different sizes of the family to run on the same host.
+	// +optional
+	InstanceFamily string `json:"instanceFamily,omitempty"`
+
+	// AvailabilityZones are the availability zones in which hosts are allocated.
+	// Defaults to the availability zones of the private subnets of the cluster.
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// HostsPerAvailabilityZone is the number of hosts allocated in each availability zone.
+	// Hosts that do not run any instance are released when it is decreased.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	HostsPerAvailabilityZone int64 `json:"hostsPerAvailabilityZone,omitempty"`
+
+	// HostRecovery enables the recovery of the hosts' instances onto a new host if the host fails.
+	// +optional
+	HostRecovery bool `json:"hostRecovery,omitempty"`
+}
+
+// DedicatedHost describes a Dedicated Host allocated for a cluster.
+type DedicatedHost struct {
+	// ID is the ID of the Dedicated Host.
+	ID string `json:"id"`
+
+	// AvailabilityZone is the availability zone of the Dedicated Host.
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// State is the allocation state of the Dedicated Host.
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// ElasticIP defines how the Elastic IP of an instance is obtained.
+// Only one of PublicIPv4Pool or Filters may be specified.
+type ElasticIP struct {
+	// PublicIPv4Pool is the ID of an address pool, such as a BYOIP pool, to allocate the Elastic IP from.
+	// Defaults to Amazon's pool of public IPv4 addresses.
+	// +optional
+	PublicIPv4Pool *string `json:"publicIpv4Pool,omitempty"`
+
+	// Filters select an existing, unassociated Elastic IP to claim instead of allocating a new one,
+	// for example by tag. A claimed Elastic IP is only disassociated when the machine is deleted.
+	// +optional
+	Filters []Filter `json:"filters,omitempty"`
+}
+
+// NetworkInterfaceSpec defines a network interface that is created together with the instance,
+// attached to it, and deleted when the instance is terminated.
+type NetworkInterfaceSpec struct {
+	// Description is the description of the network interface.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Subnet is a reference to the subnet in which to create the network interface.
+	// The subnet must be in the same availability zone as the instance.
+	// Defaults to the subnet of the instance.
+	// +optional
+	Subnet *AWSResourceReference `json:"subnet,omitempty"`
+
+	// SecurityGroups is a list of references to the security groups to apply to the network interface.
+	// Defaults to the security groups of the instance.
+	// +optional
+	SecurityGroups []AWSResourceReference `json:"securityGroups,omitempty"`
+
+	// SecondaryPrivateIPAddressCount is the number of secondary private IPv4 addresses to assign
+	// to the network interface.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	SecondaryPrivateIPAddressCount int64 `json:"secondaryPrivateIPAddressCount,omitempty"`
+
+	// SourceDestCheck indicates whether source/destination checking is enabled on the network interface.
+	// Defaults to true.
+	// +optional
+	SourceDestCheck *bool `json:"sourceDestCheck,omitempty"`
+}
+
+// NetworkInterfaceStatus describes a network interface attached to an instance.
+type NetworkInterfaceStatus struct {
+	// ID is the ID of the network interface.
+	ID string `json:"id"`
+
+	// DeviceIndex is the index of the device for the network interface attachment.
+	DeviceIndex int64 `json:"deviceIndex"`
+
+	// SubnetID is the ID of the subnet of the network interface.
+	// +optional
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// PrivateIPs are the private IPv4 addresses assigned to the network interface.
+	// +optional
+	PrivateIPs []string `json:"privateIps,omitempty"`
+
+	// SourceDestCheck indicates whether source/destination checking is enabled.
+	// +optional
+	SourceDestCheck bool `json:"sourceDestCheck,omitempty"`
+}
+
+// VolumeAttachmentStatus describes an EBS volume attached to an instance.
+type VolumeAttachmentStatus struct {
+	// ID is the ID of the volume.
+	ID string `json:"id"`
+
+	// DeviceName is the device name the volume is exposed to the instance as.
+	DeviceName string `json:"deviceName"`
+
+	// Root is true if the volume is the root volume of the instance.
+	// +optional
+	Root bool `json:"root,omitempty"`
+}
+
+// InstanceStatusCheck is the result of an EC2 instance or system status check.
+type InstanceStatusCheck string
+
+var (
+	// InstanceStatusCheckOK is the status of a passed status check.
+	InstanceStatusCheckOK = InstanceStatusCheck("ok")
+
+	// InstanceStatusCheckImpaired is the status of a failed status check.
+	InstanceStatusCheckImpaired = InstanceStatusCheck("impaired")
+
+	// InstanceStatusCheckInitializing is the status of a status check that is still in progress.
+	InstanceStatusCheckInitializing = InstanceStatusCheck("initializing")
+
+	// InstanceStatusCheckInsufficientData is the status of a status check that lacks the data to be evaluated.
+	InstanceStatusCheckInsufficientData = InstanceStatusCheck("insufficient-data")
+
+	// InstanceStatusCheckNotApplicable is the status of a status check of an instance that is not running.
+	InstanceStatusCheckNotApplicable = InstanceStatusCheck("not-applicable")
+)
+
+// InstanceHealth describes the status checks and scheduled events of an EC2 instance.
+type InstanceHealth struct {
+	// SystemStatus is the result of the checks of the AWS systems the instance runs on.
+	SystemStatus InstanceStatusCheck `json:"systemStatus,omitempty"`
+
+	// InstanceStatus is the result of the checks of the software and network configuration of the instance.
+	InstanceStatus InstanceStatusCheck `json:"instanceStatus,omitempty"`
+
+	// ScheduledEvents are the upcoming events AWS scheduled for the instance, e.g. retirements or reboots.
+	ScheduledEvents []InstanceScheduledEvent `json:"scheduledEvents,omitempty"`
+}
+
+// InstanceScheduledEvent describes an event AWS scheduled for an EC2 instance.
+type InstanceScheduledEvent struct {
+	// Code is the kind of the event (e.g. instance-retirement, system-reboot, system-maintenance).
+	Code string `json:"code"`
+
+	// Description describes the event.
+	Description string `json:"description,omitempty"`
+
+	// NotBefore is the earliest time the event may start.
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
 }
 
 // Volume encapsulates the configuration options for the storage device
@@ -685,7 +973,7 @@ type Volume struct {
 	// +kubebuilder:validation:Minimum=8
 	Size int64 `json:"size"`
 
-	// Type is the type of the volume (e.g. gp2, io1, etc...).
+	// Type is the type of the volume (e.g. gp2, gp3, io1, etc...).
 	// +optional
 	Type string `json:"type,omitempty"`
 
@@ -693,6 +981,12 @@ type Volume struct {
 	// +optional
 	IOPS int64 `json:"iops,omitempty"`
 
+	// Throughput is the throughput in MiB/s requested for the disk. Only applicable to gp3 volumes.
+	// +optional
+	// +kubebuilder:validation:Minimum=125
+	// +kubebuilder:validation:Maximum=1000
+	Throughput int64 `json:"throughput,omitempty"`
+
 	// Encrypted is whether the volume should be encrypted or not.
 	// +optional
 	Encrypted bool `json:"encrypted,omitempty"`
@@ -702,6 +996,15 @@ type Volume struct {
 	// The key must already exist and be accessible by the controller.
 	// +optional
 	EncryptionKey string `json:"encryptionKey,omitempty"`
+
+	// DeleteOnTermination is whether the volume is deleted when the instance terminates.
+	// Defaults to true.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+
+	// Tags is a set of tags to add to this volume, in addition to the tags of the instance.
+	// +optional
+	Tags Tags `json:"tags,omitempty"`
 }
 
 // SpotMarketOptions defines the options available to a user when configuring
@@ -713,3 +1016,43 @@ type SpotMarketOptions struct {
 	// +kubebuilder:validation:pattern="^[0-9]+(\.[0-9]+)?$"
 	MaxPrice *string `json:"maxPrice,omitempty"`
 }
+
+// FleetAllocationStrategy describes how an EC2 Fleet picks the spot capacity pool an instance is launched from.
+type FleetAllocationStrategy string
+
+var (
+	// FleetAllocationStrategyCapacityOptimized picks the pool with the most available capacity.
+	FleetAllocationStrategyCapacityOptimized = FleetAllocationStrategy("capacity-optimized")
+
+	// FleetAllocationStrategyCapacityOptimizedPrioritized picks the pool with the most available capacity,
+	// honoring the order of the instance types on a best-effort basis.
+	FleetAllocationStrategyCapacityOptimizedPrioritized = FleetAllocationStrategy("capacity-optimized-prioritized")
+
+	// FleetAllocationStrategyPriceCapacityOptimized picks the pools with the most available capacity, and
+	// among them the one with the lowest price.
+	FleetAllocationStrategyPriceCapacityOptimized = FleetAllocationStrategy("price-capacity-optimized")
+
+	// FleetAllocationStrategyLowestPrice picks the pool with the lowest price.
+	FleetAllocationStrategyLowestPrice = FleetAllocationStrategy("lowest-price")
+)
+
+// FleetOptions defines the instance types and subnets among which an EC2 Fleet picks the spot capacity
+// pool an instance is launched from.
+type FleetOptions struct {
+	// InstanceTypes are the instance types the instance may be launched with, in addition to the
+	// instance type of the machine. All instance types must share the same architecture, as the
+	// instance is launched with the same AMI whichever type is picked.
+	// +optional
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets the instance may be launched in. Defaults to the subnet the
+	// instance would be launched in otherwise and, unless a subnet or failure domain is set, to one
+	// private subnet in each other availability zone.
+	// +optional
+	SubnetIDs []string `json:"subnetIDs,omitempty"`
+
+	// AllocationStrategy defines how the spot capacity pool is picked. Defaults to capacity-optimized.
+	// +optional
+	// +kubebuilder:validation:Enum=capacity-optimized;capacity-optimized-prioritized;price-capacity-optimized;lowest-price
+	AllocationStrategy FleetAllocationStrategy `json:"allocationStrategy,omitempty"`
+}
@@ -26,6 +26,10 @@ import (
 
 var (
 	sshKeyValidNameRegex = regexp.MustCompile(`^[[:graph:]]+([[:print:]]*[[:graph:]]+)*$`)
+
+	// gravitonInstanceTypeRegex matches the instance types of the AWS Graviton processors, whose family has a g
+	// after its generation, such as m6g, c6gn or t4g, as well as the first generation a1 family.
+	gravitonInstanceTypeRegex = regexp.MustCompile(`^([a-z]+[0-9]+g[a-z]*|a1)\.`)
 )
 
 // Validate will validate the bastion fields
@@ -39,6 +43,37 @@ func (b *Bastion) Validate() []*field.Error {
 		return errs
 	}
 
+	if b.Mode == BastionModeSessionManager {
+		if b.InstanceType != "" {
+			errs = append(errs,
+				field.Forbidden(field.NewPath("spec", "bastion", "instanceType"), "cannot be set if spec.bastion.mode is SessionManager"),
+			)
+		}
+		if b.AMI != "" {
+			errs = append(errs,
+				field.Forbidden(field.NewPath("spec", "bastion", "ami"), "cannot be set if spec.bastion.mode is SessionManager"),
+			)
+		}
+		if b.UserData != nil {
+			errs = append(errs,
+				field.Forbidden(field.NewPath("spec", "bastion", "userData"), "cannot be set if spec.bastion.mode is SessionManager"),
+			)
+		}
+	}
+
+	if b.UserData != nil {
+		switch {
+		case b.UserData.SecretName != nil && b.UserData.Value != nil:
+			errs = append(errs,
+				field.Forbidden(field.NewPath("spec", "bastion", "userData", "value"), "cannot be set together with spec.bastion.userData.secretName"),
+			)
+		case b.UserData.SecretName == nil && b.UserData.Value == nil:
+			errs = append(errs,
+				field.Required(field.NewPath("spec", "bastion", "userData"), "one of secretName or value must be set"),
+			)
+		}
+	}
+
 	for i, cidr := range b.AllowedCIDRBlocks {
 		if _, _, err := net.ParseCIDR(cidr); err != nil {
 			errs = append(errs,
@@ -49,6 +84,197 @@ func (b *Bastion) Validate() []*field.Error {
 	return errs
 }
 
+// Validate will validate the AMI reference fields
+func (r *AMIReference) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.SSMParameter != nil && r.ID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ssmParameter"), "cannot be set together with id"))
+	}
+
+	return allErrs
+}
+
+// Validate will validate the capacity reservation target fields
+func (t *CapacityReservationTarget) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if t == nil {
+		return allErrs
+	}
+
+	set := 0
+	if t.ID != nil {
+		set++
+	}
+	if t.ResourceGroupARN != nil {
+		set++
+	}
+	if t.Preference != "" {
+		set++
+	}
+
+	if set > 1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of id, resourceGroupARN or preference may be specified"))
+	}
+
+	return allErrs
+}
+
+// Validate will validate the launch template reference fields
+func (r *LaunchTemplateReference) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r == nil {
+		return allErrs
+	}
+
+	if (r.ID == nil) == (r.Name == nil) {
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of id or name must be specified"))
+	}
+
+	return allErrs
+}
+
+// Validate will validate the host placement fields against the tenancy of the instance
+func (p *HostPlacement) Validate(fldPath *field.Path, tenancy string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if p == nil {
+		return allErrs
+	}
+
+	if tenancy != "host" {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "can only be set if tenancy is host"))
+	}
+
+	if p.ID != nil && p.ResourceGroupARN != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of id or resourceGroupARN may be specified"))
+	}
+
+	return allErrs
+}
+
+// Validate will validate the dedicated host pool fields
+func (p *DedicatedHostPool) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if p == nil {
+		return allErrs
+	}
+
+	if (p.InstanceType == "") == (p.InstanceFamily == "") {
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of instanceType or instanceFamily must be specified"))
+	}
+
+	if p.HostsPerAvailabilityZone < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("hostsPerAvailabilityZone"), p.HostsPerAvailabilityZone, "must not be negative"))
+	}
+
+	return allErrs
+}
+
+// Validate will validate the Elastic IP fields
+func (e *ElasticIP) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if e == nil {
+		return allErrs
+	}
+
+	if e.PublicIPv4Pool != nil && len(e.Filters) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of publicIpv4Pool or filters may be specified"))
+	}
+
+	return allErrs
+}
+
+// validateFleetOptions validates the fleet options of an AWSMachine spec against the other fields of the spec
+func validateFleetOptions(spec *AWSMachineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.Fleet == nil {
+		return allErrs
+	}
+
+	if spec.SpotMarketOptions == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("spotMarketOptions"), "must be set if fleet is set"))
+	}
+	if len(spec.NetworkInterfaces) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networkInterfaces"), "cannot be set together with fleet"))
+	}
+	if len(spec.AdditionalNetworkInterfaces) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalNetworkInterfaces"), "cannot be set together with fleet"))
+	}
+	if spec.LaunchTemplate != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("launchTemplate"), "cannot be set together with fleet"))
+	}
+
+	return allErrs
+}
+
+// validateFallbackInstanceTypes checks that the fallback instance types share the CPU architecture of the instance
+// type, as the AMI is looked up for the instance type only.
+func validateFallbackInstanceTypes(spec *AWSMachineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.InstanceType == "" {
+		return allErrs
+	}
+
+	architecture := instanceTypeArchitecture(spec.InstanceType)
+	for i, instanceType := range spec.FallbackInstanceTypes {
+		if instanceTypeArchitecture(instanceType) != architecture {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("fallbackInstanceTypes").Index(i), instanceType,
+				fmt.Sprintf("must have the %s architecture of instanceType %q", architecture, spec.InstanceType)))
+		}
+	}
+
+	return allErrs
+}
+
+// instanceTypeArchitecture returns the CPU architecture of an instance type, as far as it can be told from its name.
+func instanceTypeArchitecture(instanceType string) string {
+	if gravitonInstanceTypeRegex.MatchString(instanceType) {
+		return "arm64"
+	}
+	return "x86_64"
+}
+
+// Validate will validate the SSH access mode against the SSH key name of the cluster
+func (m SSHAccessMode) Validate(sshKeyName *string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if m == SSHAccessModeInstanceConnect && sshKeyName != nil && *sshKeyName != "" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "sshKeyName"), "cannot be set if spec.sshAccess is InstanceConnect"))
+	}
+
+	return allErrs
+}
+
+// Validate will validate the managed SSH key pair against the other SSH settings of the cluster
+func (k *ManagedSSHKeyPair) Validate(sshKeyName *string, sshAccess SSHAccessMode) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if k == nil {
+		return allErrs
+	}
+
+	if sshKeyName != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "sshKeyName"), "cannot be set if spec.managedSSHKeyPair is set"))
+	}
+
+	if sshAccess == SSHAccessModeInstanceConnect {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedSSHKeyPair"), "cannot be set if spec.sshAccess is InstanceConnect"))
+	}
+
+	if k.PublicKeySecretName != nil && *k.PublicKeySecretName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "managedSSHKeyPair", "publicKeySecretName"), "cannot be empty"))
+	}
+
+	return allErrs
+}
+
 func validateSSHKeyName(sshKey *string) field.ErrorList {
 	var allErrs field.ErrorList
 	switch {
@@ -68,6 +68,16 @@ func SetDefaults_AWSIAMConfigurationSpec(obj *AWSIAMConfigurationSpec) { //nolin
 			Enable: false,
 		}
 	}
+	if obj.SessionManager == nil {
+		obj.SessionManager = &SessionManagerConfig{
+			Enable: false,
+		}
+	}
+	if obj.EC2InstanceConnect == nil {
+		obj.EC2InstanceConnect = &EC2InstanceConnectConfig{
+			Enable: false,
+		}
+	}
 	if obj.EKS.ManagedMachinePool == nil {
 		obj.EKS.ManagedMachinePool = &AWSIAMRoleSpec{
 			Disable: true,
@@ -117,6 +117,22 @@ type EventBridgeConfig struct {
 	Enable bool `json:"enable,omitempty"`
 }
 
+// SessionManagerConfig represents configuration for reaching instances through the AWS Systems Manager
+// Session Manager
+type SessionManagerConfig struct {
+	// Enable controls whether the control plane and nodes roles are granted the permissions of the
+	// AWS Systems Manager agent
+	Enable bool `json:"enable,omitempty"`
+}
+
+// EC2InstanceConnectConfig represents configuration for connecting to instances with SSH public keys
+// pushed through EC2 Instance Connect
+type EC2InstanceConnectConfig struct {
+	// Enable controls whether a managed policy is created that grants the permissions to push SSH
+	// public keys to the instances of clusters through EC2 Instance Connect
+	Enable bool `json:"enable,omitempty"`
+}
+
 // ClusterAPIControllers controls the configuration of the AWS IAM role for
 // the Kubernetes Cluster API Provider AWS controller.
 type ClusterAPIControllers struct {
@@ -187,6 +203,12 @@ type AWSIAMConfigurationSpec struct {
 	// EventBridge controls configuration for consuming EventBridge events
 	EventBridge *EventBridgeConfig `json:"eventBridge,omitempty"`
 
+	// SessionManager controls configuration for reaching instances through the AWS Systems Manager Session Manager
+	SessionManager *SessionManagerConfig `json:"sessionManager,omitempty"`
+
+	// EC2InstanceConnect controls configuration for connecting to instances through EC2 Instance Connect
+	EC2InstanceConnect *EC2InstanceConnectConfig `json:"ec2InstanceConnect,omitempty"`
+
 	// Partition is the AWS security partition being used. Defaults to "aws"
 	Partition string `json:"partition,omitempty"`
 
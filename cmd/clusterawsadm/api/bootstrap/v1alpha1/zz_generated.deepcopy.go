@@ -73,6 +73,16 @@ func (in *AWSIAMConfigurationSpec) DeepCopyInto(out *AWSIAMConfigurationSpec) {
 		*out = new(EventBridgeConfig)
 		**out = **in
 	}
+	if in.SessionManager != nil {
+		in, out := &in.SessionManager, &out.SessionManager
+		*out = new(SessionManagerConfig)
+		**out = **in
+	}
+	if in.EC2InstanceConnect != nil {
+		in, out := &in.EC2InstanceConnect, &out.EC2InstanceConnect
+		*out = new(EC2InstanceConnectConfig)
+		**out = **in
+	}
 	if in.SecureSecretsBackends != nil {
 		in, out := &in.SecureSecretsBackends, &out.SecureSecretsBackends
 		*out = make([]v1alpha3.SecretBackend, len(*in))
@@ -207,6 +217,21 @@ func (in *ControlPlane) DeepCopy() *ControlPlane {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2InstanceConnectConfig) DeepCopyInto(out *EC2InstanceConnectConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2InstanceConnectConfig.
+func (in *EC2InstanceConnectConfig) DeepCopy() *EC2InstanceConnectConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2InstanceConnectConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EKSConfig) DeepCopyInto(out *EKSConfig) {
 	*out = *in
@@ -263,3 +288,18 @@ func (in *Nodes) DeepCopy() *Nodes {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionManagerConfig) DeepCopyInto(out *SessionManagerConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionManagerConfig.
+func (in *SessionManagerConfig) DeepCopy() *SessionManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionManagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
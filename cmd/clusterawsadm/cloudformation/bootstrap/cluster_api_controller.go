@@ -63,10 +63,14 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 			Resource: iamv1.Resources{iamv1.Any},
 			Action: iamv1.Actions{
 				"ec2:AllocateAddress",
+				"ec2:AllocateHosts",
+				"ec2:AssociateAddress",
 				"ec2:AssociateRouteTable",
 				"ec2:AttachInternetGateway",
 				"ec2:AuthorizeSecurityGroupIngress",
+				"ec2:CreateFleet",
 				"ec2:CreateInternetGateway",
+				"ec2:CreateKeyPair",
 				"ec2:CreateNatGateway",
 				"ec2:CreateRoute",
 				"ec2:CreateRouteTable",
@@ -74,20 +78,28 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 				"ec2:CreateSubnet",
 				"ec2:CreateTags",
 				"ec2:CreateVpc",
+				"ec2:CreateVpcEndpoint",
 				"ec2:ModifyVpcAttribute",
 				"ec2:DeleteInternetGateway",
+				"ec2:DeleteKeyPair",
 				"ec2:DeleteNatGateway",
 				"ec2:DeleteRouteTable",
 				"ec2:DeleteSecurityGroup",
 				"ec2:DeleteSubnet",
 				"ec2:DeleteTags",
 				"ec2:DeleteVpc",
+				"ec2:DeleteVpcEndpoints",
 				"ec2:DescribeAccountAttributes",
 				"ec2:DescribeAddresses",
 				"ec2:DescribeAvailabilityZones",
+				"ec2:DescribeHosts",
 				"ec2:DescribeInstances",
+				"ec2:DescribeInstanceAttribute",
+				"ec2:DescribeInstanceStatus",
+				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeInternetGateways",
 				"ec2:DescribeImages",
+				"ec2:DescribeKeyPairs",
 				"ec2:DescribeNatGateways",
 				"ec2:DescribeNetworkInterfaces",
 				"ec2:DescribeNetworkInterfaceAttribute",
@@ -96,17 +108,26 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeSubnets",
 				"ec2:DescribeVpcs",
 				"ec2:DescribeVpcAttribute",
+				"ec2:DescribeVpcEndpoints",
 				"ec2:DescribeVolumes",
+				"ec2:DescribeVolumesModifications",
 				"ec2:DetachInternetGateway",
 				"ec2:DisassociateRouteTable",
 				"ec2:DisassociateAddress",
+				"ec2:GetConsoleOutput",
+				"ec2:ImportKeyPair",
 				"ec2:ModifyInstanceAttribute",
 				"ec2:ModifyNetworkInterfaceAttribute",
+				"ec2:ModifyVolume",
 				"ec2:ModifySubnetAttribute",
 				"ec2:ReleaseAddress",
+				"ec2:ReleaseHosts",
 				"ec2:RevokeSecurityGroupIngress",
 				"ec2:RunInstances",
+				"ec2:StartInstances",
+				"ec2:StopInstances",
 				"ec2:TerminateInstances",
+				"route53:AssociateVPCWithHostedZone",
 				"tag:GetResources",
 				"elasticloadbalancing:AddTags",
 				"elasticloadbalancing:CreateLoadBalancer",
@@ -121,6 +142,11 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 				"elasticloadbalancing:RemoveTags",
 				"autoscaling:DescribeAutoScalingGroups",
 				"autoscaling:DescribeInstanceRefreshes",
+				"autoscaling:DescribeWarmPool",
+				"autoscaling:DescribeLifecycleHooks",
+				"autoscaling:DescribeScheduledActions",
+				"autoscaling:DescribePolicies",
+				"autoscaling:DescribeScalingActivities",
 				"ec2:CreateLaunchTemplate",
 				"ec2:CreateLaunchTemplateVersion",
 				"ec2:DescribeLaunchTemplates",
@@ -139,8 +165,19 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 				"autoscaling:UpdateAutoScalingGroup",
 				"autoscaling:CreateOrUpdateTags",
 				"autoscaling:StartInstanceRefresh",
+				"autoscaling:CancelInstanceRefresh",
+				"autoscaling:TerminateInstanceInAutoScalingGroup",
 				"autoscaling:DeleteAutoScalingGroup",
 				"autoscaling:DeleteTags",
+				"autoscaling:PutWarmPool",
+				"autoscaling:DeleteWarmPool",
+				"autoscaling:PutLifecycleHook",
+				"autoscaling:DeleteLifecycleHook",
+				"autoscaling:CompleteLifecycleAction",
+				"autoscaling:PutScheduledUpdateGroupAction",
+				"autoscaling:DeleteScheduledAction",
+				"autoscaling:PutScalingPolicy",
+				"autoscaling:DeletePolicy",
 			},
 		},
 		{
@@ -179,6 +216,18 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 				iamv1.StringLike: map[string]string{"iam:AWSServiceName": "spot.amazonaws.com"},
 			},
 		},
+		{
+			Effect: iamv1.EffectAllow,
+			Action: iamv1.Actions{
+				"iam:CreateServiceLinkedRole",
+			},
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/aws-service-role/ec2fleet.amazonaws.com/AWSServiceRoleForEC2Fleet",
+			},
+			Condition: iamv1.Conditions{
+				iamv1.StringLike: map[string]string{"iam:AWSServiceName": "ec2fleet.amazonaws.com"},
+			},
+		},
 		{
 			Effect:   iamv1.EffectAllow,
 			Resource: t.allowedEC2InstanceProfiles(),
@@ -215,21 +264,21 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 			})
 		}
 	}
+	// Allow looking up AMIs from public SSM parameters, including the EKS optimized AMIs.
+	statement = append(statement, iamv1.StatementEntry{
+		Effect: iamv1.EffectAllow,
+		Resource: iamv1.Resources{
+			"arn:*:ssm:*:*:parameter/aws/service/*",
+		},
+		Action: iamv1.Actions{
+			"ssm:GetParameter",
+		},
+	})
 	if t.Spec.EKS.Enable {
 		allowedIAMActions := iamv1.Actions{
 			"iam:GetRole",
 			"iam:ListAttachedRolePolicies",
 		}
-		statement = append(statement, iamv1.StatementEntry{
-			Effect: iamv1.EffectAllow,
-			Resource: iamv1.Resources{
-				"arn:*:ssm:*:*:parameter/aws/service/eks/optimized-ami/*",
-			},
-			Action: iamv1.Actions{
-				"ssm:GetParameter",
-			},
-		})
-
 		statement = append(statement, iamv1.StatementEntry{
 			Effect: iamv1.EffectAllow,
 			Action: iamv1.Actions{
@@ -78,6 +78,10 @@ func (t Template) nodeManagedPolicies() []string {
 		policies = append(policies, t.generateAWSManagedPolicyARN("AmazonEC2ContainerRegistryReadOnly"))
 	}
 
+	if t.Spec.SessionManager.Enable {
+		policies = append(policies, t.generateAWSManagedPolicyARN("AmazonSSMManagedInstanceCore"))
+	}
+
 	return policies
 }
 
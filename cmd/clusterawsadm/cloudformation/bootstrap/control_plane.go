@@ -38,6 +38,16 @@ func (t Template) controlPlanePolicies() []cfn_iam.Role_Policy {
 	return policies
 }
 
+func (t Template) controlPlaneManagedPolicies() []string {
+	policies := t.Spec.ControlPlane.ExtraPolicyAttachments
+
+	if t.Spec.SessionManager.Enable {
+		policies = append(policies, t.generateAWSManagedPolicyARN("AmazonSSMManagedInstanceCore"))
+	}
+
+	return policies
+}
+
 func (t Template) controlPlaneTrustPolicy() *iamv1.PolicyDocument {
 	policyDocument := ec2AssumeRolePolicy()
 	policyDocument.Statement = append(policyDocument.Statement, t.Spec.ControlPlane.TrustStatements...)
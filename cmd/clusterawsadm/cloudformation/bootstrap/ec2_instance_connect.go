@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"github.com/awslabs/goformation/v4/cloudformation"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/api/iam/v1alpha1"
+)
+
+func (t Template) ec2InstanceConnectPolicyGroups() []string {
+	groups := []string{}
+	if t.Spec.BootstrapUser.Enable {
+		groups = append(groups, cloudformation.Ref(AWSIAMGroupBootstrapper))
+	}
+	return groups
+}
+
+// ec2InstanceConnectPolicy grants the permissions used by `clusterawsadm ssh` to find the instances of
+// a cluster and push SSH public keys to them. Keys can only be pushed to instances created by the
+// provider, which all carry its role tag.
+func (t Template) ec2InstanceConnectPolicy() *iamv1.PolicyDocument {
+	return &iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources{iamv1.Any},
+				Action: iamv1.Actions{
+					"ec2:DescribeInstances",
+				},
+			},
+			{
+				Effect: iamv1.EffectAllow,
+				Resource: iamv1.Resources{
+					"arn:*:ec2:*:*:instance/*",
+				},
+				Action: iamv1.Actions{
+					"ec2-instance-connect:SendSSHPublicKey",
+				},
+				Condition: iamv1.Conditions{
+					iamv1.StringLike: map[string]string{"ec2:ResourceTag/" + infrav1.NameAWSClusterAPIRole: "*"},
+				},
+			},
+		},
+	}
+}
@@ -46,6 +46,7 @@ const (
 	ControlPlanePolicy                PolicyName = "AWSIAMManagedPolicyCloudProviderControlPlane"
 	NodePolicy                        PolicyName = "AWSIAMManagedPolicyCloudProviderNodes"
 	CSIPolicy                         PolicyName = "AWSEBSCSIPolicyController"
+	EC2InstanceConnectPolicy          PolicyName = "AWSIAMManagedPolicyEC2InstanceConnect"
 )
 
 type Template struct {
@@ -119,10 +120,19 @@ func (t Template) RenderCloudFormation() *cloudformation.Template {
 		}
 	}
 
+	if t.Spec.EC2InstanceConnect.Enable {
+		template.Resources[string(EC2InstanceConnectPolicy)] = &cfn_iam.ManagedPolicy{
+			ManagedPolicyName: t.NewManagedName("ec2-instance-connect"),
+			Description:       `For connecting to cluster instances through EC2 Instance Connect`,
+			PolicyDocument:    t.ec2InstanceConnectPolicy(),
+			Groups:            t.ec2InstanceConnectPolicyGroups(),
+		}
+	}
+
 	template.Resources[AWSIAMRoleControlPlane] = &cfn_iam.Role{
 		RoleName:                 t.NewManagedName("control-plane"),
 		AssumeRolePolicyDocument: t.controlPlaneTrustPolicy(),
-		ManagedPolicyArns:        t.Spec.ControlPlane.ExtraPolicyAttachments,
+		ManagedPolicyArns:        t.controlPlaneManagedPolicies(),
 		Policies:                 t.controlPlanePolicies(),
 		Tags:                     converters.MapToCloudFormationTags(t.Spec.ControlPlane.Tags),
 	}
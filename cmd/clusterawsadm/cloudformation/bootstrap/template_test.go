@@ -107,6 +107,22 @@ func Test_RenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_session_manager",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.SessionManager.Enable = true
+				return t
+			},
+		},
+		{
+			fixture: "with_ec2_instance_connect",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.EC2InstanceConnect.Enable = true
+				return t
+			},
+		},
 		{
 			fixture: "with_extra_statements",
 			template: func() Template {
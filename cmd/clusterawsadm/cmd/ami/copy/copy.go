@@ -76,7 +76,7 @@ func CopyAMICmd() *cobra.Command {
 			}
 
 			klog.V(5).Infof("Retrieving the image from %s: os=%s version=%s", ownerID, opSystem, kubernetesVersion)
-			image, err := ec2service.DefaultAMILookup(ec2Client, ownerID, opSystem, kubernetesVersion, "")
+			image, err := ec2service.DefaultAMILookup(ec2Client, ownerID, opSystem, kubernetesVersion, "", "")
 			if err != nil {
 				return err
 			}
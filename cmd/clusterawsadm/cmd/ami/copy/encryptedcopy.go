@@ -89,7 +89,7 @@ func EncryptedCopyAMICmd() *cobra.Command {
 				fmt.Printf("Failed to parse dry-run value: %v. Defaulting to --dry-run=false\n", err)
 			}
 
-			image, err := ec2service.DefaultAMILookup(ec2Client, ownerID, opSystem, kubernetesVersion, "")
+			image, err := ec2service.DefaultAMILookup(ec2Client, ownerID, opSystem, kubernetesVersion, "", "")
 			if err != nil {
 				return err
 			}
@@ -28,6 +28,8 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/ami"
 	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/bootstrap"
 	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/sessionmanager"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/ssh"
 	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/version"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd"
 )
@@ -66,6 +68,8 @@ func RootCmd() *cobra.Command {
 	newCmd.AddCommand(version.VersionCmd(os.Stdout))
 	newCmd.AddCommand(ami.RootCmd())
 	newCmd.AddCommand(eks.RootCmd())
+	newCmd.AddCommand(sessionmanager.RootCmd())
+	newCmd.AddCommand(ssh.SSHCmd())
 
 	return newCmd
 }
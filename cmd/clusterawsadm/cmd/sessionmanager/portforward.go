@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessionmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/flags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd"
+)
+
+const (
+	// sessionManagerPlugin is the name of the binary of the Session Manager plugin of the AWS CLI.
+	sessionManagerPlugin = "session-manager-plugin"
+
+	// portForwardingDocument is the Session Manager document forwarding a local port to a port of the target.
+	portForwardingDocument = "AWS-StartPortForwardingSession"
+
+	// apiServerPort is the port the Kubernetes API server listens on on control plane instances.
+	apiServerPort = 6443
+
+	// controlPlaneRoleTagValue is the value of the role tag of control plane instances.
+	controlPlaneRoleTagValue = "control-plane"
+)
+
+func portForwardCmd() *cobra.Command {
+	instanceID := ""
+	clusterName := ""
+	apiServer := false
+	remotePort := 0
+	localPort := 0
+
+	newCmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port to a port of a cluster instance through Session Manager",
+		Long: cmd.LongDesc(`
+			Start a Session Manager session forwarding a local port to a port of an instance. The instance is
+			either given by its ID, or is a running control plane instance of the cluster with the given name.
+			With --api-server, the local port is forwarded to the Kubernetes API server of the control plane
+			instance.
+		`),
+		Example: cmd.Examples(`
+		# Forward local port 2222 to the SSH port of an instance.
+		clusterawsadm session-manager port-forward --instance-id i-0123456789abcdef0 --remote-port 22 --local-port 2222
+
+		# Forward local port 6443 to the Kubernetes API server of a control plane instance of the cluster.
+		clusterawsadm session-manager port-forward --cluster-name test --api-server --region us-west-2
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (instanceID == "") == (clusterName == "") {
+				return errors.New("exactly one of --instance-id or --cluster-name must be set")
+			}
+			if remotePort == 0 {
+				if !apiServer {
+					return errors.New("--remote-port must be set unless --api-server is set")
+				}
+				remotePort = apiServerPort
+			}
+			if localPort == 0 {
+				localPort = remotePort
+			}
+
+			pluginPath, err := exec.LookPath(sessionManagerPlugin)
+			if err != nil {
+				return errors.Wrapf(err, "could not find %s, see https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html for how to install it", sessionManagerPlugin)
+			}
+
+			region, err := flags.GetRegion(cmd)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Could not resolve AWS region, define it with --region flag or as an environment variable.")
+				return err
+			}
+
+			sess, err := session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+				Config:            aws.Config{Region: aws.String(region)},
+			})
+			if err != nil {
+				return err
+			}
+
+			if instanceID == "" {
+				instanceID, err = findControlPlaneInstance(ec2.New(sess), clusterName)
+				if err != nil {
+					return flags.ResolveAWSError(err)
+				}
+			}
+
+			fmt.Fprintf(os.Stderr, "Forwarding local port %d to port %d of instance %s\n", localPort, remotePort, instanceID)
+			return startPortForwardingSession(ssm.New(sess), pluginPath, region, instanceID, remotePort, localPort)
+		},
+	}
+
+	flags.AddRegionFlag(newCmd)
+	newCmd.Flags().StringVar(&instanceID, "instance-id", "", "The ID of the instance to forward the local port to")
+	newCmd.Flags().StringVar(&clusterName, "cluster-name", "", "The name of the cluster whose control plane instance to forward the local port to")
+	newCmd.Flags().BoolVar(&apiServer, "api-server", false, "Forward the local port to the Kubernetes API server of the instance")
+	newCmd.Flags().IntVar(&remotePort, "remote-port", 0, "The port of the instance to forward the local port to")
+	newCmd.Flags().IntVar(&localPort, "local-port", 0, "The local port to forward, defaults to the remote port")
+
+	return newCmd
+}
+
+// findControlPlaneInstance returns the ID of a running control plane instance of the cluster.
+func findControlPlaneInstance(ec2Client ec2iface.EC2API, clusterName string) (string, error) {
+	out, err := ec2Client.DescribeInstances(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ProviderRole(controlPlaneRoleTagValue),
+			filter.EC2.Cluster(clusterName),
+			filter.EC2.InstanceStates(ec2.InstanceStateNameRunning),
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe control plane instances of cluster %q", clusterName)
+	}
+
+	for _, reservation := range out.Reservations {
+		for _, instance := range reservation.Instances {
+			return aws.StringValue(instance.InstanceId), nil
+		}
+	}
+
+	return "", errors.Errorf("no running control plane instance found for cluster %q", clusterName)
+}
+
+// startPortForwardingSession starts a port forwarding session to the instance, and hands it over to the Session
+// Manager plugin, which forwards the local port until it is interrupted.
+func startPortForwardingSession(ssmClient *ssm.SSM, pluginPath, region, instanceID string, remotePort, localPort int) error {
+	input := &ssm.StartSessionInput{
+		Target:       aws.String(instanceID),
+		DocumentName: aws.String(portForwardingDocument),
+		Parameters: map[string][]*string{
+			"portNumber":      {aws.String(strconv.Itoa(remotePort))},
+			"localPortNumber": {aws.String(strconv.Itoa(localPort))},
+		},
+	}
+
+	out, err := ssmClient.StartSession(input)
+	if err != nil {
+		return errors.Wrapf(flags.ResolveAWSError(err), "failed to start session to instance %q", instanceID)
+	}
+
+	response, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	parameters, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	// The arguments are the ones the AWS CLI passes to the plugin.
+	plugin := exec.Command(pluginPath, string(response), region, "StartSession", os.Getenv("AWS_PROFILE"), string(parameters), ssmClient.Endpoint) //nolint:gosec
+	plugin.Stdin = os.Stdin
+	plugin.Stdout = os.Stdout
+	plugin.Stderr = os.Stderr
+
+	// The plugin terminates the session when interrupted.
+	signal.Ignore(os.Interrupt)
+	defer signal.Reset(os.Interrupt)
+
+	if err := plugin.Run(); err != nil {
+		if _, terminateErr := ssmClient.TerminateSession(&ssm.TerminateSessionInput{SessionId: out.SessionId}); terminateErr != nil {
+			fmt.Fprintf(os.Stderr, "Failed to terminate session %s: %v\n", aws.StringValue(out.SessionId), terminateErr)
+		}
+		return errors.Wrap(err, "session manager plugin failed")
+	}
+
+	return nil
+}
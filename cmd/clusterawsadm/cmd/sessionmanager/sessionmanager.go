@@ -0,0 +1,44 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessionmanager
+
+import (
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd"
+)
+
+// RootCmd is the root of the `session-manager command`
+func RootCmd() *cobra.Command {
+	newCmd := &cobra.Command{
+		Use:   "session-manager [command]",
+		Short: "AWS Systems Manager Session Manager commands",
+		Args:  cobra.NoArgs,
+		Long: cmd.LongDesc(`
+			Reach the instances of a cluster through the AWS Systems Manager Session Manager, without a bastion
+			host. Requires the Session Manager plugin of the AWS CLI to be installed.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Help(); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	newCmd.AddCommand(portForwardCmd())
+	return newCmd
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssh
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ec2instanceconnect"
+	"github.com/aws/aws-sdk-go/service/ec2instanceconnect/ec2instanceconnectiface"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/flags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd"
+)
+
+const (
+	// defaultOSUser is the user of the Ubuntu AMIs the bastion and the machines run by default.
+	defaultOSUser = "ubuntu"
+
+	// controlPlaneRoleTagValue is the value of the role tag of control plane instances.
+	controlPlaneRoleTagValue = "control-plane"
+
+	// ephemeralKeyBits is the size of the RSA keys generated when no identity file is given.
+	ephemeralKeyBits = 2048
+)
+
+// connectOptions are the options of an SSH connection to an instance through EC2 Instance Connect.
+type connectOptions struct {
+	clusterName   string
+	instanceID    string
+	osUser        string
+	bastionOSUser string
+	identityFile  string
+}
+
+// SSHCmd is the `ssh` command
+func SSHCmd() *cobra.Command {
+	opts := connectOptions{}
+
+	newCmd := &cobra.Command{
+		Use:   "ssh [-- ssh arguments]",
+		Short: "Connect to a cluster instance with SSH through EC2 Instance Connect",
+		Long: cmd.LongDesc(`
+			Connect to an instance of a cluster with SSH, through the bastion host of the cluster if it has one.
+			A short-lived SSH public key is pushed to the bastion host and to the instance through EC2 Instance
+			Connect before connecting, so no EC2 key pair is needed. The instance is either given by its ID, or
+			is a running control plane instance of the cluster. Without an identity file, an ephemeral key pair
+			is generated for the connection.
+
+			The instances must run an AMI with EC2 Instance Connect installed, and the AWSCluster should set
+			spec.sshAccess to InstanceConnect so they are not launched with an EC2 key pair.
+		`),
+		Example: cmd.Examples(`
+		# Connect to a control plane instance of the cluster.
+		clusterawsadm ssh --cluster-name test --region us-west-2
+
+		# Run a command on an instance with an existing key pair.
+		clusterawsadm ssh --cluster-name test --instance-id i-0123456789abcdef0 --identity-file ~/.ssh/id_rsa -- uptime
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.clusterName == "" {
+				return errors.New("--cluster-name must be set")
+			}
+
+			sshPath, err := exec.LookPath("ssh")
+			if err != nil {
+				return errors.Wrap(err, "could not find ssh")
+			}
+
+			region, err := flags.GetRegion(cmd)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Could not resolve AWS region, define it with --region flag or as an environment variable.")
+				return err
+			}
+
+			sess, err := session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+				Config:            aws.Config{Region: aws.String(region)},
+			})
+			if err != nil {
+				return err
+			}
+
+			return connect(ec2.New(sess), ec2instanceconnect.New(sess), sshPath, opts, args)
+		},
+	}
+
+	flags.AddRegionFlag(newCmd)
+	newCmd.Flags().StringVar(&opts.clusterName, "cluster-name", "", "The name of the cluster whose instance to connect to")
+	newCmd.Flags().StringVar(&opts.instanceID, "instance-id", "", "The ID of the instance to connect to, defaults to a control plane instance")
+	newCmd.Flags().StringVar(&opts.osUser, "os-user", defaultOSUser, "The operating system user to connect to the instance as")
+	newCmd.Flags().StringVar(&opts.bastionOSUser, "bastion-os-user", defaultOSUser, "The operating system user to connect to the bastion host as")
+	newCmd.Flags().StringVarP(&opts.identityFile, "identity-file", "i", "", "The private key to connect with, whose public key is read from the .pub file next to it")
+
+	return newCmd
+}
+
+func connect(ec2Client ec2iface.EC2API, connectClient ec2instanceconnectiface.EC2InstanceConnectAPI, sshPath string, opts connectOptions, args []string) error {
+	target, err := findTargetInstance(ec2Client, opts.clusterName, opts.instanceID)
+	if err != nil {
+		return flags.ResolveAWSError(err)
+	}
+
+	bastion, err := findBastionInstance(ec2Client, opts.clusterName)
+	if err != nil {
+		return flags.ResolveAWSError(err)
+	}
+	if bastion == nil && target.PublicIpAddress == nil {
+		return errors.Errorf("cluster %q has no running bastion host, and instance %q has no public IP address", opts.clusterName, aws.StringValue(target.InstanceId))
+	}
+
+	identityFile := opts.identityFile
+	if identityFile == "" {
+		dir, err := ioutil.TempDir("", "clusterawsadm-ssh")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+
+		identityFile = filepath.Join(dir, "id_rsa")
+		if err := generateKeyPair(identityFile); err != nil {
+			return err
+		}
+	}
+
+	publicKey, err := ioutil.ReadFile(identityFile + ".pub")
+	if err != nil {
+		return errors.Wrapf(err, "failed to read public key of identity file %q", identityFile)
+	}
+
+	sshArgs := []string{"-i", identityFile, "-o", "IdentitiesOnly=yes"}
+	host := aws.StringValue(target.PublicIpAddress)
+
+	if bastion != nil {
+		if err := sendSSHPublicKey(connectClient, bastion, opts.bastionOSUser, publicKey); err != nil {
+			return err
+		}
+		proxyCommand := fmt.Sprintf("%s -i %s -o IdentitiesOnly=yes -W %%h:%%p %s@%s", sshPath, identityFile, opts.bastionOSUser, aws.StringValue(bastion.PublicIpAddress))
+		sshArgs = append(sshArgs, "-o", "ProxyCommand="+proxyCommand)
+		host = aws.StringValue(target.PrivateIpAddress)
+	}
+
+	if err := sendSSHPublicKey(connectClient, target, opts.osUser, publicKey); err != nil {
+		return err
+	}
+
+	sshArgs = append(sshArgs, fmt.Sprintf("%s@%s", opts.osUser, host))
+	sshArgs = append(sshArgs, args...)
+
+	fmt.Fprintf(os.Stderr, "Connecting to instance %s\n", aws.StringValue(target.InstanceId))
+	sshCmd := exec.Command(sshPath, sshArgs...) //nolint:gosec
+	sshCmd.Stdin = os.Stdin
+	sshCmd.Stdout = os.Stdout
+	sshCmd.Stderr = os.Stderr
+	return sshCmd.Run()
+}
+
+// findTargetInstance returns the instance with the given ID, or a running control plane instance of the
+// cluster if no ID is given.
+func findTargetInstance(ec2Client ec2iface.EC2API, clusterName, instanceID string) (*ec2.Instance, error) {
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Cluster(clusterName),
+			filter.EC2.InstanceStates(ec2.InstanceStateNameRunning),
+		},
+	}
+	if instanceID != "" {
+		input.InstanceIds = aws.StringSlice([]string{instanceID})
+	} else {
+		input.Filters = append(input.Filters, filter.EC2.ProviderRole(controlPlaneRoleTagValue))
+	}
+
+	instance, err := describeFirstInstance(ec2Client, input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instances of cluster %q", clusterName)
+	}
+	if instance == nil {
+		if instanceID != "" {
+			return nil, errors.Errorf("no running instance %q found for cluster %q", instanceID, clusterName)
+		}
+		return nil, errors.Errorf("no running control plane instance found for cluster %q", clusterName)
+	}
+
+	return instance, nil
+}
+
+// findBastionInstance returns the running bastion host instance of the cluster, if any.
+func findBastionInstance(ec2Client ec2iface.EC2API, clusterName string) (*ec2.Instance, error) {
+	instance, err := describeFirstInstance(ec2Client, &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ProviderRole(infrav1.BastionRoleTagValue),
+			filter.EC2.Cluster(clusterName),
+			filter.EC2.InstanceStates(ec2.InstanceStateNameRunning),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe bastion host of cluster %q", clusterName)
+	}
+	if instance == nil || instance.PublicIpAddress == nil {
+		return nil, nil
+	}
+
+	return instance, nil
+}
+
+func describeFirstInstance(ec2Client ec2iface.EC2API, input *ec2.DescribeInstancesInput) (*ec2.Instance, error) {
+	out, err := ec2Client.DescribeInstances(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reservation := range out.Reservations {
+		for _, instance := range reservation.Instances {
+			return instance, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// sendSSHPublicKey pushes the public key to the instance through EC2 Instance Connect. The key is
+// accepted for the operating system user for 60 seconds.
+func sendSSHPublicKey(connectClient ec2instanceconnectiface.EC2InstanceConnectAPI, instance *ec2.Instance, osUser string, publicKey []byte) error {
+	out, err := connectClient.SendSSHPublicKey(&ec2instanceconnect.SendSSHPublicKeyInput{
+		InstanceId:       instance.InstanceId,
+		AvailabilityZone: instance.Placement.AvailabilityZone,
+		InstanceOSUser:   aws.String(osUser),
+		SSHPublicKey:     aws.String(string(publicKey)),
+	})
+	if err != nil {
+		return errors.Wrapf(flags.ResolveAWSError(err), "failed to send SSH public key to instance %q", aws.StringValue(instance.InstanceId))
+	}
+	if !aws.BoolValue(out.Success) {
+		return errors.Errorf("failed to send SSH public key to instance %q", aws.StringValue(instance.InstanceId))
+	}
+
+	return nil
+}
+
+// generateKeyPair writes a new RSA private key to the path, and its public key in the authorized keys
+// format next to it.
+func generateKeyPair(path string) error {
+	privateKey, err := rsa.GenerateKey(rand.Reader, ephemeralKeyBits)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate SSH key pair")
+	}
+
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+	if err := ioutil.WriteFile(path, privateKeyPEM, 0600); err != nil {
+		return errors.Wrap(err, "failed to write SSH private key")
+	}
+
+	publicKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate SSH public key")
+	}
+	if err := ioutil.WriteFile(path+".pub", ssh.MarshalAuthorizedKey(publicKey), 0600); err != nil {
+		return errors.Wrap(err, "failed to write SSH public key")
+	}
+
+	return nil
+}
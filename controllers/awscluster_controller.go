@@ -62,6 +62,7 @@ type AWSClusterReconciler struct {
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;delete
 
 func (r *AWSClusterReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx := context.TODO()
@@ -151,6 +152,16 @@ func reconcileDelete(clusterScope *scope.ClusterScope) (reconcile.Result, error)
 		return reconcile.Result{}, err
 	}
 
+	if err := ec2svc.DeleteDedicatedHosts(); err != nil {
+		clusterScope.Error(err, "error releasing dedicated hosts")
+		return reconcile.Result{}, err
+	}
+
+	if err := ec2svc.DeleteSSHKeyPair(); err != nil {
+		clusterScope.Error(err, "error deleting SSH key pair")
+		return reconcile.Result{}, err
+	}
+
 	if err := sgService.DeleteSecurityGroups(); err != nil {
 		clusterScope.Error(err, "error deleting security groups")
 		return reconcile.Result{}, err
@@ -201,12 +212,22 @@ func reconcileNormal(clusterScope *scope.ClusterScope) (reconcile.Result, error)
 		return reconcile.Result{}, err
 	}
 
+	if err := ec2Service.ReconcileSSHKeyPair(); err != nil {
+		clusterScope.Error(err, "failed to reconcile SSH key pair")
+		return reconcile.Result{}, err
+	}
+
 	if err := ec2Service.ReconcileBastion(); err != nil {
 		conditions.MarkFalse(awsCluster, infrav1.BastionHostReadyCondition, infrav1.BastionHostFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		clusterScope.Error(err, "failed to reconcile bastion host")
 		return reconcile.Result{}, err
 	}
 
+	if err := ec2Service.ReconcileDedicatedHosts(); err != nil {
+		clusterScope.Error(err, "failed to reconcile dedicated hosts")
+		return reconcile.Result{}, err
+	}
+
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		instancestateSvc := instancestate.NewService(clusterScope)
 		if err := instancestateSvc.ReconcileEC2Events(); err != nil {
@@ -254,6 +275,11 @@ func reconcileNormal(clusterScope *scope.ClusterScope) (reconcile.Result, error)
 	}
 
 	awsCluster.Status.Ready = true
+
+	if bastion := clusterScope.Bastion(); bastion.Enabled && bastion.Mode == infrav1.BastionModeAutoScalingGroup {
+		return reconcile.Result{RequeueAfter: ec2.BastionAutoScalingGroupResyncPeriod}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// consoleOutputTailLines and consoleOutputTailBytes bound the console output recorded for an instance that failed
+	// to bootstrap, so that it fits into an event.
+	consoleOutputTailLines = 20
+	consoleOutputTailBytes = 1024
+
+	redacted = "<redacted>"
+)
+
+var consoleOutputRedactions = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// PEM encoded private keys
+	{regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?(-----END [A-Z ]*PRIVATE KEY-----|$)`), redacted},
+	// AWS access key IDs
+	{regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`), redacted},
+	// kubeadm bootstrap tokens
+	{regexp.MustCompile(`\b[a-z0-9]{6}\.[a-z0-9]{16}\b`), redacted},
+	// values of anything that looks like a credential
+	{regexp.MustCompile(`(?i)\b(password|passwd|secret|token|key)(["']?\s*[:=]\s*)\S+`), "${1}${2}" + redacted},
+}
+
+// reconcileBootstrapFailure records the console output of an instance that has been running for longer than the
+// bootstrap failure timeout without its Machine getting a Node. It returns the time after which the instance should
+// be checked again, or zero if no further check is needed.
+func (r *AWSMachineReconciler) reconcileBootstrapFailure(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, i *infrav1.Instance) time.Duration {
+	if machineScope.Machine.Status.NodeRef != nil {
+		conditions.MarkTrue(machineScope.AWSMachine, infrav1.BootstrapSucceededCondition)
+		return 0
+	}
+
+	// The console output is only recorded once per failure.
+	if r.BootstrapFailureTimeout <= 0 || i.State != infrav1.InstanceStateRunning || conditions.IsFalse(machineScope.AWSMachine, infrav1.BootstrapSucceededCondition) {
+		return 0
+	}
+
+	instanceReady := conditions.Get(machineScope.AWSMachine, infrav1.InstanceReadyCondition)
+	if instanceReady == nil || instanceReady.Status != corev1.ConditionTrue {
+		return 0
+	}
+
+	if remaining := r.BootstrapFailureTimeout - time.Since(instanceReady.LastTransitionTime.Time); remaining > 0 {
+		return remaining
+	}
+
+	machineScope.Info("Instance did not join the cluster in time, fetching console output", "instance-id", i.ID, "timeout", r.BootstrapFailureTimeout)
+	conditions.MarkFalse(machineScope.AWSMachine, infrav1.BootstrapSucceededCondition, infrav1.BootstrapFailedReason, clusterv1.ConditionSeverityWarning,
+		"Instance %q did not join the cluster within %s", i.ID, r.BootstrapFailureTimeout)
+
+	output, err := ec2svc.GetConsoleOutput(i.ID)
+	if awserrors.IsAccessDenied(err) {
+		// Getting the console output is optional, so the IAM policy of the controller may not allow it.
+		machineScope.V(2).Info("Not allowed to get console output, skipping", "instance-id", i.ID)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "BootstrapFailed", "Instance %q did not join the cluster within %s", i.ID, r.BootstrapFailureTimeout)
+		return 0
+	}
+	if err != nil {
+		machineScope.Error(err, "unable to get console output", "instance-id", i.ID)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "BootstrapFailed", "Instance %q did not join the cluster within %s, console output is not available: %v", i.ID, r.BootstrapFailureTimeout, err)
+		return 0
+	}
+
+	tail := consoleOutputTail(output)
+	if tail == "" {
+		tail = "<empty>"
+	}
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "BootstrapFailed", "Instance %q did not join the cluster within %s, console output:\n%s", i.ID, r.BootstrapFailureTimeout, tail)
+
+	return 0
+}
+
+// consoleOutputTail returns the redacted last lines of the console output, bounded by consoleOutputTailLines and
+// consoleOutputTailBytes.
+func consoleOutputTail(output string) string {
+	for _, redaction := range consoleOutputRedactions {
+		output = redaction.pattern.ReplaceAllString(output, redaction.replacement)
+	}
+
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(output, "\r\n", "\n"), "\n"), "\n")
+	if len(lines) > consoleOutputTailLines {
+		lines = lines[len(lines)-consoleOutputTailLines:]
+	}
+
+	tail := strings.Join(lines, "\n")
+	if len(tail) > consoleOutputTailBytes {
+		tail = tail[len(tail)-consoleOutputTailBytes:]
+		// Drop the partial first line.
+		if i := strings.Index(tail, "\n"); i >= 0 {
+			tail = tail[i+1:]
+		}
+	}
+
+	return tail
+}
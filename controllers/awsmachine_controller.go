@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -46,11 +47,13 @@ import (
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/elb"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/instancestate"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/network"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/secretsmanager"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
@@ -64,9 +67,19 @@ type AWSMachineReconciler struct {
 	Log                          logr.Logger
 	Recorder                     record.EventRecorder
 	ec2ServiceFactory            func(scope.EC2Scope) services.EC2MachineInterface
+	elasticIPServiceFactory      func(network.Scope) services.ElasticIPInterface
 	secretsManagerServiceFactory func(cloud.ClusterScoper) services.SecretInterface
 	SSMServiceFactory            func(cloud.ClusterScoper) services.SecretInterface
 	Endpoints                    []scope.ServiceEndpoint
+
+	// BootstrapFailureTimeout is the time an instance may be running without its Machine getting a Node, before its
+	// console output is recorded and the bootstrap is considered failed. Zero disables the check.
+	BootstrapFailureTimeout time.Duration
+
+	// InstanceHealthPollInterval is the interval at which the status checks and scheduled events of instances are
+	// polled. Zero disables polling.
+	InstanceHealthPollInterval time.Duration
+	instanceHealth             *instanceHealthCache
 }
 
 const (
@@ -82,6 +95,14 @@ func (r *AWSMachineReconciler) getEC2Service(scope scope.EC2Scope) services.EC2M
 	return ec2.NewService(scope)
 }
 
+func (r *AWSMachineReconciler) getElasticIPService(scope network.Scope) services.ElasticIPInterface {
+	if r.elasticIPServiceFactory != nil {
+		return r.elasticIPServiceFactory(scope)
+	}
+
+	return network.NewService(scope)
+}
+
 func (r *AWSMachineReconciler) getSecretsManagerService(scope cloud.ClusterScoper) services.SecretInterface {
 	if r.secretsManagerServiceFactory != nil {
 		return r.secretsManagerServiceFactory(scope)
@@ -202,6 +223,8 @@ func (r *AWSMachineReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reter
 }
 
 func (r *AWSMachineReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	r.instanceHealth = newInstanceHealthCache()
+
 	controller, err := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
 		For(&infrav1.AWSMachine{}).
@@ -336,12 +359,33 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		// 4. Scale controller deployment to 1
 		machineScope.V(2).Info("Unable to locate EC2 instance by ID or tags")
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "NoInstanceFound", "Unable to find matching EC2 instance")
+
+		// The Elastic IP allocated for the machine outlives its instance, release it by the machine tag.
+		if machineScope.AWSMachine.Spec.ElasticIP != nil {
+			if err := r.releaseElasticIP(machineScope, ec2Scope, ""); err != nil {
+				machineScope.Error(err, "failed to release Elastic IP")
+				r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedReleaseElasticIP", "Failed to release Elastic IP of machine %q: %v", machineScope.Name(), err)
+				return ctrl.Result{}, err
+			}
+		}
+
 		controllerutil.RemoveFinalizer(machineScope.AWSMachine, infrav1.MachineFinalizer)
 		return ctrl.Result{}, nil
 	}
 
 	machineScope.V(3).Info("EC2 instance found matching deleted AWSMachine", "instance-id", instance.ID)
 
+	terminate := machineScope.AWSMachine.Spec.DeletionPolicy != infrav1.DeletionPolicyRetain && machineScope.AWSMachine.Spec.DeletionPolicy != infrav1.DeletionPolicyStop
+	if terminate && machineScope.AWSMachine.Spec.TerminationProtection &&
+		instance.State != infrav1.InstanceStateShuttingDown && instance.State != infrav1.InstanceStateTerminated {
+		// The deletion is resumed once termination protection is disabled in the spec.
+		machineScope.Info("EC2 instance is protected from termination", "instance-id", instance.ID)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceTerminationProtectedReason, clusterv1.ConditionSeverityWarning,
+			"termination protection must be disabled to delete the instance")
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "TerminationProtected", "Refusing to terminate instance %q while termination protection is enabled", instance.ID)
+		return ctrl.Result{}, nil
+	}
+
 	if err := r.reconcileLBAttachment(machineScope, elbScope, instance); err != nil {
 		// We are tolerating AccessDenied error, so this won't block for users with older version of IAM;
 		// all the other errors are blocking.
@@ -358,15 +402,24 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		instancestateSvc := instancestate.NewService(ec2Scope)
 		instancestateSvc.RemoveInstanceFromEventPattern(instance.ID)
+		if machineScope.AWSMachine.Spec.SpotMarketOptions != nil {
+			instancestateSvc.RemoveInstanceFromSpotEventPattern(instance.ID)
+		}
 	}
 
 	// Check the instance state. If it's already shutting down or terminated,
 	// do nothing. Otherwise attempt to delete it.
 	// This decision is based on the ec2-instance-lifecycle graph at
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-instance-lifecycle.html
-	switch instance.State {
-	case infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated:
+	// Instances kept according to the deletion policy are only stopped and orphaned.
+	switch {
+	case instance.State == infrav1.InstanceStateShuttingDown || instance.State == infrav1.InstanceStateTerminated:
 		machineScope.Info("EC2 instance is shutting down or already terminated", "instance-id", instance.ID)
+	case !terminate:
+		if err := r.orphanInstance(ec2Service, machineScope, instance); err != nil {
+			machineScope.Error(err, "failed to orphan instance")
+			return ctrl.Result{}, err
+		}
 	default:
 		machineScope.Info("Terminating EC2 instance", "instance-id", instance.ID)
 
@@ -377,6 +430,21 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 			return ctrl.Result{}, err
 		}
 
+		// The attribute is read from the instance, as the protection may have been enabled outside of the controller.
+		protected, err := ec2Service.GetTerminationProtection(instance.ID)
+		if err != nil {
+			machineScope.Error(err, "failed to get termination protection")
+			return ctrl.Result{}, err
+		}
+		if protected {
+			if err := ec2Service.SetTerminationProtection(instance.ID, false); err != nil {
+				machineScope.Error(err, "failed to disable termination protection")
+				r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedSetTerminationProtection", "Failed to disable termination protection of instance %q: %v", instance.ID, err)
+				return ctrl.Result{}, err
+			}
+			machineScope.AWSMachine.Status.TerminationProtection = false
+		}
+
 		if err := ec2Service.TerminateInstanceAndWait(instance.ID); err != nil {
 			machineScope.Error(err, "failed to terminate instance")
 			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
@@ -418,6 +486,15 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulTerminate", "Terminated instance %q", instance.ID)
 	}
 
+	// A retained instance keeps its Elastic IP, which was tagged as orphaned along with the instance.
+	if terminate && machineScope.AWSMachine.Spec.ElasticIP != nil {
+		if err := r.releaseElasticIP(machineScope, ec2Scope, instance.ID); err != nil {
+			machineScope.Error(err, "failed to release Elastic IP")
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedReleaseElasticIP", "Failed to release Elastic IP of instance %q: %v", instance.ID, err)
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Instance is deleted so remove the finalizer.
 	controllerutil.RemoveFinalizer(machineScope.AWSMachine, infrav1.MachineFinalizer)
 
@@ -450,7 +527,7 @@ func (r *AWSMachineReconciler) findInstance(scope *scope.MachineScope, ec2svc se
 	return instance, nil
 }
 
-func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope, elbScope scope.ELBScope) (ctrl.Result, error) {
+func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope, elbScope scope.ELBScope) (ctrl.Result, error) {
 	machineScope.Info("Reconciling AWSMachine")
 
 	// If the AWSMachine is in an error state, return early.
@@ -501,7 +578,7 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 	// Create new instance
 	if instance == nil {
 		// Avoid a flickering condition between InstanceProvisionStarted and InstanceProvisionFailed if there's a persistent failure with createInstance
-		if conditions.GetReason(machineScope.AWSMachine, infrav1.InstanceReadyCondition) != infrav1.InstanceProvisionFailedReason {
+		if reason := conditions.GetReason(machineScope.AWSMachine, infrav1.InstanceReadyCondition); reason != infrav1.InstanceProvisionFailedReason && reason != infrav1.InstanceCapacityReservationExhaustedReason {
 			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionStartedReason, clusterv1.ConditionSeverityInfo, "")
 			if patchErr := machineScope.PatchObject(); err != nil {
 				machineScope.Error(patchErr, "failed to patch conditions")
@@ -511,6 +588,10 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		instance, err = r.createInstance(ec2svc, machineScope, clusterScope)
 		if err != nil {
 			machineScope.Error(err, "unable to create instance")
+			if awserrors.IsReservationCapacityExceeded(errors.Cause(err)) {
+				conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceCapacityReservationExhaustedReason, clusterv1.ConditionSeverityWarning, err.Error())
+				return ctrl.Result{}, err
+			}
 			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionFailedReason, clusterv1.ConditionSeverityError, err.Error())
 			return ctrl.Result{}, err
 		}
@@ -520,6 +601,11 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		if err := instancestateSvc.AddInstanceToEventPattern(instance.ID); err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "failed to add instance to Event Bridge instance state rule")
 		}
+		if machineScope.AWSMachine.Spec.SpotMarketOptions != nil {
+			if err := instancestateSvc.AddInstanceToSpotEventPattern(instance.ID); err != nil {
+				return ctrl.Result{}, errors.Wrap(err, "failed to add instance to Event Bridge spot interruption rule")
+			}
+		}
 	}
 
 	// Make sure Spec.ProviderID and Spec.InstanceID are always set.
@@ -531,6 +617,25 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 	// Sets the AWSMachine status Interruptible, when the SpotMarketOptions is enabled for AWSMachine, Interruptible is set as true.
 	machineScope.SetInterruptible()
 
+	// Record the instance type and availability zone actually in use, which may be one of the fallback
+	// instance types or have been picked by an EC2 Fleet.
+	machineScope.SetInstanceType(instance.Type)
+	machineScope.SetAvailabilityZone(instance.AvailabilityZone)
+
+	if err := ec2svc.ReconcileSourceDestCheck(machineScope.AWSMachine.Spec.AdditionalNetworkInterfaces, instance.AttachedNetworkInterfaces); err != nil {
+		machineScope.Error(err, "failed to reconcile source/destination check of network interfaces")
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedModifyNetworkInterface", "Failed to configure network interfaces of instance %q: %v", instance.ID, err)
+		return ctrl.Result{}, err
+	}
+	machineScope.SetNetworkInterfaces(instance.AttachedNetworkInterfaces)
+
+	if err := r.reconcilePowerState(ec2svc, machineScope, instance); err != nil {
+		machineScope.Error(err, "failed to reconcile instance power state")
+		return ctrl.Result{}, err
+	}
+
+	r.reconcileInterruption(machineScope, instance)
+
 	existingInstanceState := machineScope.GetInstanceState()
 	machineScope.SetInstanceState(instance.State)
 
@@ -544,8 +649,7 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		machineScope.SetNotReady()
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceNotReadyReason, clusterv1.ConditionSeverityWarning, "")
 	case infrav1.InstanceStateStopping, infrav1.InstanceStateStopped:
-		machineScope.SetNotReady()
-		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStoppedReason, clusterv1.ConditionSeverityError, "")
+		r.reconcileStoppedInstance(machineScope, instance)
 	case infrav1.InstanceStateRunning:
 		machineScope.SetReady()
 		conditions.MarkTrue(machineScope.AWSMachine, infrav1.InstanceReadyCondition)
@@ -590,6 +694,17 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 
 	// tasks that can only take place during operational instance states
 	if machineScope.InstanceIsOperational() {
+		if err := r.reconcileTerminationProtection(ec2svc, machineScope, instance); err != nil {
+			machineScope.Error(err, "failed to reconcile termination protection")
+			return ctrl.Result{}, err
+		}
+
+		if err := r.reconcileElasticIP(machineScope, ec2Scope, instance); err != nil {
+			machineScope.Error(err, "failed to reconcile Elastic IP")
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedAssociateElasticIP", "Failed to associate Elastic IP with instance %q: %v", instance.ID, err)
+			return ctrl.Result{}, err
+		}
+
 		machineScope.SetAddresses(instance.Addresses)
 
 		existingSecurityGroups, err := ec2svc.GetInstanceSecurityGroups(*machineScope.GetInstanceID())
@@ -606,11 +721,206 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 			return ctrl.Result{}, err
 		}
 		conditions.MarkTrue(machineScope.AWSMachine, infrav1.SecurityGroupsReadyCondition)
+
+		// Ensure that the volumes are tagged and expanded as requested.
+		resized, err := ec2svc.ReconcileVolumes(instance, machineScope.AWSMachine.Spec.RootVolume, machineScope.AWSMachine.Spec.NonRootVolumes, machineScope.AWSMachine.Spec.AllowVolumeExpansion)
+		if err != nil {
+			machineScope.Error(err, "unable to reconcile volumes")
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedReconcileVolumes", "Failed to reconcile volumes of instance %q: %v", instance.ID, err)
+			return ctrl.Result{}, err
+		}
+		for _, volumeID := range resized {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "VolumeResized", "Requested expansion of volume %q of instance %q", volumeID, instance.ID)
+		}
+
+		recheckAfter := r.reconcileBootstrapFailure(ec2svc, machineScope, instance)
+		if pollAfter := r.reconcileInstanceHealth(ctx, ec2svc, machineScope, instance); pollAfter > 0 && (recheckAfter == 0 || pollAfter < recheckAfter) {
+			recheckAfter = pollAfter
+		}
+		return ctrl.Result{RequeueAfter: recheckAfter}, nil
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// reconcilePowerState stops or starts the instance as requested through the power-state annotation,
+// or starts it again if it was stopped unexpectedly and the StoppedInstanceRecoveryPolicy asks for it.
+func (r *AWSMachineReconciler) reconcilePowerState(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, i *infrav1.Instance) error {
+	powerState, requested := machineScope.AWSMachine.Annotations[infrav1.PowerStateAnnotation]
+
+	switch {
+	case requested && powerState == infrav1.PowerStateStopped && i.State == infrav1.InstanceStateRunning:
+		if err := ec2svc.StopInstance(i.ID); err != nil {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedStop", "Failed to stop instance %q: %v", i.ID, err)
+			return err
+		}
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulStop", "Stopped instance %q as requested", i.ID)
+		i.State = infrav1.InstanceStateStopping
+	case i.State == infrav1.InstanceStateStopped && (powerState == infrav1.PowerStateRunning ||
+		!requested && machineScope.AWSMachine.Spec.StoppedInstanceRecoveryPolicy == infrav1.StoppedInstanceRecoveryPolicyStart):
+		if err := ec2svc.StartInstance(i.ID); err != nil {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedStart", "Failed to start instance %q: %v", i.ID, err)
+			return err
+		}
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulStart", "Started instance %q", i.ID)
+		i.State = infrav1.InstanceStatePending
+	}
+
+	return nil
+}
+
+// reconcileStoppedInstance marks the AWSMachine of a stopping or stopped instance as not ready. An instance that was
+// not stopped through the power-state annotation fails the machine if the StoppedInstanceRecoveryPolicy asks for it.
+func (r *AWSMachineReconciler) reconcileStoppedInstance(machineScope *scope.MachineScope, instance *infrav1.Instance) {
+	machineScope.SetNotReady()
+
+	switch {
+	case machineScope.AWSMachine.Annotations[infrav1.PowerStateAnnotation] == infrav1.PowerStateStopped:
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStoppedByRequestReason, clusterv1.ConditionSeverityInfo, "")
+	case instance.State == infrav1.InstanceStateStopped && machineScope.AWSMachine.Spec.StoppedInstanceRecoveryPolicy == infrav1.StoppedInstanceRecoveryPolicyFail:
+		machineScope.Info("EC2 instance was stopped unexpectedly", "instance-id", instance.ID)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "InstanceUnexpectedStop", "EC2 instance was stopped unexpectedly")
+		machineScope.SetFailureReason(capierrors.UpdateMachineError)
+		machineScope.SetFailureMessage(errors.Errorf("EC2 instance %q was stopped unexpectedly", instance.ID))
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStoppedReason, clusterv1.ConditionSeverityError, "")
+	default:
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStoppedReason, clusterv1.ConditionSeverityError, "")
+	}
+}
+
+// reconcileTerminationProtection enables or disables the termination protection of the instance when its
+// DisableApiTermination attribute differs from the spec, e.g. because it was changed outside of the controller.
+func (r *AWSMachineReconciler) reconcileTerminationProtection(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, i *infrav1.Instance) error {
+	protected, err := ec2svc.GetTerminationProtection(i.ID)
+	if err != nil {
+		return err
+	}
+	machineScope.AWSMachine.Status.TerminationProtection = protected
+
+	enabled := machineScope.AWSMachine.Spec.TerminationProtection
+	if protected == enabled {
+		return nil
+	}
+
+	if err := ec2svc.SetTerminationProtection(i.ID, enabled); err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedSetTerminationProtection", "Failed to set termination protection of instance %q: %v", i.ID, err)
+		return err
+	}
+	machineScope.AWSMachine.Status.TerminationProtection = enabled
+
+	if enabled {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulSetTerminationProtection", "Enabled termination protection of instance %q", i.ID)
+	} else {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulSetTerminationProtection", "Disabled termination protection of instance %q", i.ID)
+	}
+	return nil
+}
+
+// orphanInstance keeps the instance of a deleted AWSMachine according to its deletion policy: the instance is
+// stopped with the Stop policy, and it, its volumes and its Elastic IP are tagged as orphaned so that they are
+// no longer considered part of the cluster. The instance stays in its subnet and keeps the cluster security
+// groups, so the deletion of the cluster is blocked until it is terminated or its security groups are changed.
+func (r *AWSMachineReconciler) orphanInstance(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, i *infrav1.Instance) error {
+	if machineScope.AWSMachine.Spec.DeletionPolicy == infrav1.DeletionPolicyStop &&
+		i.State != infrav1.InstanceStateStopping && i.State != infrav1.InstanceStateStopped {
+		if err := ec2svc.StopInstance(i.ID); err != nil {
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedStop", "Failed to stop instance %q: %v", i.ID, err)
+			return err
+		}
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulStop", "Stopped instance %q according to the deletion policy", i.ID)
+	}
+
+	if err := ec2svc.OrphanInstance(i.ID); err != nil {
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedOrphan", "Failed to tag instance %q as orphaned: %v", i.ID, err)
+		return err
+	}
+
+	conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceOrphanedReason, clusterv1.ConditionSeverityInfo, "")
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulOrphan", "Retained instance %q and tagged it as orphaned", i.ID)
+	return nil
+}
+
+// reconcileInterruption marks the AWSMachine as failed once EC2 announced the interruption of its spot instance
+// through the interruption annotation, so that a MachineHealthCheck replaces the Machine before the instance is
+// reclaimed. A rebalance recommendation is not terminal, it only sets the NoRebalanceRecommendation condition of
+// spot instances to false.
+func (r *AWSMachineReconciler) reconcileInterruption(machineScope *scope.MachineScope, i *infrav1.Instance) {
+	switch machineScope.AWSMachine.Annotations[infrav1.InterruptionAnnotation] {
+	case infrav1.InterruptionSpotInterruption:
+		machineScope.Info("EC2 spot instance is going to be interrupted", "instance-id", i.ID)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "SpotInterruption", "EC2 spot instance %q is going to be interrupted", i.ID)
+		machineScope.SetFailureReason(capierrors.UpdateMachineError)
+		machineScope.SetFailureMessage(errors.Errorf("EC2 spot instance %q is going to be interrupted", i.ID))
+	case infrav1.InterruptionRebalanceRecommendation:
+		if conditions.IsFalse(machineScope.AWSMachine, infrav1.NoRebalanceRecommendationCondition) {
+			return
+		}
+		machineScope.Info("EC2 recommended to rebalance spot instance", "instance-id", i.ID)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "RebalanceRecommendation", "EC2 recommended to rebalance spot instance %q", i.ID)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.NoRebalanceRecommendationCondition, infrav1.RebalanceRecommendedReason, clusterv1.ConditionSeverityWarning,
+			"EC2 spot instance %q is at an elevated risk of interruption", i.ID)
+	default:
+		if machineScope.AWSMachine.Status.Interruptible {
+			conditions.MarkTrue(machineScope.AWSMachine, infrav1.NoRebalanceRecommendationCondition)
+		}
+	}
+}
+
+// reconcileElasticIP associates an Elastic IP with the instance if the AWSMachine requests one, and
+// adds its public IP address to the instance addresses.
+func (r *AWSMachineReconciler) reconcileElasticIP(machineScope *scope.MachineScope, ec2Scope scope.EC2Scope, i *infrav1.Instance) error {
+	// An Elastic IP can only be associated with a running or stopped instance.
+	if machineScope.AWSMachine.Spec.ElasticIP == nil || (i.State != infrav1.InstanceStateRunning && i.State != infrav1.InstanceStateStopped) {
+		return nil
+	}
+
+	networkScope, ok := ec2Scope.(network.Scope)
+	if !ok {
+		return errors.New("infrastructure cluster does not support Elastic IPs")
+	}
+
+	publicIP, err := r.getElasticIPService(networkScope).ReconcileElasticIP(i.ID, machineScope.Name(), machineScope.Role(), machineScope.AWSMachine.Spec.ElasticIP)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range i.Addresses {
+		if address.Type == clusterv1.MachineExternalIP && address.Address == publicIP {
+			return nil
+		}
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulAssociateElasticIP", "Associated Elastic IP %q with instance %q", publicIP, i.ID)
+
+	// The Elastic IP replaces any public IP address the instance had before, the external DNS name
+	// will be picked up again on the next reconciliation.
+	addresses := make([]clusterv1.MachineAddress, 0, len(i.Addresses)+1)
+	for _, address := range i.Addresses {
+		if address.Type != clusterv1.MachineExternalIP && address.Type != clusterv1.MachineExternalDNS {
+			addresses = append(addresses, address)
+		}
+	}
+	i.Addresses = append(addresses, clusterv1.MachineAddress{
+		Type:    clusterv1.MachineExternalIP,
+		Address: publicIP,
+	})
+
+	return nil
+}
+
+// releaseElasticIP releases, or returns to its pool, the Elastic IP of the instance. The instance ID is
+// empty if the instance no longer exists.
+func (r *AWSMachineReconciler) releaseElasticIP(machineScope *scope.MachineScope, ec2Scope scope.EC2Scope, instanceID string) error {
+	networkScope, ok := ec2Scope.(network.Scope)
+	if !ok {
+		return errors.New("infrastructure cluster does not support Elastic IPs")
+	}
+
+	return r.getElasticIPService(networkScope).ReleaseElasticIP(instanceID, machineScope.Name())
+}
+
 func (r *AWSMachineReconciler) deleteEncryptedBootstrapDataSecret(machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper) error {
 	if !machineScope.UseSecretsManager() {
 		return nil
@@ -39,6 +39,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/network"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -148,6 +149,9 @@ var _ = Describe("AWSMachineReconciler", func() {
 
 		mockCtrl = gomock.NewController(GinkgoT())
 		ec2Svc = mock_services.NewMockEC2MachineInterface(mockCtrl)
+		ec2Svc.EXPECT().ReconcileVolumes(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		ec2Svc.EXPECT().ReconcileSourceDestCheck(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ec2Svc.EXPECT().GetTerminationProtection(gomock.Any()).Return(false, nil).AnyTimes()
 		secretSvc = mock_services.NewMockSecretInterface(mockCtrl)
 
 		// If your test hangs for 9 minutes, increase the value here to the number of events during a reconciliation loop
@@ -666,6 +670,36 @@ var _ = Describe("AWSMachineReconciler", func() {
 				Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedTerminate")))
 			})
 
+			When("the deletion policy retains the instance", func() {
+				BeforeEach(func() {
+					ms.AWSMachine.Spec.DeletionPolicy = infrav1.DeletionPolicyRetain
+					ms.AWSMachine.Spec.ElasticIP = &infrav1.ElasticIP{}
+					// The Elastic IP service has no expectations, so releasing the Elastic IP fails the test.
+					reconciler.elasticIPServiceFactory = func(network.Scope) services.ElasticIPInterface {
+						return mock_services.NewMockElasticIPInterface(mockCtrl)
+					}
+				})
+
+				It("should orphan the instance and keep its Elastic IP", func() {
+					ec2Svc.EXPECT().OrphanInstance(id).Return(nil)
+
+					_, err := reconciler.reconcileDelete(ms, cs, cs, cs)
+					Expect(err).To(BeNil())
+					Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
+					Eventually(recorder.Events).Should(Receive(ContainSubstring("SuccessfulOrphan")))
+				})
+
+				It("should stop the instance before orphaning it with the Stop policy", func() {
+					ms.AWSMachine.Spec.DeletionPolicy = infrav1.DeletionPolicyStop
+					stop := ec2Svc.EXPECT().StopInstance(id).Return(nil)
+					ec2Svc.EXPECT().OrphanInstance(id).Return(nil).After(stop)
+
+					_, err := reconciler.reconcileDelete(ms, cs, cs, cs)
+					Expect(err).To(BeNil())
+					Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
+				})
+			})
+
 			When("instance can be shut down", func() {
 				BeforeEach(func() {
 					ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil)
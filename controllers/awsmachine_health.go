@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// instanceHealthCache holds the health of the instances of each cluster, so that the health of all instances of a
+// cluster is described in a single request per poll interval rather than in one request per machine.
+type instanceHealthCache struct {
+	mu      sync.Mutex
+	entries map[string]*instanceHealthCacheEntry
+	now     func() time.Time
+}
+
+type instanceHealthCacheEntry struct {
+	health  map[string]*infrav1.InstanceHealth
+	err     error
+	expires time.Time
+	// done is closed once the health of the instances has been described.
+	done chan struct{}
+}
+
+func newInstanceHealthCache() *instanceHealthCache {
+	return &instanceHealthCache{
+		entries: map[string]*instanceHealthCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// get returns the health of the instances of the cluster, describing them if the cached health expired. The health
+// of a cluster is described without holding the lock, machines of the same cluster wait for the pending request.
+func (c *instanceHealthCache) get(cluster string, ttl time.Duration, describe func() (map[string]*infrav1.InstanceHealth, error)) (map[string]*infrav1.InstanceHealth, error) {
+	c.mu.Lock()
+	c.prune()
+	if entry, ok := c.entries[cluster]; ok {
+		c.mu.Unlock()
+		<-entry.done
+		return entry.health, entry.err
+	}
+
+	entry := &instanceHealthCacheEntry{done: make(chan struct{})}
+	c.entries[cluster] = entry
+	c.mu.Unlock()
+
+	defer close(entry.done)
+	entry.health, entry.err = describe()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry.err != nil {
+		delete(c.entries, cluster)
+	}
+	entry.expires = c.now().Add(ttl)
+	return entry.health, entry.err
+}
+
+// prune removes the expired entries, including the ones of clusters that are no longer reconciled. It must be called
+// with the lock held.
+func (c *instanceHealthCache) prune() {
+	now := c.now()
+	for cluster, entry := range c.entries {
+		select {
+		case <-entry.done:
+		default:
+			// The health of the cluster is being described.
+			continue
+		}
+		if !now.Before(entry.expires) {
+			delete(c.entries, cluster)
+		}
+	}
+}
+
+// reconcileInstanceHealth sets the InstanceHealthy and NoScheduledEvents conditions from the status checks and
+// scheduled events of the instance. It returns the time after which the health should be polled again, or zero if
+// polling is disabled.
+func (r *AWSMachineReconciler) reconcileInstanceHealth(ctx context.Context, ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, i *infrav1.Instance) time.Duration {
+	if r.InstanceHealthPollInterval <= 0 || r.instanceHealth == nil {
+		return 0
+	}
+
+	cluster := fmt.Sprintf("%s/%s", machineScope.Cluster.Namespace, machineScope.Cluster.Name)
+	health, err := r.instanceHealth.get(cluster, r.InstanceHealthPollInterval, func() (map[string]*infrav1.InstanceHealth, error) {
+		instanceIDs, err := r.clusterInstanceIDs(ctx, machineScope)
+		if err != nil {
+			return nil, err
+		}
+		return ec2svc.DescribeInstanceHealth(instanceIDs)
+	})
+	if err != nil {
+		machineScope.Error(err, "unable to describe instance health")
+		conditions.MarkUnknown(machineScope.AWSMachine, infrav1.InstanceHealthyCondition, infrav1.InstanceHealthUnknownReason, "%v", err)
+		return r.InstanceHealthPollInterval
+	}
+
+	// Instances created after the health of the cluster was described are checked on the next poll.
+	instanceHealth, ok := health[i.ID]
+	if !ok {
+		return r.InstanceHealthPollInterval
+	}
+
+	switch {
+	case instanceHealth.SystemStatus == infrav1.InstanceStatusCheckImpaired || instanceHealth.InstanceStatus == infrav1.InstanceStatusCheckImpaired:
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceHealthyCondition, infrav1.InstanceStatusCheckFailedReason, clusterv1.ConditionSeverityError,
+			"System status is %q, instance status is %q", instanceHealth.SystemStatus, instanceHealth.InstanceStatus)
+	case instanceHealth.SystemStatus == infrav1.InstanceStatusCheckOK && instanceHealth.InstanceStatus == infrav1.InstanceStatusCheckOK:
+		conditions.MarkTrue(machineScope.AWSMachine, infrav1.InstanceHealthyCondition)
+	default:
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceHealthyCondition, infrav1.InstanceStatusCheckPendingReason, clusterv1.ConditionSeverityInfo,
+			"System status is %q, instance status is %q", instanceHealth.SystemStatus, instanceHealth.InstanceStatus)
+	}
+
+	if len(instanceHealth.ScheduledEvents) == 0 {
+		conditions.MarkTrue(machineScope.AWSMachine, infrav1.NoScheduledEventsCondition)
+		return r.InstanceHealthPollInterval
+	}
+
+	events := make([]string, 0, len(instanceHealth.ScheduledEvents))
+	for _, event := range instanceHealth.ScheduledEvents {
+		if event.NotBefore != nil {
+			events = append(events, fmt.Sprintf("%s not before %s: %s", event.Code, event.NotBefore.UTC().Format(time.RFC3339), event.Description))
+		} else {
+			events = append(events, fmt.Sprintf("%s: %s", event.Code, event.Description))
+		}
+	}
+	message := strings.Join(events, "; ")
+
+	if !conditions.IsFalse(machineScope.AWSMachine, infrav1.NoScheduledEventsCondition) || conditions.GetMessage(machineScope.AWSMachine, infrav1.NoScheduledEventsCondition) != message {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "InstanceEventScheduled", "Events scheduled for instance %q: %s", i.ID, message)
+	}
+	conditions.MarkFalse(machineScope.AWSMachine, infrav1.NoScheduledEventsCondition, infrav1.InstanceEventScheduledReason, clusterv1.ConditionSeverityWarning, "%s", message)
+
+	return r.InstanceHealthPollInterval
+}
+
+// clusterInstanceIDs returns the IDs of the instances of all AWSMachines of the cluster of the machine.
+func (r *AWSMachineReconciler) clusterInstanceIDs(ctx context.Context, machineScope *scope.MachineScope) ([]string, error) {
+	awsMachines := &infrav1.AWSMachineList{}
+	if err := r.List(ctx, awsMachines, client.InNamespace(machineScope.Cluster.Namespace), client.MatchingLabels{clusterv1.ClusterLabelName: machineScope.Cluster.Name}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list AWSMachines of cluster %q", machineScope.Cluster.Name)
+	}
+
+	instanceIDs := []string{}
+	seen := map[string]bool{}
+	for _, awsMachine := range awsMachines.Items {
+		if awsMachine.Spec.InstanceID == nil || seen[*awsMachine.Spec.InstanceID] {
+			continue
+		}
+		seen[*awsMachine.Spec.InstanceID] = true
+		instanceIDs = append(instanceIDs, *awsMachine.Spec.InstanceID)
+	}
+
+	// The machine being reconciled may not be labeled yet, or its instance ID not persisted.
+	if id := machineScope.GetInstanceID(); id != nil && !seen[*id] {
+		instanceIDs = append(instanceIDs, *id)
+	}
+
+	return instanceIDs, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	kubedrain "sigs.k8s.io/cluster-api/third_party/kubernetes-drain"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RemoteKubeClient returns a client for the workload cluster.
+func RemoteKubeClient(ctx context.Context, c client.Client, cluster *clusterv1.Cluster) (kubernetes.Interface, error) {
+	restConfig, err := remote.RESTConfig(ctx, c, util.ObjectKey(cluster))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create remote client")
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create remote client")
+	}
+	return kubeClient, nil
+}
+
+// DrainNode cordons and drains a node of the workload cluster, giving up once the timeout elapses or the context is
+// done. A node that no longer exists has nothing left to drain.
+func DrainNode(ctx context.Context, log logr.Logger, kubeClient kubernetes.Interface, nodeName string, timeout time.Duration) error {
+	log = log.WithValues("node", nodeName)
+
+	node, err := kubeClient.CoreV1().Nodes().Get(nodeName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		log.Info("Node is already gone")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get node %q", nodeName)
+	}
+
+	drainer := &kubedrain.Helper{
+		Ctx:                 ctx,
+		Client:              kubeClient,
+		Force:               true,
+		IgnoreAllDaemonSets: true,
+		DeleteLocalData:     true,
+		GracePeriodSeconds:  -1,
+		Timeout:             timeout,
+		OnPodDeletedOrEvicted: func(pod *corev1.Pod, usingEviction bool) {
+			verbStr := "Deleted"
+			if usingEviction {
+				verbStr = "Evicted"
+			}
+			log.Info(fmt.Sprintf("%s pod from Node", verbStr), "pod", fmt.Sprintf("%s/%s", pod.Name, pod.Namespace))
+		},
+		Out:    drainLogWriter{log: log},
+		ErrOut: drainLogWriter{log: log},
+	}
+
+	if err := kubedrain.RunCordonOrUncordon(drainer, node, true); err != nil {
+		return errors.Wrapf(err, "failed to cordon node %q", nodeName)
+	}
+	if err := kubedrain.RunNodeDrain(drainer, nodeName); err != nil {
+		return errors.Wrapf(err, "failed to drain node %q", nodeName)
+	}
+
+	log.Info("Drained node")
+	return nil
+}
+
+// drainLogWriter passes the output of the drainer to a logger.
+type drainLogWriter struct {
+	log logr.Logger
+}
+
+func (w drainLogWriter) Write(p []byte) (n int, err error) {
+	w.log.Info(string(p))
+	return len(p), nil
+}
@@ -50,6 +50,14 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	SSHKeyName *string `json:"sshKeyName,omitempty"`
 
+	// SSHAccess selects how SSH access to the bastion host is granted. KeyPair launches it with the
+	// EC2 key pair named by SSHKeyName. InstanceConnect launches it without a key pair, and relies on
+	// EC2 Instance Connect pushing short-lived SSH public keys to it instead, which requires SSHKeyName
+	// to be empty or omitted. Defaults to KeyPair.
+	// +kubebuilder:validation:Enum=KeyPair;InstanceConnect
+	// +optional
+	SSHAccess infrav1.SSHAccessMode `json:"sshAccess,omitempty"`
+
 	// Version defines the desired Kubernetes version. If no version number
 	// is supplied then the latest version of Kubernetes that EKS supports
 	// will be used.
@@ -198,6 +206,12 @@ type AWSManagedControlPlaneStatus struct {
 	// Bastion holds details of the instance that is used as a bastion jump box
 	// +optional
 	Bastion *infrav1.Instance `json:"bastion,omitempty"`
+	// BastionEndpoint is the public IP address the bastion is reachable at
+	// +optional
+	BastionEndpoint string `json:"bastionEndpoint,omitempty"`
+	// BastionMode is the mode of the bastion that was last reconciled.
+	// +optional
+	BastionMode infrav1.BastionMode `json:"bastionMode,omitempty"`
 	// OIDCProvider holds the status of the identity provider for this cluster
 	// +optional
 	OIDCProvider OIDCProviderStatus `json:"oidcProvider,omitempty"`
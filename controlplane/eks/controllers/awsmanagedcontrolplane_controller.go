@@ -253,6 +253,10 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		})
 	}
 
+	if bastion := managedScope.Bastion(); bastion.Enabled && bastion.Mode == infrav1.BastionModeAutoScalingGroup {
+		return reconcile.Result{RequeueAfter: ec2.BastionAutoScalingGroupResyncPeriod}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -81,6 +81,29 @@ type AWSMachinePoolSpec struct {
 	// Enable or disable the capacity rebalance autoscaling group feature
 	// +optional
 	CapacityRebalance bool `json:"capacityRebalance,omitempty"`
+
+	// WarmPool keeps pre-initialized instances next to the group, which are moved into service when the
+	// group scales out instead of launching and bootstrapping new instances. As the instances of the warm
+	// pool run their user data when they are launched, their nodes join the cluster before being stopped
+	// or hibernated, and are not ready while in the warm pool.
+	// +optional
+	WarmPool *WarmPool `json:"warmPool,omitempty"`
+
+	// LifecycleHooks pause the termination of instances when the group scales in or replaces them, until their
+	// nodes are cordoned and drained. The termination events are delivered through the EventBridge queue of the
+	// cluster, so they require the EventBridgeInstanceState feature gate.
+	// +optional
+	LifecycleHooks []AWSLifecycleHook `json:"lifecycleHooks,omitempty"`
+
+	// ScheduledActions change the size of the group on a schedule.
+	// +optional
+	ScheduledActions []ScheduledAction `json:"scheduledActions,omitempty"`
+
+	// ScalingPolicies scale the group based on CloudWatch metrics.
+	// When scheduled actions or scaling policies are set, the desired capacity of the group is left to them and
+	// the replicas of the MachinePool are no longer applied to the group.
+	// +optional
+	ScalingPolicies []ScalingPolicy `json:"scalingPolicies,omitempty"`
 }
 
 type RefreshPreferences struct {
@@ -100,6 +123,68 @@ type RefreshPreferences struct {
 	// during an instance refresh. The default is 90.
 	// +optional
 	MinHealthyPercentage *int64 `json:"minHealthyPercentage,omitempty"`
+
+	// CheckpointPercentages are the percentages of the group replaced by the instance refresh at which it pauses
+	// for the CheckpointDelay. They must be in increasing order, and the last one should be 100 for the refresh
+	// to replace all instances.
+	// +optional
+	CheckpointPercentages []int64 `json:"checkpointPercentages,omitempty"`
+
+	// CheckpointDelay is the number of seconds the instance refresh waits at each checkpoint.
+	// The default is 3600.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=172800
+	// +optional
+	CheckpointDelay *int64 `json:"checkpointDelay,omitempty"`
+}
+
+// InstanceRefreshStatus describes the progress of the latest instance refresh of the Auto Scaling group.
+type InstanceRefreshStatus struct {
+	// ID is the ID of the instance refresh.
+	ID string `json:"id"`
+
+	// Status is Pending, InProgress, Successful, Failed, Cancelling or Cancelled.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// StatusReason explains the status of the instance refresh.
+	// +optional
+	StatusReason string `json:"statusReason,omitempty"`
+
+	// PercentageComplete is the percentage of the instance refresh that is complete.
+	// +optional
+	PercentageComplete *int64 `json:"percentageComplete,omitempty"`
+
+	// InstancesToUpdate is the number of instances the instance refresh has yet to replace.
+	// +optional
+	InstancesToUpdate *int64 `json:"instancesToUpdate,omitempty"`
+
+	// StartTime is when the instance refresh started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is when the instance refresh ended.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// PreviousLaunchTemplateVersion is the version of the launch template before the instance refresh, which is
+	// restored if the instance refresh fails or is cancelled by the controller.
+	// +optional
+	PreviousLaunchTemplateVersion *int64 `json:"previousLaunchTemplateVersion,omitempty"`
+
+	// Rollback is true if the instance refresh rolls the group back to the previous launch template version.
+	// +optional
+	Rollback bool `json:"rollback,omitempty"`
+
+	// CancelledByController is true if the controller cancelled the instance refresh because its new instances
+	// failed to launch. Only those cancelled refreshes are rolled back, not the ones cancelled by an operator.
+	// +optional
+	CancelledByController bool `json:"cancelledByController,omitempty"`
+
+	// ObservedGeneration is the generation of the AWSMachinePool whose launch template the instance refresh
+	// rolls out, or rolls back for rollbacks.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // AWSMachinePoolStatus defines the observed state of AWSMachinePool
@@ -123,6 +208,26 @@ type AWSMachinePoolStatus struct {
 	// The ID of the launch template
 	LaunchTemplateID string `json:"launchTemplateID,omitempty"`
 
+	// ScalingPolicyARNs are the ARNs of the scaling policies of the group by name, which the CloudWatch alarms
+	// triggering step scaling policies refer to. Only these scaling policies are deleted once they are removed from
+	// the spec, scaling policies created outside of the controller are kept.
+	// +optional
+	ScalingPolicyARNs map[string]string `json:"scalingPolicyARNs,omitempty"`
+
+	// LifecycleHooks are the names of the lifecycle hooks the controller created on the group. Only these are
+	// deleted once they are removed from the spec, lifecycle hooks created outside of the controller are kept.
+	// +optional
+	LifecycleHooks []string `json:"lifecycleHooks,omitempty"`
+
+	// ScheduledActions are the names of the scheduled actions the controller created on the group. Only these are
+	// deleted once they are removed from the spec, scheduled actions created outside of the controller are kept.
+	// +optional
+	ScheduledActions []string `json:"scheduledActions,omitempty"`
+
+	// InstanceRefresh is the progress of the latest instance refresh of the Auto Scaling group.
+	// +optional
+	InstanceRefresh *InstanceRefreshStatus `json:"instanceRefresh,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
@@ -171,6 +276,10 @@ type AWSMachinePoolInstanceStatus struct {
 	// Version defines the Kubernetes version for the Machine Instance
 	// +optional
 	Version *string `json:"version,omitempty"`
+
+	// Warm is true if the instance is in the warm pool of the group rather than in service.
+	// +optional
+	Warm bool `json:"warm,omitempty"`
 }
 
 // +kubebuilder:object:root=true
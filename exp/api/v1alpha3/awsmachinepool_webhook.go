@@ -54,6 +54,137 @@ func (r *AWSMachinePool) validateDefaultCoolDown() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validateAMI() field.ErrorList {
+	return r.Spec.AWSLaunchTemplate.AMI.Validate(field.NewPath("spec", "awsLaunchTemplate", "ami"))
+}
+
+func (r *AWSMachinePool) validateCapacityReservationTarget() field.ErrorList {
+	return r.Spec.AWSLaunchTemplate.CapacityReservationTarget.Validate(field.NewPath("spec", "awsLaunchTemplate", "capacityReservationTarget"))
+}
+
+func (r *AWSMachinePool) validateHostPlacement() field.ErrorList {
+	lt := r.Spec.AWSLaunchTemplate
+	fldPath := field.NewPath("spec", "awsLaunchTemplate", "hostPlacement")
+
+	allErrs := lt.HostPlacement.Validate(fldPath, lt.Tenancy)
+	if lt.HostPlacement != nil && lt.HostPlacement.ID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("id"), "Auto Scaling groups cannot target a single Dedicated Host, use resourceGroupARN instead"))
+	}
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateWarmPool() field.ErrorList {
+	var allErrs field.ErrorList
+
+	warmPool := r.Spec.WarmPool
+	if warmPool == nil {
+		return allErrs
+	}
+
+	if warmPool.MaxPreparedCapacity != nil && *warmPool.MaxPreparedCapacity < warmPool.MinSize {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "warmPool", "maxPreparedCapacity"), *warmPool.MaxPreparedCapacity, "must be greater than or equal to spec.warmPool.minSize"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateLifecycleHooks() field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, hook := range r.Spec.LifecycleHooks {
+		fldPath := field.NewPath("spec", "lifecycleHooks").Index(i)
+		if names[hook.Name] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), hook.Name))
+		}
+		names[hook.Name] = true
+
+		if hook.HeartbeatTimeout != nil && (hook.HeartbeatTimeout.Duration < 30*time.Second || hook.HeartbeatTimeout.Duration > 2*time.Hour) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("heartbeatTimeout"), hook.HeartbeatTimeout.Duration.String(), "must be between 30s and 2h"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateScheduledActions() field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, action := range r.Spec.ScheduledActions {
+		fldPath := field.NewPath("spec", "scheduledActions").Index(i)
+		if names[action.Name] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), action.Name))
+		}
+		names[action.Name] = true
+
+		if action.Recurrence == "" && action.StartTime == nil {
+			allErrs = append(allErrs, field.Required(fldPath, "either recurrence or startTime must be set"))
+		}
+		if action.Recurrence == "" && action.EndTime != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("endTime"), "endTime is only supported by recurring actions"))
+		}
+		if action.MinSize == nil && action.MaxSize == nil && action.DesiredCapacity == nil {
+			allErrs = append(allErrs, field.Required(fldPath, "at least one of minSize, maxSize and desiredCapacity must be set"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateScalingPolicies() field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, policy := range r.Spec.ScalingPolicies {
+		fldPath := field.NewPath("spec", "scalingPolicies").Index(i)
+		if names[policy.Name] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), policy.Name))
+		}
+		names[policy.Name] = true
+
+		if (policy.TargetTracking == nil) == (policy.StepScaling == nil) {
+			allErrs = append(allErrs, field.Invalid(fldPath, policy.Name, "exactly one of targetTracking and stepScaling must be set"))
+			continue
+		}
+
+		if tt := policy.TargetTracking; tt != nil {
+			ttPath := fldPath.Child("targetTracking")
+			if (tt.PredefinedMetric == "") == (tt.CustomMetric == nil) {
+				allErrs = append(allErrs, field.Invalid(ttPath, policy.Name, "exactly one of predefinedMetric and customMetric must be set"))
+			}
+			if tt.PredefinedMetric == PredefinedMetricTypeALBRequestCountPerTarget && tt.ResourceLabel == "" {
+				allErrs = append(allErrs, field.Required(ttPath.Child("resourceLabel"), "resourceLabel is required by the ALBRequestCountPerTarget metric"))
+			}
+			if tt.PredefinedMetric != PredefinedMetricTypeALBRequestCountPerTarget && tt.ResourceLabel != "" {
+				allErrs = append(allErrs, field.Forbidden(ttPath.Child("resourceLabel"), "resourceLabel is only supported by the ALBRequestCountPerTarget metric"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateRefreshPreferences() field.ErrorList {
+	var allErrs field.ErrorList
+
+	prefs := r.Spec.RefreshPreferences
+	if prefs == nil {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "refreshPreferences", "checkpointPercentages")
+	previous := int64(0)
+	for i, percentage := range prefs.CheckpointPercentages {
+		if percentage <= previous || percentage > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), percentage, "must be between 1 and 100, and greater than the previous checkpoint"))
+		}
+		previous = percentage
+	}
+
+	return allErrs
+}
+
 // ValidateCreate will do any extra validation when creating a AWSMachinePool
 func (r *AWSMachinePool) ValidateCreate() error {
 	log.Info("AWSMachinePool validate create", "name", r.Name)
@@ -64,6 +195,15 @@ func (r *AWSMachinePool) ValidateCreate() error {
 		allErrs = append(allErrs, errs...)
 	}
 
+	allErrs = append(allErrs, r.validateAMI()...)
+	allErrs = append(allErrs, r.validateCapacityReservationTarget()...)
+	allErrs = append(allErrs, r.validateHostPlacement()...)
+	allErrs = append(allErrs, r.validateWarmPool()...)
+	allErrs = append(allErrs, r.validateLifecycleHooks()...)
+	allErrs = append(allErrs, r.validateScheduledActions()...)
+	allErrs = append(allErrs, r.validateScalingPolicies()...)
+	allErrs = append(allErrs, r.validateRefreshPreferences()...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -82,6 +222,15 @@ func (r *AWSMachinePool) ValidateUpdate(old runtime.Object) error {
 		allErrs = append(allErrs, errs...)
 	}
 
+	allErrs = append(allErrs, r.validateAMI()...)
+	allErrs = append(allErrs, r.validateCapacityReservationTarget()...)
+	allErrs = append(allErrs, r.validateHostPlacement()...)
+	allErrs = append(allErrs, r.validateWarmPool()...)
+	allErrs = append(allErrs, r.validateLifecycleHooks()...)
+	allErrs = append(allErrs, r.validateScheduledActions()...)
+	allErrs = append(allErrs, r.validateScalingPolicies()...)
+	allErrs = append(allErrs, r.validateRefreshPreferences()...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+const (
+	// AWSMachinePoolMachineFinalizer allows the controller to drain the node and terminate the instance of an
+	// AWSMachinePoolMachine before it is removed.
+	AWSMachinePoolMachineFinalizer = "awsmachinepoolmachine.infrastructure.cluster.x-k8s.io"
+
+	// AWSMachinePoolNameLabel is the label set on AWSMachinePoolMachines with the name of their AWSMachinePool.
+	AWSMachinePoolNameLabel = "infrastructure.cluster.x-k8s.io/aws-machine-pool"
+)
+
+// AWSMachinePoolMachineSpec defines the instance of the Auto Scaling group an AWSMachinePoolMachine stands for.
+type AWSMachinePoolMachineSpec struct {
+	// ProviderID is the provider ID of the instance, which matches the one of its node.
+	ProviderID string `json:"providerID"`
+
+	// InstanceID is the ID of the instance.
+	InstanceID string `json:"instanceID"`
+}
+
+// AWSMachinePoolMachineStatus defines the observed state of AWSMachinePoolMachine
+type AWSMachinePoolMachineStatus struct {
+	// Ready is true when the node of the instance is ready.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// NodeRef is the node of the instance in the workload cluster.
+	// +optional
+	NodeRef *corev1.ObjectReference `json:"nodeRef,omitempty"`
+
+	// Version is the Kubernetes version of the node of the instance.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// LaunchTemplateVersion is the version of the launch template the instance was launched from.
+	// +optional
+	LaunchTemplateVersion *string `json:"launchTemplateVersion,omitempty"`
+
+	// LifecycleState is the lifecycle state of the instance in the Auto Scaling group, such as InService.
+	// +optional
+	LifecycleState string `json:"lifecycleState,omitempty"`
+
+	// Warm is true if the instance is in the warm pool of the group rather than in service.
+	// +optional
+	Warm bool `json:"warm,omitempty"`
+
+	// Conditions defines current service state of the AWSMachinePoolMachine.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=awsmachinepoolmachines,scope=Namespaced,categories=cluster-api
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Node ready status"
+// +kubebuilder:printcolumn:name="InstanceID",type="string",JSONPath=".spec.instanceID",description="EC2 instance ID"
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".status.nodeRef.name",description="Node of the instance"
+// +kubebuilder:printcolumn:name="LaunchTemplate Version",type="string",JSONPath=".status.launchTemplateVersion",description="Launch template version of the instance"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.lifecycleState",description="Lifecycle state of the instance"
+
+// AWSMachinePoolMachine is the Schema for the awsmachinepoolmachines API, which stands for an instance of the
+// Auto Scaling group of an AWSMachinePool. Deleting it drains the node and terminates the instance.
+type AWSMachinePoolMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSMachinePoolMachineSpec   `json:"spec,omitempty"`
+	Status AWSMachinePoolMachineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSMachinePoolMachineList contains a list of AWSMachinePoolMachine
+type AWSMachinePoolMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSMachinePoolMachine `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AWSMachinePoolMachine{}, &AWSMachinePoolMachineList{})
+}
+
+func (r *AWSMachinePoolMachine) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+func (r *AWSMachinePoolMachine) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
@@ -42,6 +42,9 @@ const (
 	InstanceRefreshNotReadyReason = "InstanceRefreshNotReady"
 	// InstanceRefreshFailedReason used to report when there instance refresh is not initiated.
 	InstanceRefreshFailedReason = "InstanceRefreshFailed"
+	// InstanceRefreshRolledBackReason used to report when the launch template was rolled back after a failed
+	// or cancelled instance refresh.
+	InstanceRefreshRolledBackReason = "InstanceRefreshRolledBack"
 )
 
 const (
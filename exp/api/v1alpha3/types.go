@@ -64,8 +64,10 @@ type AWSLaunchTemplate struct {
 	IamInstanceProfile string `json:"iamInstanceProfile,omitempty"`
 
 	// AMI is the reference to the AMI from which to create the machine instance.
+	// If it is not set, or only refers to an SSM parameter, the CPU architecture of the
+	// image is derived from the instance type.
 	// +optional
-	AMI infrav1.AWSResourceReference `json:"ami,omitempty"`
+	AMI infrav1.AMIReference `json:"ami,omitempty"`
 
 	// ImageLookupFormat is the AMI naming format to look up the image for this
 	// machine It will be ignored if an explicit AMI is set. Supports
@@ -112,6 +114,23 @@ type AWSLaunchTemplate struct {
 	// at the cluster level or in the actuator.
 	// +optional
 	AdditionalSecurityGroups []infrav1.AWSResourceReference `json:"additionalSecurityGroups,omitempty"`
+
+	// CapacityReservationTarget allows instances to be launched into a specific On-Demand
+	// Capacity Reservation, a Capacity Reservation resource group, or to express an open/none
+	// reservation preference.
+	// +optional
+	CapacityReservationTarget *infrav1.CapacityReservationTarget `json:"capacityReservationTarget,omitempty"`
+
+	// Tenancy indicates if instances should run on shared or single-tenant hardware.
+	// +optional
+	// +kubebuilder:validation:Enum:=default;dedicated;host
+	Tenancy string `json:"tenancy,omitempty"`
+
+	// HostPlacement selects the host resource group the instances run on. Requires Tenancy to be
+	// host. Auto Scaling groups cannot target a single Dedicated Host, so only ResourceGroupARN
+	// and Affinity may be specified.
+	// +optional
+	HostPlacement *infrav1.HostPlacement `json:"hostPlacement,omitempty"`
 }
 
 // Overrides are used to override the instance type specified by the launch template with multiple
@@ -166,6 +185,232 @@ type MixedInstancesPolicy struct {
 	Overrides             []Overrides            `json:"overrides,omitempty"`
 }
 
+// WarmPoolState is the state instances are kept in while in the warm pool of an Auto Scaling group.
+type WarmPoolState string
+
+var (
+	// WarmPoolStateStopped keeps the instances of the warm pool stopped.
+	WarmPoolStateStopped = WarmPoolState("Stopped")
+
+	// WarmPoolStateHibernated keeps the instances of the warm pool hibernated, which preserves their memory.
+	WarmPoolStateHibernated = WarmPoolState("Hibernated")
+
+	// WarmPoolStateRunning keeps the instances of the warm pool running.
+	WarmPoolStateRunning = WarmPoolState("Running")
+)
+
+// WarmPool describes the pool of pre-initialized instances kept next to an Auto Scaling group, which are
+// moved into service when the group scales out instead of launching new instances.
+type WarmPool struct {
+	// MinSize is the minimum number of instances kept in the warm pool. Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinSize int32 `json:"minSize,omitempty"`
+
+	// MaxPreparedCapacity is the maximum number of instances in service and in the warm pool together.
+	// The warm pool holds the difference between it and the desired capacity of the group, or MinSize
+	// instances if that is more. Defaults to the maximum size of the group.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxPreparedCapacity *int32 `json:"maxPreparedCapacity,omitempty"`
+
+	// PoolState is the state of the instances of the warm pool. Hibernated requires instance types and
+	// AMIs supporting hibernation. Defaults to Stopped.
+	// +kubebuilder:validation:Enum=Stopped;Hibernated;Running
+	// +optional
+	PoolState WarmPoolState `json:"poolState,omitempty"`
+
+	// ReuseOnScaleIn returns instances to the warm pool when the group scales in, instead of terminating them.
+	// +optional
+	ReuseOnScaleIn bool `json:"reuseOnScaleIn,omitempty"`
+}
+
+// LifecycleHookDefaultResult is the action an Auto Scaling group takes when the heartbeat timeout of a lifecycle
+// hook elapses before the lifecycle action is completed.
+type LifecycleHookDefaultResult string
+
+var (
+	// LifecycleHookDefaultResultContinue terminates the instance.
+	LifecycleHookDefaultResultContinue = LifecycleHookDefaultResult("CONTINUE")
+
+	// LifecycleHookDefaultResultAbandon terminates the instance and stops any remaining lifecycle actions.
+	LifecycleHookDefaultResultAbandon = LifecycleHookDefaultResult("ABANDON")
+)
+
+// AWSLifecycleHook describes a lifecycle hook that pauses the termination of the instances of an Auto Scaling
+// group until the nodes of the instances are drained.
+type AWSLifecycleHook struct {
+	// Name is the name of the lifecycle hook.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// HeartbeatTimeout is the maximum time an instance waits for its node to be drained before the default
+	// result applies. It must be between 30 seconds and 2 hours. Defaults to 5 minutes.
+	// +optional
+	HeartbeatTimeout *metav1.Duration `json:"heartbeatTimeout,omitempty"`
+
+	// DefaultResult is the action taken when the heartbeat timeout elapses. Defaults to CONTINUE.
+	// +kubebuilder:validation:Enum=CONTINUE;ABANDON
+	// +optional
+	DefaultResult LifecycleHookDefaultResult `json:"defaultResult,omitempty"`
+}
+
+// ScheduledAction describes a scheduled change of the size of an Auto Scaling group.
+type ScheduledAction struct {
+	// Name is the name of the scheduled action.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Recurrence is the cron expression of when the action recurs, for example "0 8 * * MON-FRI".
+	// +optional
+	Recurrence string `json:"recurrence,omitempty"`
+
+	// StartTime is when the action runs, or when a recurring action starts recurring.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is when a recurring action stops recurring.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// TimeZone is the IANA time zone of the recurrence, for example "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// MinSize is the minimum size the group is set to.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// MaxSize is the maximum size the group is set to.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// DesiredCapacity is the desired capacity the group is set to.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DesiredCapacity *int32 `json:"desiredCapacity,omitempty"`
+}
+
+// PredefinedMetricType is a metric of an Auto Scaling group that a target tracking scaling policy can track.
+type PredefinedMetricType string
+
+var (
+	// PredefinedMetricTypeCPUUtilization is the average CPU utilization of the instances of the group.
+	PredefinedMetricTypeCPUUtilization = PredefinedMetricType("ASGAverageCPUUtilization")
+
+	// PredefinedMetricTypeNetworkIn is the average number of bytes received by the instances of the group.
+	PredefinedMetricTypeNetworkIn = PredefinedMetricType("ASGAverageNetworkIn")
+
+	// PredefinedMetricTypeNetworkOut is the average number of bytes sent by the instances of the group.
+	PredefinedMetricTypeNetworkOut = PredefinedMetricType("ASGAverageNetworkOut")
+
+	// PredefinedMetricTypeALBRequestCountPerTarget is the number of requests per target of an ALB target group.
+	PredefinedMetricTypeALBRequestCountPerTarget = PredefinedMetricType("ALBRequestCountPerTarget")
+)
+
+// CustomMetric describes a CloudWatch metric that a target tracking scaling policy tracks.
+type CustomMetric struct {
+	// Namespace is the namespace of the metric.
+	Namespace string `json:"namespace"`
+
+	// MetricName is the name of the metric.
+	MetricName string `json:"metricName"`
+
+	// Dimensions are the dimensions of the metric.
+	// +optional
+	Dimensions map[string]string `json:"dimensions,omitempty"`
+
+	// Statistic is the statistic of the metric.
+	// +kubebuilder:validation:Enum=Average;Minimum;Maximum;SampleCount;Sum
+	Statistic string `json:"statistic"`
+
+	// Unit is the unit of the metric.
+	// +optional
+	Unit string `json:"unit,omitempty"`
+}
+
+// TargetTrackingScaling scales an Auto Scaling group to keep a metric at a target value.
+type TargetTrackingScaling struct {
+	// PredefinedMetric is the predefined metric the policy tracks. Either it or CustomMetric must be set.
+	// +kubebuilder:validation:Enum=ASGAverageCPUUtilization;ASGAverageNetworkIn;ASGAverageNetworkOut;ALBRequestCountPerTarget
+	// +optional
+	PredefinedMetric PredefinedMetricType `json:"predefinedMetric,omitempty"`
+
+	// ResourceLabel identifies the target group of the ALBRequestCountPerTarget metric, in the form
+	// app/<load-balancer-name>/<load-balancer-id>/targetgroup/<target-group-name>/<target-group-id>.
+	// +optional
+	ResourceLabel string `json:"resourceLabel,omitempty"`
+
+	// CustomMetric is the CloudWatch metric the policy tracks. Either it or PredefinedMetric must be set.
+	// +optional
+	CustomMetric *CustomMetric `json:"customMetric,omitempty"`
+
+	// TargetValue is the value the metric is kept at.
+	TargetValue int64 `json:"targetValue"`
+
+	// DisableScaleIn keeps the policy from scaling in the group.
+	// +optional
+	DisableScaleIn bool `json:"disableScaleIn,omitempty"`
+}
+
+// StepAdjustment is the adjustment of a step scaling policy for a range of the difference between the metric
+// and the threshold of the alarm triggering the policy.
+type StepAdjustment struct {
+	// LowerBound is the inclusive lower bound of the range, which is negative infinity if omitted.
+	// +optional
+	LowerBound *int64 `json:"lowerBound,omitempty"`
+
+	// UpperBound is the exclusive upper bound of the range, which is infinity if omitted.
+	// +optional
+	UpperBound *int64 `json:"upperBound,omitempty"`
+
+	// ScalingAdjustment is the amount by which the group is scaled, as described by the adjustment type.
+	ScalingAdjustment int64 `json:"scalingAdjustment"`
+}
+
+// StepScaling scales an Auto Scaling group in steps when a CloudWatch alarm triggers the policy. The alarm is not
+// managed by the controller, and refers to the policy through its ARN in the status of the AWSMachinePool.
+type StepScaling struct {
+	// AdjustmentType is how the scaling adjustments change the group.
+	// +kubebuilder:validation:Enum=ChangeInCapacity;ExactCapacity;PercentChangeInCapacity
+	AdjustmentType string `json:"adjustmentType"`
+
+	// MetricAggregationType is how the data points of the metric are aggregated. Defaults to Average.
+	// +kubebuilder:validation:Enum=Minimum;Maximum;Average
+	// +optional
+	MetricAggregationType string `json:"metricAggregationType,omitempty"`
+
+	// StepAdjustments are the adjustments for the ranges of the metric.
+	// +kubebuilder:validation:MinItems=1
+	StepAdjustments []StepAdjustment `json:"stepAdjustments"`
+}
+
+// ScalingPolicy describes a dynamic scaling policy of an Auto Scaling group. Exactly one of TargetTracking and
+// StepScaling must be set.
+type ScalingPolicy struct {
+	// Name is the name of the scaling policy.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// EstimatedInstanceWarmup is how long a new instance takes until it contributes to the metric.
+	// Defaults to the default cooldown of the group.
+	// +optional
+	EstimatedInstanceWarmup *metav1.Duration `json:"estimatedInstanceWarmup,omitempty"`
+
+	// TargetTracking scales the group to keep a metric at a target value.
+	// +optional
+	TargetTracking *TargetTrackingScaling `json:"targetTracking,omitempty"`
+
+	// StepScaling scales the group in steps when a CloudWatch alarm triggers the policy.
+	// +optional
+	StepScaling *StepScaling `json:"stepScaling,omitempty"`
+}
+
 // Tags
 type Tags map[string]string
 
@@ -184,8 +429,13 @@ type AutoScalingGroup struct {
 	CapacityRebalance bool            `json:"capacityRebalance,omitempty"`
 
 	MixedInstancesPolicy *MixedInstancesPolicy `json:"mixedInstancesPolicy,omitempty"`
+	WarmPool             *WarmPool             `json:"warmPool,omitempty"`
 	Status               ASGStatus
 	Instances            []infrav1.Instance `json:"instances,omitempty"`
+
+	// InstanceLaunchTemplateVersions maps the IDs of the instances of the group to the version of the launch
+	// template they were launched from.
+	InstanceLaunchTemplateVersions map[string]string `json:"instanceLaunchTemplateVersions,omitempty"`
 }
 
 // ASGStatus is a status string returned by the autoscaling API
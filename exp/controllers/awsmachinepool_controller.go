@@ -20,18 +20,22 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
 
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/cluster-api-provider-aws/controllers"
+	"sigs.k8s.io/cluster-api-provider-aws/feature"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiv1exp "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -49,15 +53,17 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	asg "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/instancestate"
 )
 
 // AWSMachinePoolReconciler reconciles a AWSMachinePool object
 type AWSMachinePoolReconciler struct {
 	client.Client
-	Log               logr.Logger
-	Recorder          record.EventRecorder
-	asgServiceFactory func(cloud.ClusterScoper) services.ASGInterface
-	ec2ServiceFactory func(scope.EC2Scope) services.EC2MachineInterface
+	Log                     logr.Logger
+	Recorder                record.EventRecorder
+	asgServiceFactory       func(cloud.ClusterScoper) services.ASGInterface
+	ec2ServiceFactory       func(scope.EC2Scope) services.EC2MachineInterface
+	remoteKubeClientFactory func(*clusterv1.Cluster) (kubernetes.Interface, error)
 }
 
 func (r *AWSMachinePoolReconciler) getASGService(scope cloud.ClusterScoper) services.ASGInterface {
@@ -77,6 +83,8 @@ func (r *AWSMachinePoolReconciler) getEC2Service(scope scope.EC2Scope) services.
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepoolmachines,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepoolmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=exp.cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
@@ -179,6 +187,7 @@ func (r *AWSMachinePoolReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, r
 func (r *AWSMachinePoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1exp.AWSMachinePool{}).
+		Owns(&infrav1exp.AWSMachinePoolMachine{}).
 		Watches(
 			&source.Kind{Type: &capiv1exp.MachinePool{}},
 			&handler.EnqueueRequestsFromMapFunc{
@@ -221,6 +230,11 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		return ctrl.Result{}, nil
 	}
 
+	if err := r.reconcileInstanceRefresh(machinePoolScope, ec2Scope); err != nil {
+		machinePoolScope.Error(err, "failed to reconcile instance refresh")
+		return ctrl.Result{}, err
+	}
+
 	if err := r.reconcileLaunchTemplate(machinePoolScope, ec2Scope); err != nil {
 		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedLaunchTemplateReconcile", "Failed to reconcile launch template: %v", err)
 		machinePoolScope.Error(err, "failed to reconcile launch template")
@@ -254,6 +268,21 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		return ctrl.Result{}, err
 	}
 
+	if err := r.reconcileWarmPool(machinePoolScope, clusterScope, asg); err != nil {
+		machinePoolScope.Error(err, "error updating warm pool of AWSMachinePool")
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileLifecycleHooks(machinePoolScope, clusterScope, ec2Scope); err != nil {
+		machinePoolScope.Error(err, "error updating lifecycle hooks of AWSMachinePool")
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileScaling(machinePoolScope, clusterScope); err != nil {
+		machinePoolScope.Error(err, "error updating scheduled actions and scaling policies of AWSMachinePool")
+		return ctrl.Result{}, err
+	}
+
 	err = r.reconcileTags(machinePoolScope, clusterScope, ec2Scope)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error updating tags")
@@ -274,12 +303,22 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 	machinePoolScope.AWSMachinePool.Status.Ready = true
 	conditions.MarkTrue(machinePoolScope.AWSMachinePool, infrav1exp.ASGReadyCondition)
 
-	err = machinePoolScope.UpdateInstanceStatuses(ctx, asg.Instances)
+	// Instances of the warm pool are not in service, so they are only part of the instance statuses.
+	var warmInstances []infrav1.Instance
+	if asg.WarmPool != nil {
+		warmInstances, err = asgsvc.DescribeWarmPoolInstances(asg.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	nodeStatusByProviderID, err := machinePoolScope.UpdateInstanceStatuses(ctx, asg.Instances, warmInstances)
 	if err != nil {
-		machinePoolScope.Info("Failed updating instances", "instances", asg.Instances)
+		machinePoolScope.Error(err, "failed updating instances", "instances", asg.Instances, "warmInstances", warmInstances)
+		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return r.reconcileMachinePoolMachines(ctx, machinePoolScope, clusterScope, asg, warmInstances, nodeStatusByProviderID)
 }
 
 func (r *AWSMachinePoolReconciler) reconcileDelete(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) (ctrl.Result, error) {
@@ -288,6 +327,11 @@ func (r *AWSMachinePoolReconciler) reconcileDelete(machinePoolScope *scope.Machi
 	ec2Svc := r.getEC2Service(ec2Scope)
 	asgSvc := r.getASGService(clusterScope)
 
+	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
+		instancestateSvc := instancestate.NewService(ec2Scope)
+		instancestateSvc.RemoveAutoScalingGroupFromLifecycleEventPattern(machinePoolScope.Name())
+	}
+
 	asg, err := r.findASG(machinePoolScope, asgSvc)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -314,6 +358,10 @@ func (r *AWSMachinePoolReconciler) reconcileDelete(machinePoolScope *scope.Machi
 		}
 	}
 
+	if err := r.deleteMachinePoolMachines(context.TODO(), machinePoolScope.AWSMachinePool); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	launchTemplateID := machinePoolScope.AWSMachinePool.Status.LaunchTemplateID
 	launchTemplate, err := ec2Svc.GetLaunchTemplate(launchTemplateID)
 	if err != nil {
@@ -355,6 +403,61 @@ func (r *AWSMachinePoolReconciler) updatePool(machinePoolScope *scope.MachinePoo
 	return nil
 }
 
+func (r *AWSMachinePoolReconciler) reconcileWarmPool(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, existingASG *infrav1exp.AutoScalingGroup) error {
+	if warmPoolNeedsUpdates(machinePoolScope, existingASG) {
+		machinePoolScope.Info("updating AutoScalingGroup warm pool")
+		asgSvc := r.getASGService(clusterScope)
+
+		if err := asgSvc.UpdateWarmPool(machinePoolScope); err != nil {
+			return errors.Wrap(err, "unable to update ASG warm pool")
+		}
+	}
+
+	return nil
+}
+
+func (r *AWSMachinePoolReconciler) reconcileLifecycleHooks(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) error {
+	asgSvc := r.getASGService(clusterScope)
+	if err := asgSvc.ReconcileLifecycleHooks(machinePoolScope); err != nil {
+		return errors.Wrap(err, "unable to update ASG lifecycle hooks")
+	}
+
+	if len(machinePoolScope.AWSMachinePool.Spec.LifecycleHooks) == 0 {
+		return nil
+	}
+
+	// The termination lifecycle actions are delivered to the controller through the queue of the cluster.
+	if !feature.Gates.Enabled(feature.EventBridgeInstanceState) {
+		machinePoolScope.Info("Lifecycle hooks require the EventBridgeInstanceState feature gate to drain nodes, instances are only terminated once the heartbeat timeout elapses")
+		return nil
+	}
+
+	instancestateSvc := instancestate.NewService(ec2Scope)
+	if err := instancestateSvc.AddAutoScalingGroupToLifecycleEventPattern(machinePoolScope.Name()); err != nil {
+		return errors.Wrap(err, "failed to add ASG to Event Bridge lifecycle rule")
+	}
+
+	return nil
+}
+
+func (r *AWSMachinePoolReconciler) reconcileScaling(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper) error {
+	asgSvc := r.getASGService(clusterScope)
+	if err := asgSvc.ReconcileScheduledActions(machinePoolScope); err != nil {
+		return errors.Wrap(err, "unable to update ASG scheduled actions")
+	}
+
+	arns, err := asgSvc.ReconcileScalingPolicies(machinePoolScope)
+	if err != nil {
+		return errors.Wrap(err, "unable to update ASG scaling policies")
+	}
+	machinePoolScope.AWSMachinePool.Status.ScalingPolicyARNs = arns
+	if len(arns) == 0 {
+		machinePoolScope.AWSMachinePool.Status.ScalingPolicyARNs = nil
+	}
+
+	return nil
+}
+
 func (r *AWSMachinePoolReconciler) createPool(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper) (*infrav1exp.AutoScalingGroup, error) {
 	clusterScope.Info("Initializing ASG client")
 
@@ -426,6 +529,15 @@ func (r *AWSMachinePoolReconciler) reconcileLaunchTemplate(machinePoolScope *sco
 		return err
 	}
 
+	// A launch template that was rolled back is not updated again until the AWSMachinePool changes.
+	if refresh := machinePoolScope.AWSMachinePool.Status.InstanceRefresh; refresh != nil && refresh.Rollback &&
+		refresh.ObservedGeneration == machinePoolScope.AWSMachinePool.Generation {
+		if needsUpdate || tagsChanged || *imageID != *launchTemplate.AMI.ID {
+			machinePoolScope.Info("skipping launch template update, the last one was rolled back")
+		}
+		return nil
+	}
+
 	// If there is a change: before changing the template, check if there exist an ongoing instance refresh,
 	// because only 1 instance refresh can be "InProgress". If template is updated when refresh cannot be started,
 	// that change will not trigger a refresh.
@@ -454,15 +566,117 @@ func (r *AWSMachinePoolReconciler) reconcileLaunchTemplate(machinePoolScope *sco
 		// After creating a new version of launch template, instance refresh is required
 		// to trigger a rolling replacement of all previously launched instances.
 
-		if err := asgSvc.StartASGInstanceRefresh(machinePoolScope); err != nil {
+		refreshID, err := asgSvc.StartASGInstanceRefresh(machinePoolScope)
+		if err != nil {
 			conditions.MarkFalse(machinePoolScope.AWSMachinePool, infrav1exp.InstanceRefreshStartedCondition, infrav1exp.InstanceRefreshFailedReason, clusterv1.ConditionSeverityError, err.Error())
 			return err
 		}
+		machinePoolScope.AWSMachinePool.Status.InstanceRefresh = &infrav1exp.InstanceRefreshStatus{
+			ID:                            refreshID,
+			Status:                        autoscaling.InstanceRefreshStatusPending,
+			PreviousLaunchTemplateVersion: launchTemplate.VersionNumber,
+			ObservedGeneration:            machinePoolScope.AWSMachinePool.Generation,
+		}
 		conditions.MarkTrue(machinePoolScope.AWSMachinePool, infrav1exp.InstanceRefreshStartedCondition)
 	}
 	return nil
 }
 
+// reconcileInstanceRefresh updates the progress of the latest instance refresh in the status of the AWSMachinePool.
+// A refresh whose new instances fail to launch is cancelled, and the launch template of a refresh that failed or was
+// cancelled that way is rolled back to its previous version by another refresh. A refresh cancelled by an operator is
+// left as is.
+func (r *AWSMachinePoolReconciler) reconcileInstanceRefresh(machinePoolScope *scope.MachinePoolScope, ec2Scope scope.EC2Scope) error {
+	asgSvc := r.getASGService(ec2Scope)
+	refresh, err := asgSvc.DescribeLatestInstanceRefresh(machinePoolScope)
+	if err != nil {
+		return err
+	}
+	if refresh == nil {
+		return nil
+	}
+
+	// Keep track of what the controller recorded when it started the refresh.
+	if current := machinePoolScope.AWSMachinePool.Status.InstanceRefresh; current != nil && current.ID == refresh.ID {
+		refresh.PreviousLaunchTemplateVersion = current.PreviousLaunchTemplateVersion
+		refresh.Rollback = current.Rollback
+		refresh.CancelledByController = current.CancelledByController
+		refresh.ObservedGeneration = current.ObservedGeneration
+	}
+	machinePoolScope.AWSMachinePool.Status.InstanceRefresh = refresh
+
+	// Only the refreshes the controller started to roll out a new launch template version are rolled back.
+	if refresh.Rollback || refresh.PreviousLaunchTemplateVersion == nil {
+		return nil
+	}
+
+	switch refresh.Status {
+	case autoscaling.InstanceRefreshStatusInProgress:
+		if refresh.StartTime == nil {
+			return nil
+		}
+		failed, err := asgSvc.HasFailedLaunchActivities(machinePoolScope, refresh.StartTime.Time)
+		if err != nil {
+			return err
+		}
+		if !failed {
+			return nil
+		}
+
+		machinePoolScope.Info("cancelling instance refresh with failed launches", "id", refresh.ID)
+		if err := asgSvc.CancelASGInstanceRefresh(machinePoolScope); err != nil {
+			return err
+		}
+		refresh.CancelledByController = true
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "CancelledInstanceRefresh", "Cancelled instance refresh %q with failed launches", refresh.ID)
+
+	case autoscaling.InstanceRefreshStatusCancelled:
+		if !refresh.CancelledByController {
+			machinePoolScope.Info("not rolling back instance refresh cancelled outside of the controller", "id", refresh.ID)
+			return nil
+		}
+		return r.rollbackInstanceRefresh(machinePoolScope, ec2Scope, refresh)
+
+	case autoscaling.InstanceRefreshStatusFailed:
+		return r.rollbackInstanceRefresh(machinePoolScope, ec2Scope, refresh)
+	}
+
+	return nil
+}
+
+// rollbackInstanceRefresh rolls the launch template of the instance refresh back to its previous version, and starts
+// another refresh to replace the instances that were launched from the new version.
+func (r *AWSMachinePoolReconciler) rollbackInstanceRefresh(machinePoolScope *scope.MachinePoolScope, ec2Scope scope.EC2Scope, refresh *infrav1exp.InstanceRefreshStatus) error {
+	version := *refresh.PreviousLaunchTemplateVersion
+	machinePoolScope.Info("rolling back launch template of instance refresh", "id", refresh.ID, "status", refresh.Status, "version", version)
+
+	ec2svc := r.getEC2Service(ec2Scope)
+	asgSvc := r.getASGService(ec2Scope)
+	if err := ec2svc.RollbackLaunchTemplateVersion(machinePoolScope, version); err != nil {
+		return err
+	}
+
+	refreshID, err := asgSvc.StartASGInstanceRefresh(machinePoolScope)
+	if err != nil {
+		return err
+	}
+	machinePoolScope.AWSMachinePool.Status.InstanceRefresh = &infrav1exp.InstanceRefreshStatus{
+		ID:                 refreshID,
+		Status:             autoscaling.InstanceRefreshStatusPending,
+		Rollback:           true,
+		ObservedGeneration: refresh.ObservedGeneration,
+	}
+
+	message := fmt.Sprintf("Instance refresh %s is %s, rolled back launch template to version %d", refresh.ID, strings.ToLower(refresh.Status), version)
+	if refresh.StatusReason != "" {
+		message = fmt.Sprintf("%s: %s", message, refresh.StatusReason)
+	}
+	conditions.MarkFalse(machinePoolScope.AWSMachinePool, infrav1exp.InstanceRefreshStartedCondition, infrav1exp.InstanceRefreshRolledBackReason, clusterv1.ConditionSeverityWarning, message)
+	r.Recorder.Event(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "RolledBackInstanceRefresh", message)
+
+	return nil
+}
+
 func (r *AWSMachinePoolReconciler) reconcileTags(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) error {
 	ec2Svc := r.getEC2Service(ec2Scope)
 	asgSvc := r.getASGService(clusterScope)
@@ -483,7 +697,7 @@ func (r *AWSMachinePoolReconciler) reconcileTags(machinePoolScope *scope.Machine
 
 // asgNeedsUpdates compares incoming AWSMachinePool and compares against existing ASG
 func asgNeedsUpdates(machinePoolScope *scope.MachinePoolScope, existingASG *infrav1exp.AutoScalingGroup) bool {
-	if machinePoolScope.MachinePool.Spec.Replicas != nil && machinePoolScope.MachinePool.Spec.Replicas != existingASG.DesiredCapacity {
+	if machinePoolScope.MachinePool.Spec.Replicas != nil && !machinePoolScope.ReplicasExternallyManaged() && machinePoolScope.MachinePool.Spec.Replicas != existingASG.DesiredCapacity {
 		return true
 	}
 
@@ -509,6 +723,25 @@ func asgNeedsUpdates(machinePoolScope *scope.MachinePoolScope, existingASG *infr
 	return false
 }
 
+// warmPoolNeedsUpdates compares the warm pool of the incoming AWSMachinePool against the one of the existing ASG
+func warmPoolNeedsUpdates(machinePoolScope *scope.MachinePoolScope, existingASG *infrav1exp.AutoScalingGroup) bool {
+	desired := machinePoolScope.AWSMachinePool.Spec.WarmPool
+	existing := existingASG.WarmPool
+	if desired == nil || existing == nil {
+		return desired != existing
+	}
+
+	desiredState := desired.PoolState
+	if desiredState == "" {
+		desiredState = infrav1exp.WarmPoolStateStopped
+	}
+
+	return desired.MinSize != existing.MinSize ||
+		!reflect.DeepEqual(desired.MaxPreparedCapacity, existing.MaxPreparedCapacity) ||
+		desiredState != existing.PoolState ||
+		desired.ReuseOnScaleIn != existing.ReuseOnScaleIn
+}
+
 // getOwnerMachinePool returns the MachinePool object owning the current resource.
 func getOwnerMachinePool(ctx context.Context, c client.Client, obj metav1.ObjectMeta) (*capiv1exp.MachinePool, error) {
 	for _, ref := range obj.OwnerReferences {
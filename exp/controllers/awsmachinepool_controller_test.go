@@ -21,7 +21,10 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -29,6 +32,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/pointer"
@@ -44,6 +48,7 @@ import (
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("AWSMachinePoolReconciler", func() {
@@ -142,6 +147,7 @@ var _ = Describe("AWSMachinePoolReconciler", func() {
 			asgServiceFactory: func(cloud.ClusterScoper) services.ASGInterface {
 				return asgSvc
 			},
+			Client:   testEnv.Client,
 			Recorder: recorder,
 		}
 	})
@@ -215,6 +221,7 @@ var _ = Describe("AWSMachinePoolReconciler", func() {
 				Expect(err).To(BeNil())
 
 				ms.AWSMachinePool.Spec.ProviderID = id
+				asgSvc.EXPECT().DescribeLatestInstanceRefresh(gomock.Any()).Return(nil, nil).AnyTimes()
 			})
 
 			It("it should look up by provider ID when one exists", func() {
@@ -294,6 +301,238 @@ var _ = Describe("AWSMachinePoolReconciler", func() {
 	})
 })
 
+var _ = Describe("AWSMachinePoolReconciler instance refresh", func() {
+	var (
+		reconciler AWSMachinePoolReconciler
+		cs         *scope.ClusterScope
+		ms         *scope.MachinePoolScope
+		mockCtrl   *gomock.Controller
+		ec2Svc     *mock_services.MockEC2MachineInterface
+		asgSvc     *mock_services.MockASGInterface
+		recorder   *record.FakeRecorder
+		startTime  metav1.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		cs, err = scope.NewClusterScope(
+			scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			},
+		)
+		Expect(err).To(BeNil())
+
+		awsMachinePool := &expinfrav1.AWSMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test",
+				Namespace:  "default",
+				Generation: 2,
+			},
+		}
+		ms, err = scope.NewMachinePoolScope(
+			scope.MachinePoolScopeParams{
+				Client:         fake.NewFakeClientWithScheme(scheme.Scheme),
+				Cluster:        &clusterv1.Cluster{},
+				MachinePool:    &expclusterv1.MachinePool{},
+				InfraCluster:   cs,
+				AWSMachinePool: awsMachinePool,
+			},
+		)
+		Expect(err).To(BeNil())
+
+		mockCtrl = gomock.NewController(GinkgoT())
+		ec2Svc = mock_services.NewMockEC2MachineInterface(mockCtrl)
+		asgSvc = mock_services.NewMockASGInterface(mockCtrl)
+		recorder = record.NewFakeRecorder(2)
+		startTime = metav1.NewTime(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC))
+
+		reconciler = AWSMachinePoolReconciler{
+			ec2ServiceFactory: func(scope.EC2Scope) services.EC2MachineInterface {
+				return ec2Svc
+			},
+			asgServiceFactory: func(cloud.ClusterScoper) services.ASGInterface {
+				return asgSvc
+			},
+			Recorder: recorder,
+		}
+	})
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	// startedRefresh is the status the controller records when it starts an instance refresh to roll out version 4
+	// of the launch template.
+	startedRefresh := func() *expinfrav1.InstanceRefreshStatus {
+		return &expinfrav1.InstanceRefreshStatus{
+			ID:                            "refresh-1",
+			Status:                        autoscaling.InstanceRefreshStatusPending,
+			PreviousLaunchTemplateVersion: aws.Int64(3),
+			ObservedGeneration:            2,
+		}
+	}
+
+	It("should do nothing when the group has no instance refresh", func() {
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(nil, nil)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(Succeed())
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh).To(BeNil())
+	})
+
+	It("should keep what was recorded when the instance refresh was started", func() {
+		ms.AWSMachinePool.Status.InstanceRefresh = startedRefresh()
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(&expinfrav1.InstanceRefreshStatus{
+			ID:                 "refresh-1",
+			Status:             autoscaling.InstanceRefreshStatusInProgress,
+			PercentageComplete: aws.Int64(50),
+			StartTime:          &startTime,
+		}, nil)
+		asgSvc.EXPECT().HasFailedLaunchActivities(ms, startTime.Time).Return(false, nil)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(Succeed())
+		refresh := ms.AWSMachinePool.Status.InstanceRefresh
+		Expect(refresh.Status).To(Equal(autoscaling.InstanceRefreshStatusInProgress))
+		Expect(aws.Int64Value(refresh.PercentageComplete)).To(Equal(int64(50)))
+		Expect(aws.Int64Value(refresh.PreviousLaunchTemplateVersion)).To(Equal(int64(3)))
+		Expect(refresh.ObservedGeneration).To(Equal(int64(2)))
+	})
+
+	It("should cancel an instance refresh whose new instances fail to launch", func() {
+		ms.AWSMachinePool.Status.InstanceRefresh = startedRefresh()
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(&expinfrav1.InstanceRefreshStatus{
+			ID:        "refresh-1",
+			Status:    autoscaling.InstanceRefreshStatusInProgress,
+			StartTime: &startTime,
+		}, nil)
+		asgSvc.EXPECT().HasFailedLaunchActivities(ms, startTime.Time).Return(true, nil)
+		asgSvc.EXPECT().CancelASGInstanceRefresh(ms).Return(nil)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(Succeed())
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.CancelledByController).To(BeTrue())
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("CancelledInstanceRefresh")))
+	})
+
+	It("should return the error of a failed cancellation", func() {
+		ms.AWSMachinePool.Status.InstanceRefresh = startedRefresh()
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(&expinfrav1.InstanceRefreshStatus{
+			ID:        "refresh-1",
+			Status:    autoscaling.InstanceRefreshStatusInProgress,
+			StartTime: &startTime,
+		}, nil)
+		asgSvc.EXPECT().HasFailedLaunchActivities(ms, startTime.Time).Return(true, nil)
+		expectedErr := errors.New("no connection available")
+		asgSvc.EXPECT().CancelASGInstanceRefresh(ms).Return(expectedErr)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(MatchError(expectedErr))
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.CancelledByController).To(BeFalse())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should roll back the launch template of a failed instance refresh", func() {
+		ms.AWSMachinePool.Status.InstanceRefresh = startedRefresh()
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(&expinfrav1.InstanceRefreshStatus{
+			ID:           "refresh-1",
+			Status:       autoscaling.InstanceRefreshStatusFailed,
+			StatusReason: "instances failed to launch",
+		}, nil)
+		gomock.InOrder(
+			ec2Svc.EXPECT().RollbackLaunchTemplateVersion(ms, int64(3)).Return(nil),
+			asgSvc.EXPECT().StartASGInstanceRefresh(ms).Return("refresh-2", nil),
+		)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(Succeed())
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh).To(Equal(&expinfrav1.InstanceRefreshStatus{
+			ID:                 "refresh-2",
+			Status:             autoscaling.InstanceRefreshStatusPending,
+			Rollback:           true,
+			ObservedGeneration: 2,
+		}))
+		expectConditions(ms.AWSMachinePool, []conditionAssertion{{
+			conditionType: expinfrav1.InstanceRefreshStartedCondition,
+			status:        corev1.ConditionFalse,
+			severity:      clusterv1.ConditionSeverityWarning,
+			reason:        expinfrav1.InstanceRefreshRolledBackReason,
+		}})
+		Expect(conditions.GetMessage(ms.AWSMachinePool, expinfrav1.InstanceRefreshStartedCondition)).To(
+			Equal("Instance refresh refresh-1 is failed, rolled back launch template to version 3: instances failed to launch"))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("RolledBackInstanceRefresh")))
+	})
+
+	It("should roll back the launch template of an instance refresh cancelled by the controller", func() {
+		ms.AWSMachinePool.Status.InstanceRefresh = startedRefresh()
+		ms.AWSMachinePool.Status.InstanceRefresh.CancelledByController = true
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(&expinfrav1.InstanceRefreshStatus{
+			ID:     "refresh-1",
+			Status: autoscaling.InstanceRefreshStatusCancelled,
+		}, nil)
+		ec2Svc.EXPECT().RollbackLaunchTemplateVersion(ms, int64(3)).Return(nil)
+		asgSvc.EXPECT().StartASGInstanceRefresh(ms).Return("refresh-2", nil)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(Succeed())
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.ID).To(Equal("refresh-2"))
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.Rollback).To(BeTrue())
+		Expect(conditions.GetMessage(ms.AWSMachinePool, expinfrav1.InstanceRefreshStartedCondition)).To(
+			Equal("Instance refresh refresh-1 is cancelled, rolled back launch template to version 3"))
+	})
+
+	It("should not roll back an instance refresh cancelled by an operator", func() {
+		ms.AWSMachinePool.Status.InstanceRefresh = startedRefresh()
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(&expinfrav1.InstanceRefreshStatus{
+			ID:     "refresh-1",
+			Status: autoscaling.InstanceRefreshStatusCancelled,
+		}, nil)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(Succeed())
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.ID).To(Equal("refresh-1"))
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.Status).To(Equal(autoscaling.InstanceRefreshStatusCancelled))
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should not start a rollback refresh when the launch template fails to roll back", func() {
+		ms.AWSMachinePool.Status.InstanceRefresh = startedRefresh()
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(&expinfrav1.InstanceRefreshStatus{
+			ID:     "refresh-1",
+			Status: autoscaling.InstanceRefreshStatusFailed,
+		}, nil)
+		expectedErr := errors.New("no connection available")
+		ec2Svc.EXPECT().RollbackLaunchTemplateVersion(ms, int64(3)).Return(expectedErr)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(MatchError(expectedErr))
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.ID).To(Equal("refresh-1"))
+		Expect(aws.Int64Value(ms.AWSMachinePool.Status.InstanceRefresh.PreviousLaunchTemplateVersion)).To(Equal(int64(3)))
+	})
+
+	It("should not roll back a failed rollback refresh", func() {
+		ms.AWSMachinePool.Status.InstanceRefresh = &expinfrav1.InstanceRefreshStatus{
+			ID:                 "refresh-2",
+			Status:             autoscaling.InstanceRefreshStatusPending,
+			Rollback:           true,
+			ObservedGeneration: 2,
+		}
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(&expinfrav1.InstanceRefreshStatus{
+			ID:     "refresh-2",
+			Status: autoscaling.InstanceRefreshStatusFailed,
+		}, nil)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(Succeed())
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.Status).To(Equal(autoscaling.InstanceRefreshStatusFailed))
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.Rollback).To(BeTrue())
+	})
+
+	It("should not roll back an instance refresh started outside of the controller", func() {
+		ms.AWSMachinePool.Status.InstanceRefresh = startedRefresh()
+		asgSvc.EXPECT().DescribeLatestInstanceRefresh(ms).Return(&expinfrav1.InstanceRefreshStatus{
+			ID:     "refresh-external",
+			Status: autoscaling.InstanceRefreshStatusCancelled,
+		}, nil)
+
+		Expect(reconciler.reconcileInstanceRefresh(ms, cs)).To(Succeed())
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.ID).To(Equal("refresh-external"))
+		Expect(ms.AWSMachinePool.Status.InstanceRefresh.PreviousLaunchTemplateVersion).To(BeNil())
+	})
+})
+
 //TODO: This was taken from awsmachine_controller_test, i think it should be moved to elsewhere in both locations like test/helpers
 
 type conditionAssertion struct {
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/controllers"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// machinePoolMachineDrainTimeout bounds each attempt to drain the node of a deleted AWSMachinePoolMachine, which is
+// retried until it succeeds.
+const machinePoolMachineDrainTimeout = 20 * time.Second
+
+// reconcileMachinePoolMachines keeps an AWSMachinePoolMachine per instance of the ASG in sync with it. The instances
+// of deleted AWSMachinePoolMachines have their node drained and are terminated, and get replaced by the ASG.
+func (r *AWSMachinePoolReconciler) reconcileMachinePoolMachines(ctx context.Context, machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, asg *infrav1exp.AutoScalingGroup, warmInstances []infrav1.Instance, nodeStatusByProviderID map[string]*scope.NodeStatus) (ctrl.Result, error) {
+	machines, err := r.listMachinePoolMachines(ctx, machinePoolScope.AWSMachinePool)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	existing := map[string]*infrav1exp.AWSMachinePoolMachine{}
+	for i := range machines {
+		existing[machines[i].Spec.InstanceID] = &machines[i]
+	}
+
+	var result ctrl.Result
+	sync := func(instance infrav1.Instance, warm bool) error {
+		machine, ok := existing[instance.ID]
+		delete(existing, instance.ID)
+
+		if ok && !machine.DeletionTimestamp.IsZero() {
+			requeue, err := r.deleteMachinePoolMachine(ctx, machinePoolScope, clusterScope, machine)
+			if requeue {
+				result.RequeueAfter = machinePoolMachineDrainTimeout
+			}
+			return err
+		}
+
+		// Instances already being terminated are about to leave the group.
+		if !ok && strings.HasPrefix(string(instance.State), "Terminating") {
+			return nil
+		}
+
+		if !ok {
+			created, err := r.createMachinePoolMachine(ctx, machinePoolScope, instance.ID)
+			if err != nil {
+				return err
+			}
+			machine = created
+		}
+
+		return r.updateMachinePoolMachineStatus(ctx, machine, instance, warm, asg.InstanceLaunchTemplateVersions[instance.ID], nodeStatusByProviderID[machine.Spec.ProviderID])
+	}
+
+	for _, instance := range asg.Instances {
+		if err := sync(instance, false); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	for _, instance := range warmInstances {
+		if err := sync(instance, true); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The remaining AWSMachinePoolMachines stand for instances that left the group.
+	for _, machine := range existing {
+		if err := r.removeMachinePoolMachine(ctx, machine); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// deleteMachinePoolMachines removes the AWSMachinePoolMachines of a deleted AWSMachinePool, whose instances are
+// terminated along with the ASG.
+func (r *AWSMachinePoolReconciler) deleteMachinePoolMachines(ctx context.Context, awsMachinePool *infrav1exp.AWSMachinePool) error {
+	machines, err := r.listMachinePoolMachines(ctx, awsMachinePool)
+	if err != nil {
+		return err
+	}
+
+	for i := range machines {
+		if err := r.removeMachinePoolMachine(ctx, &machines[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *AWSMachinePoolReconciler) listMachinePoolMachines(ctx context.Context, awsMachinePool *infrav1exp.AWSMachinePool) ([]infrav1exp.AWSMachinePoolMachine, error) {
+	machines := &infrav1exp.AWSMachinePoolMachineList{}
+	if err := r.List(ctx, machines, client.InNamespace(awsMachinePool.Namespace), client.MatchingLabels{infrav1exp.AWSMachinePoolNameLabel: awsMachinePool.Name}); err != nil {
+		return nil, errors.Wrap(err, "failed to list AWSMachinePoolMachines")
+	}
+
+	return machines.Items, nil
+}
+
+func (r *AWSMachinePoolReconciler) createMachinePoolMachine(ctx context.Context, machinePoolScope *scope.MachinePoolScope, instanceID string) (*infrav1exp.AWSMachinePoolMachine, error) {
+	awsMachinePool := machinePoolScope.AWSMachinePool
+	machine := &infrav1exp.AWSMachinePoolMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", awsMachinePool.Name, instanceID),
+			Namespace: awsMachinePool.Namespace,
+			Labels: map[string]string{
+				clusterv1.ClusterLabelName:         machinePoolScope.Cluster.Name,
+				infrav1exp.AWSMachinePoolNameLabel: awsMachinePool.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(awsMachinePool, infrav1exp.GroupVersion.WithKind("AWSMachinePool")),
+			},
+			Finalizers: []string{infrav1exp.AWSMachinePoolMachineFinalizer},
+		},
+		Spec: infrav1exp.AWSMachinePoolMachineSpec{
+			ProviderID: fmt.Sprintf("aws:////%s", instanceID),
+			InstanceID: instanceID,
+		},
+	}
+
+	if err := r.Create(ctx, machine); err != nil {
+		return nil, errors.Wrapf(err, "failed to create AWSMachinePoolMachine for instance %q", instanceID)
+	}
+
+	return machine, nil
+}
+
+func (r *AWSMachinePoolReconciler) updateMachinePoolMachineStatus(ctx context.Context, machine *infrav1exp.AWSMachinePoolMachine, instance infrav1.Instance, warm bool, launchTemplateVersion string, nodeStatus *scope.NodeStatus) error {
+	patchHelper, err := patch.NewHelper(machine, r.Client)
+	if err != nil {
+		return err
+	}
+
+	machine.Status.LifecycleState = string(instance.State)
+	machine.Status.Warm = warm
+	machine.Status.LaunchTemplateVersion = nil
+	if launchTemplateVersion != "" {
+		machine.Status.LaunchTemplateVersion = &launchTemplateVersion
+	}
+
+	switch {
+	case nodeStatus == nil || nodeStatus.Name == "":
+		machine.Status.Ready = false
+		machine.Status.NodeRef = nil
+		machine.Status.Version = nil
+	default:
+		machine.Status.Ready = nodeStatus.Ready
+		machine.Status.NodeRef = &corev1.ObjectReference{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Node",
+			Name:       nodeStatus.Name,
+		}
+		machine.Status.Version = &nodeStatus.Version
+	}
+
+	return patchHelper.Patch(ctx, machine)
+}
+
+// deleteMachinePoolMachine drains the node of a deleted AWSMachinePoolMachine and terminates its instance. It returns
+// true if the drain has to be retried.
+func (r *AWSMachinePoolReconciler) deleteMachinePoolMachine(ctx context.Context, machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, machine *infrav1exp.AWSMachinePoolMachine) (bool, error) {
+	if !controllerutil.ContainsFinalizer(machine, infrav1exp.AWSMachinePoolMachineFinalizer) {
+		return false, nil
+	}
+
+	log := machinePoolScope.WithValues("awsMachinePoolMachine", machine.Name, "instanceID", machine.Spec.InstanceID)
+
+	patchHelper, err := patch.NewHelper(machine, r.Client)
+	if err != nil {
+		return false, err
+	}
+
+	if machine.Status.NodeRef != nil {
+		if err := r.drainMachinePoolMachineNode(ctx, log, machinePoolScope.Cluster, machine.Status.NodeRef.Name); err != nil {
+			log.Error(err, "failed to drain node, retrying")
+			conditions.MarkFalse(machine, clusterv1.DrainingSucceededCondition, clusterv1.DrainingFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			return true, patchHelper.Patch(ctx, machine)
+		}
+		conditions.MarkTrue(machine, clusterv1.DrainingSucceededCondition)
+	}
+
+	asgSvc := r.getASGService(clusterScope)
+	if err := asgSvc.TerminateASGInstance(machine.Spec.InstanceID); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedTerminateInstance", "Failed to terminate instance %q: %v", machine.Spec.InstanceID, err)
+		return false, err
+	}
+	r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeNormal, "SuccessfulTerminateInstance", "Terminated instance %q of deleted AWSMachinePoolMachine %q", machine.Spec.InstanceID, machine.Name)
+
+	controllerutil.RemoveFinalizer(machine, infrav1exp.AWSMachinePoolMachineFinalizer)
+	return false, patchHelper.Patch(ctx, machine)
+}
+
+// removeMachinePoolMachine deletes an AWSMachinePoolMachine whose instance is gone, without waiting on its finalizer.
+func (r *AWSMachinePoolReconciler) removeMachinePoolMachine(ctx context.Context, machine *infrav1exp.AWSMachinePoolMachine) error {
+	if controllerutil.ContainsFinalizer(machine, infrav1exp.AWSMachinePoolMachineFinalizer) {
+		patchHelper, err := patch.NewHelper(machine, r.Client)
+		if err != nil {
+			return err
+		}
+		controllerutil.RemoveFinalizer(machine, infrav1exp.AWSMachinePoolMachineFinalizer)
+		if err := patchHelper.Patch(ctx, machine); err != nil {
+			return err
+		}
+	}
+
+	if !machine.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if err := r.Delete(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete AWSMachinePoolMachine %q", machine.Name)
+	}
+
+	return nil
+}
+
+// drainMachinePoolMachineNode cordons and drains a node of the workload cluster.
+func (r *AWSMachinePoolReconciler) drainMachinePoolMachineNode(ctx context.Context, log logr.Logger, cluster *clusterv1.Cluster, nodeName string) error {
+	kubeClient, err := r.getRemoteKubeClient(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	return controllers.DrainNode(ctx, log, kubeClient, nodeName, machinePoolMachineDrainTimeout)
+}
+
+func (r *AWSMachinePoolReconciler) getRemoteKubeClient(ctx context.Context, cluster *clusterv1.Cluster) (kubernetes.Interface, error) {
+	if r.remoteKubeClientFactory != nil {
+		return r.remoteKubeClientFactory(cluster)
+	}
+
+	return controllers.RemoteKubeClient(ctx, r.Client, cluster)
+}
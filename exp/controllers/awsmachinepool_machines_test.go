@@ -0,0 +1,358 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("AWSMachinePoolReconciler machines", func() {
+	var (
+		reconciler AWSMachinePoolReconciler
+		cs         *scope.ClusterScope
+		ms         *scope.MachinePoolScope
+		mockCtrl   *gomock.Controller
+		asgSvc     *mock_services.MockASGInterface
+		kubeClient *kubefake.Clientset
+		remoteErr  error
+	)
+
+	awsMachinePool := func() *expinfrav1.AWSMachinePool {
+		return &expinfrav1.AWSMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pool",
+				Namespace: "default",
+				UID:       "pool-uid",
+			},
+		}
+	}
+
+	// machinePoolMachine returns the AWSMachinePoolMachine the controller creates for an instance.
+	machinePoolMachine := func(instanceID string) *expinfrav1.AWSMachinePoolMachine {
+		return &expinfrav1.AWSMachinePoolMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "pool-" + instanceID,
+				Namespace:       "default",
+				ResourceVersion: "1",
+				Labels: map[string]string{
+					expinfrav1.AWSMachinePoolNameLabel: "pool",
+				},
+				Finalizers: []string{expinfrav1.AWSMachinePoolMachineFinalizer},
+			},
+			Spec: expinfrav1.AWSMachinePoolMachineSpec{
+				ProviderID: "aws:////" + instanceID,
+				InstanceID: instanceID,
+			},
+		}
+	}
+
+	deletedMachinePoolMachine := func(instanceID, nodeName string) *expinfrav1.AWSMachinePoolMachine {
+		machine := machinePoolMachine(instanceID)
+		machine.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		if nodeName != "" {
+			machine.Status.NodeRef = &corev1.ObjectReference{Kind: "Node", Name: nodeName}
+		}
+		return machine
+	}
+
+	setup := func(objects ...runtime.Object) {
+		var err error
+
+		cs, err = scope.NewClusterScope(
+			scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			},
+		)
+		Expect(err).To(BeNil())
+
+		c := fake.NewFakeClientWithScheme(scheme.Scheme, objects...)
+		ms, err = scope.NewMachinePoolScope(
+			scope.MachinePoolScopeParams{
+				Client: c,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+				},
+				MachinePool:    &expclusterv1.MachinePool{},
+				InfraCluster:   cs,
+				AWSMachinePool: awsMachinePool(),
+			},
+		)
+		Expect(err).To(BeNil())
+
+		reconciler.Client = c
+	}
+
+	getMachine := func(name string) (*expinfrav1.AWSMachinePoolMachine, error) {
+		machine := &expinfrav1.AWSMachinePoolMachine{}
+		err := reconciler.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: name}, machine)
+		return machine, err
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		asgSvc = mock_services.NewMockASGInterface(mockCtrl)
+		kubeClient = kubefake.NewSimpleClientset()
+		remoteErr = nil
+
+		reconciler = AWSMachinePoolReconciler{
+			asgServiceFactory: func(cloud.ClusterScoper) services.ASGInterface {
+				return asgSvc
+			},
+			remoteKubeClientFactory: func(*clusterv1.Cluster) (kubernetes.Interface, error) {
+				if remoteErr != nil {
+					return nil, remoteErr
+				}
+				return kubeClient, nil
+			},
+			Recorder: record.NewFakeRecorder(2),
+		}
+	})
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("syncing the instances of the group", func() {
+		It("should create an AWSMachinePoolMachine per instance", func() {
+			setup()
+			asg := &expinfrav1.AutoScalingGroup{
+				Instances: []infrav1.Instance{
+					{ID: "i-1", State: "InService"},
+				},
+				InstanceLaunchTemplateVersions: map[string]string{"i-1": "3"},
+			}
+			warmInstances := []infrav1.Instance{
+				{ID: "i-2", State: "Warmed:Stopped"},
+			}
+			nodeStatuses := map[string]*scope.NodeStatus{
+				"aws:////i-1": {Name: "node-1", Ready: true, Version: "v1.19.1"},
+			}
+
+			result, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, asg, warmInstances, nodeStatuses)
+			Expect(err).To(BeNil())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			machine, err := getMachine("pool-i-1")
+			Expect(err).To(BeNil())
+			Expect(machine.Labels).To(HaveKeyWithValue(expinfrav1.AWSMachinePoolNameLabel, "pool"))
+			Expect(machine.Finalizers).To(ConsistOf(expinfrav1.AWSMachinePoolMachineFinalizer))
+			Expect(machine.OwnerReferences).To(HaveLen(1))
+			Expect(machine.Spec.ProviderID).To(Equal("aws:////i-1"))
+			Expect(machine.Status.LifecycleState).To(Equal("InService"))
+			Expect(machine.Status.Warm).To(BeFalse())
+			Expect(*machine.Status.LaunchTemplateVersion).To(Equal("3"))
+			Expect(machine.Status.Ready).To(BeTrue())
+			Expect(machine.Status.NodeRef.Name).To(Equal("node-1"))
+			Expect(*machine.Status.Version).To(Equal("v1.19.1"))
+
+			warm, err := getMachine("pool-i-2")
+			Expect(err).To(BeNil())
+			Expect(warm.Status.Warm).To(BeTrue())
+			Expect(warm.Status.LaunchTemplateVersion).To(BeNil())
+			Expect(warm.Status.Ready).To(BeFalse())
+			Expect(warm.Status.NodeRef).To(BeNil())
+		})
+
+		It("should update the status of an existing AWSMachinePoolMachine", func() {
+			existing := machinePoolMachine("i-1")
+			existing.Status.Ready = true
+			existing.Status.NodeRef = &corev1.ObjectReference{Kind: "Node", Name: "node-1"}
+			setup(existing)
+			asg := &expinfrav1.AutoScalingGroup{
+				Instances: []infrav1.Instance{
+					{ID: "i-1", State: "InService"},
+				},
+			}
+			nodeStatuses := map[string]*scope.NodeStatus{
+				"aws:////i-1": {Name: "node-1", Ready: false, Version: "v1.19.1"},
+			}
+
+			_, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, asg, nil, nodeStatuses)
+			Expect(err).To(BeNil())
+
+			machine, err := getMachine("pool-i-1")
+			Expect(err).To(BeNil())
+			Expect(machine.Status.Ready).To(BeFalse())
+			Expect(machine.Status.NodeRef.Name).To(Equal("node-1"))
+		})
+
+		It("should clear the node of an instance whose node is gone", func() {
+			existing := machinePoolMachine("i-1")
+			existing.Status.Ready = true
+			existing.Status.NodeRef = &corev1.ObjectReference{Kind: "Node", Name: "node-1"}
+			setup(existing)
+			asg := &expinfrav1.AutoScalingGroup{
+				Instances: []infrav1.Instance{
+					{ID: "i-1", State: "InService"},
+				},
+			}
+
+			_, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, asg, nil, nil)
+			Expect(err).To(BeNil())
+
+			machine, err := getMachine("pool-i-1")
+			Expect(err).To(BeNil())
+			Expect(machine.Status.Ready).To(BeFalse())
+			Expect(machine.Status.NodeRef).To(BeNil())
+			Expect(machine.Status.Version).To(BeNil())
+		})
+
+		It("should not create an AWSMachinePoolMachine for a terminating instance", func() {
+			setup()
+			asg := &expinfrav1.AutoScalingGroup{
+				Instances: []infrav1.Instance{
+					{ID: "i-1", State: "Terminating:Wait"},
+				},
+			}
+
+			_, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, asg, nil, nil)
+			Expect(err).To(BeNil())
+
+			_, err = getMachine("pool-i-1")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should remove the AWSMachinePoolMachine of an instance that left the group", func() {
+			setup(machinePoolMachine("i-1"))
+
+			_, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, &expinfrav1.AutoScalingGroup{}, nil, nil)
+			Expect(err).To(BeNil())
+
+			_, err = getMachine("pool-i-1")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("deleting an AWSMachinePoolMachine", func() {
+		asg := &expinfrav1.AutoScalingGroup{
+			Instances: []infrav1.Instance{
+				{ID: "i-1", State: "InService"},
+			},
+		}
+
+		It("should drain the node and terminate the instance", func() {
+			setup(deletedMachinePoolMachine("i-1", "node-1"))
+			_, err := kubeClient.CoreV1().Nodes().Create(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+			Expect(err).To(BeNil())
+			asgSvc.EXPECT().TerminateASGInstance("i-1").Return(nil)
+
+			result, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, asg, nil, nil)
+			Expect(err).To(BeNil())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			node, err := kubeClient.CoreV1().Nodes().Get("node-1", metav1.GetOptions{})
+			Expect(err).To(BeNil())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+
+			machine, err := getMachine("pool-i-1")
+			Expect(err).To(BeNil())
+			Expect(controllerutil.ContainsFinalizer(machine, expinfrav1.AWSMachinePoolMachineFinalizer)).To(BeFalse())
+			Expect(conditions.IsTrue(machine, clusterv1.DrainingSucceededCondition)).To(BeTrue())
+		})
+
+		It("should terminate the instance of a node that is already gone", func() {
+			setup(deletedMachinePoolMachine("i-1", "node-1"))
+			asgSvc.EXPECT().TerminateASGInstance("i-1").Return(nil)
+
+			_, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, asg, nil, nil)
+			Expect(err).To(BeNil())
+
+			machine, err := getMachine("pool-i-1")
+			Expect(err).To(BeNil())
+			Expect(controllerutil.ContainsFinalizer(machine, expinfrav1.AWSMachinePoolMachineFinalizer)).To(BeFalse())
+		})
+
+		It("should terminate the instance without draining when it has no node", func() {
+			setup(deletedMachinePoolMachine("i-1", ""))
+			remoteErr = errors.New("no workload cluster access expected")
+			asgSvc.EXPECT().TerminateASGInstance("i-1").Return(nil)
+
+			_, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, asg, nil, nil)
+			Expect(err).To(BeNil())
+
+			machine, err := getMachine("pool-i-1")
+			Expect(err).To(BeNil())
+			Expect(controllerutil.ContainsFinalizer(machine, expinfrav1.AWSMachinePoolMachineFinalizer)).To(BeFalse())
+			Expect(conditions.Has(machine, clusterv1.DrainingSucceededCondition)).To(BeFalse())
+		})
+
+		It("should retry a failed drain without terminating the instance", func() {
+			setup(deletedMachinePoolMachine("i-1", "node-1"))
+			remoteErr = errors.New("failed to create remote client")
+
+			result, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, asg, nil, nil)
+			Expect(err).To(BeNil())
+			Expect(result.RequeueAfter).To(Equal(machinePoolMachineDrainTimeout))
+
+			machine, err := getMachine("pool-i-1")
+			Expect(err).To(BeNil())
+			Expect(controllerutil.ContainsFinalizer(machine, expinfrav1.AWSMachinePoolMachineFinalizer)).To(BeTrue())
+			Expect(conditions.IsFalse(machine, clusterv1.DrainingSucceededCondition)).To(BeTrue())
+			Expect(conditions.GetReason(machine, clusterv1.DrainingSucceededCondition)).To(Equal(clusterv1.DrainingFailedReason))
+		})
+
+		It("should keep the finalizer when the instance fails to terminate", func() {
+			setup(deletedMachinePoolMachine("i-1", ""))
+			asgSvc.EXPECT().TerminateASGInstance("i-1").Return(errors.New("an error"))
+
+			_, err := reconciler.reconcileMachinePoolMachines(context.TODO(), ms, cs, asg, nil, nil)
+			Expect(err).NotTo(BeNil())
+
+			machine, err := getMachine("pool-i-1")
+			Expect(err).To(BeNil())
+			Expect(controllerutil.ContainsFinalizer(machine, expinfrav1.AWSMachinePoolMachineFinalizer)).To(BeTrue())
+		})
+	})
+
+	It("should remove the AWSMachinePoolMachines of a deleted AWSMachinePool", func() {
+		setup(machinePoolMachine("i-1"), deletedMachinePoolMachine("i-2", "node-2"))
+
+		Expect(reconciler.deleteMachinePoolMachines(context.TODO(), ms.AWSMachinePool)).To(Succeed())
+
+		_, err := getMachine("pool-i-1")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		machine, err := getMachine("pool-i-2")
+		Expect(err).To(BeNil())
+		Expect(controllerutil.ContainsFinalizer(machine, expinfrav1.AWSMachinePoolMachineFinalizer)).To(BeFalse())
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/go-logr/logr"
@@ -35,6 +36,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -45,12 +47,18 @@ type AwsInstanceStateReconciler struct {
 	client.Client
 	Log               logr.Logger
 	sqsServiceFactory func() sqsiface.SQSAPI
+	asgServiceFactory func() autoscalingiface.AutoScalingAPI
 	queueURLs         sync.Map
 	Endpoints         []scope.ServiceEndpoint
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;machines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=exp.cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *AwsInstanceStateReconciler) getSQSService(region string) (sqsiface.SQSAPI, error) {
 	if r.sqsServiceFactory != nil {
@@ -69,6 +77,23 @@ func (r *AwsInstanceStateReconciler) getSQSService(region string) (sqsiface.SQSA
 	return scope.NewGlobalSQSClient(globalScope, globalScope), nil
 }
 
+func (r *AwsInstanceStateReconciler) getASGService(region string) (autoscalingiface.AutoScalingAPI, error) {
+	if r.asgServiceFactory != nil {
+		return r.asgServiceFactory(), nil
+	}
+
+	globalScope, err := scope.NewGlobalScope(scope.GlobalScopeParams{
+		ControllerName: "awsinstancestate",
+		Region:         region,
+		Endpoints:      r.Endpoints,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return scope.NewGlobalASGClient(globalScope, globalScope), nil
+}
+
 func (r *AwsInstanceStateReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.TODO()
 	_ = r.Log.WithValues("namespace", req.NamespacedName, "awsInstanceState", req.Name)
@@ -97,16 +122,26 @@ func (r *AwsInstanceStateReconciler) Reconcile(req ctrl.Request) (ctrl.Result, e
 		if err != nil {
 			return reconcile.Result{}, err
 		}
-		r.queueURLs.Store(awsCluster.Name, queueParams{region: awsCluster.Spec.Region, URL: URL})
+		r.queueURLs.Store(awsCluster.Name, newQueueParams(awsCluster, URL))
 	}
 
 	return ctrl.Result{}, nil
 }
 
 func (r *AwsInstanceStateReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
-	go func() {
-		r.watchQueuesForInstanceEvents()
-	}()
+	// The queues are watched for as long as the manager runs, which cancels the drains in progress when it stops.
+	if err := mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			<-stop
+			cancel()
+		}()
+		r.watchQueuesForInstanceEvents(ctx)
+		return nil
+	})); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1.AWSCluster{}).
 		WithOptions(options).
@@ -114,17 +149,27 @@ func (r *AwsInstanceStateReconciler) SetupWithManager(mgr ctrl.Manager, options
 		Complete(r)
 }
 
-func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
-	ctx := context.TODO()
+func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents(ctx context.Context) {
 	awsClusterList := &infrav1.AWSClusterList{}
 	if err := r.Client.List(ctx, awsClusterList); err == nil {
 		for i, cluster := range awsClusterList.Items {
 			if URL, err := r.getQueueURL(&awsClusterList.Items[i]); err == nil {
-				r.queueURLs.Store(cluster.Name, queueParams{region: cluster.Spec.Region, URL: URL})
+				r.queueURLs.Store(cluster.Name, newQueueParams(&awsClusterList.Items[i], URL))
 			}
 		}
 	}
-	for range time.Tick(1 * time.Second) {
+	r.resumeInterruptionDrains(ctx)
+	r.resumeLifecycleActions(ctx)
+
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
 		// go through each cluster and check for messages on its queue
 		r.queueURLs.Range(func(key, val interface{}) bool {
 			go func() {
@@ -148,7 +193,7 @@ func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
 						return
 					}
 					// TODO: handle errors during process message. We currently deletes the message regardless.
-					r.processMessage(ctx, m)
+					r.processMessage(ctx, qp, m)
 
 					_, err = sqsSvs.DeleteMessage(&sqs.DeleteMessageInput{
 						QueueUrl:      aws.String(qp.URL),
@@ -166,43 +211,71 @@ func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
 	}
 }
 
-// processMessage triggers a reconcile on an AWSMachine if its EC2 instance state changed.
-func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, msg message) {
-	if msg.Source != "aws.ec2" || msg.DetailType != instancestate.Ec2StateChangeNotification || msg.MessageDetail == nil {
+// processMessage handles the EC2 and Auto Scaling events delivered to the queue of a cluster.
+func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, qp queueParams, msg message) {
+	if msg.MessageDetail == nil {
 		return
 	}
 
-	// Fetch the awsMachine instance by InstanceID
-	awsMachines := &infrav1.AWSMachineList{}
-	err := r.List(ctx, awsMachines, client.MatchingFields{controllers.InstanceIDIndex: msg.MessageDetail.InstanceID})
+	switch msg.Source {
+	case "aws.ec2":
+		switch msg.DetailType {
+		case instancestate.Ec2StateChangeNotification:
+			r.processStateChange(ctx, msg)
+		case instancestate.Ec2SpotInterruptionWarning:
+			r.processInterruption(ctx, msg, infrav1.InterruptionSpotInterruption)
+		case instancestate.Ec2RebalanceRecommendation:
+			r.processInterruption(ctx, msg, infrav1.InterruptionRebalanceRecommendation)
+		}
+	case "aws.autoscaling":
+		if msg.DetailType == instancestate.AutoScalingTerminateLifecycleAction {
+			r.processTerminateLifecycleAction(ctx, qp, msg)
+		}
+	}
+}
 
+// processStateChange triggers a reconcile on an AWSMachine if its EC2 instance state changed.
+func (r *AwsInstanceStateReconciler) processStateChange(ctx context.Context, msg message) {
+	machine := r.getAWSMachine(ctx, msg.MessageDetail.InstanceID)
+	if machine == nil {
+		return
+	}
+
+	patchHelper, err := patch.NewHelper(machine, r.Client)
 	if err != nil {
-		r.Log.Error(err, "unable to list machines by instance ID", "instanceID", msg.MessageDetail.InstanceID)
+		r.Log.Error(err, "unable to create patch helper")
+		return
+	}
+	// Trigger an update on the machine
+	labels := machine.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
 	}
 
-	if len(awsMachines.Items) > 0 {
-		machine := awsMachines.Items[0]
-		if !machine.ObjectMeta.DeletionTimestamp.IsZero() {
-			return
-		}
-		patchHelper, err := patch.NewHelper(&machine, r.Client)
-		if err != nil {
-			r.Log.Error(err, "unable to create patch helper")
-		}
-		// Trigger an update on the machine
-		labels := machine.GetLabels()
-		if labels == nil {
-			labels = make(map[string]string)
-		}
+	labels[Ec2InstanceStateLabelKey] = string(msg.MessageDetail.State)
+	machine.SetLabels(labels)
+
+	err = patchHelper.Patch(ctx, machine)
+	if err != nil {
+		r.Log.Error(err, "unable to patch AWS machine")
+	}
+}
 
-		labels[Ec2InstanceStateLabelKey] = string(msg.MessageDetail.State)
-		machine.SetLabels(labels)
+// getAWSMachine returns the AWSMachine of the instance, or nil if there is none or it is being deleted.
+func (r *AwsInstanceStateReconciler) getAWSMachine(ctx context.Context, instanceID string) *infrav1.AWSMachine {
+	// Fetch the awsMachine instance by InstanceID
+	awsMachines := &infrav1.AWSMachineList{}
+	err := r.List(ctx, awsMachines, client.MatchingFields{controllers.InstanceIDIndex: instanceID})
 
-		err = patchHelper.Patch(ctx, &machine)
-		if err != nil {
-			r.Log.Error(err, "unable to patch AWS machine")
-		}
+	if err != nil {
+		r.Log.Error(err, "unable to list machines by instance ID", "instanceID", instanceID)
+		return nil
+	}
+
+	if len(awsMachines.Items) == 0 || !awsMachines.Items[0].ObjectMeta.DeletionTimestamp.IsZero() {
+		return nil
 	}
+	return &awsMachines.Items[0]
 }
 
 // getQueueURL retrieves the SQS queue URL for a given cluster
@@ -224,6 +297,18 @@ func (r *AwsInstanceStateReconciler) getQueueURL(cluster *infrav1.AWSCluster) (s
 type queueParams struct {
 	region string
 	URL    string
+	// namespace and awsClusterName identify the AWSCluster the queue belongs to.
+	namespace      string
+	awsClusterName string
+}
+
+func newQueueParams(awsCluster *infrav1.AWSCluster, URL string) queueParams {
+	return queueParams{
+		region:         awsCluster.Spec.Region,
+		URL:            URL,
+		namespace:      awsCluster.Namespace,
+		awsClusterName: awsCluster.Name,
+	}
 }
 
 type message struct {
@@ -233,6 +318,13 @@ type message struct {
 }
 
 type messageDetail struct {
-	InstanceID string                `json:"instance-id,omitempty"`
-	State      infrav1.InstanceState `json:"state,omitempty"`
+	InstanceID     string                `json:"instance-id,omitempty"`
+	State          infrav1.InstanceState `json:"state,omitempty"`
+	InstanceAction string                `json:"instance-action,omitempty"`
+
+	// Auto Scaling lifecycle actions use different keys than EC2 events.
+	AutoScalingGroupName string `json:"AutoScalingGroupName,omitempty"`
+	LifecycleHookName    string `json:"LifecycleHookName,omitempty"`
+	LifecycleActionToken string `json:"LifecycleActionToken,omitempty"`
+	EC2InstanceID        string `json:"EC2InstanceId,omitempty"`
 }
@@ -18,6 +18,7 @@ package instancestate
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -40,6 +41,10 @@ var _ = Describe("AWSInstanceStateController", func() {
 			Name:      "aws-cluster-1-instance-1",
 			Namespace: "default",
 		}
+		interruptedMachineMeta := metav1.ObjectMeta{
+			Name:      "aws-cluster-1-instance-2",
+			Namespace: "default",
+		}
 		sqsSvs.EXPECT().GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String("aws-cluster-1-queue")}).AnyTimes().
 			Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("aws-cluster-1-url")}, nil)
 		sqsSvs.EXPECT().GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String("aws-cluster-2-queue")}).AnyTimes().
@@ -57,10 +62,20 @@ var _ = Describe("AWSInstanceStateController", func() {
 				// start returning a message once the AWSMachine is available
 				if err == nil {
 					return &sqs.ReceiveMessageOutput{
-						Messages: []*sqs.Message{{
-							ReceiptHandle: aws.String("message-receipt-handle"),
-							Body:          aws.String(messageBodyJSON),
-						}},
+						Messages: []*sqs.Message{
+							{
+								ReceiptHandle: aws.String("message-receipt-handle"),
+								Body:          aws.String(messageBodyJSON),
+							},
+							{
+								ReceiptHandle: aws.String("spot-message-receipt-handle"),
+								Body:          aws.String(spotInterruptionMessageBodyJSON),
+							},
+							{
+								ReceiptHandle: aws.String("lifecycle-message-receipt-handle"),
+								Body:          aws.String(unmanagedLifecycleActionMessageBodyJSON),
+							},
+						},
 					}, nil
 				}
 
@@ -73,6 +88,16 @@ var _ = Describe("AWSInstanceStateController", func() {
 			Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{}}, nil)
 		sqsSvs.EXPECT().DeleteMessage(&sqs.DeleteMessageInput{QueueUrl: aws.String("aws-cluster-1-url"), ReceiptHandle: aws.String("message-receipt-handle")}).AnyTimes().
 			Return(nil, nil)
+		sqsSvs.EXPECT().DeleteMessage(&sqs.DeleteMessageInput{QueueUrl: aws.String("aws-cluster-1-url"), ReceiptHandle: aws.String("spot-message-receipt-handle")}).AnyTimes().
+			Return(nil, nil)
+		// The lifecycle action of a hook that no AWSMachinePool manages is left alone, so no Auto Scaling call is
+		// expected for it.
+		var lifecycleMessageDeleted int32
+		sqsSvs.EXPECT().DeleteMessage(&sqs.DeleteMessageInput{QueueUrl: aws.String("aws-cluster-1-url"), ReceiptHandle: aws.String("lifecycle-message-receipt-handle")}).AnyTimes().
+			DoAndReturn(func(arg *sqs.DeleteMessageInput) (*sqs.DeleteMessageOutput, error) {
+				atomic.StoreInt32(&lifecycleMessageDeleted, 1)
+				return nil, nil
+			})
 
 		Expect(k8sManager.GetFieldIndexer().IndexField(&infrav1.AWSMachine{},
 			controllers.InstanceIDIndex,
@@ -100,6 +125,16 @@ var _ = Describe("AWSInstanceStateController", func() {
 		persistObject(createAWSCluster("aws-cluster-1"))
 		persistObject(createAWSCluster("aws-cluster-2"))
 
+		// The interrupted machine is persisted first, as messages are returned once the failing machine exists.
+		machine2 := &infrav1.AWSMachine{
+			Spec: infrav1.AWSMachineSpec{
+				InstanceID:        pointer.StringPtr("i-interrupted-instance-2"),
+				SpotMarketOptions: &infrav1.SpotMarketOptions{},
+			},
+			ObjectMeta: interruptedMachineMeta,
+		}
+		persistObject(machine2)
+
 		machine1 := &infrav1.AWSMachine{
 			Spec: infrav1.AWSMachineSpec{
 				InstanceID: pointer.StringPtr("i-failing-instance-1"),
@@ -148,6 +183,22 @@ var _ = Describe("AWSInstanceStateController", func() {
 			val := labels[Ec2InstanceStateLabelKey]
 			return val == "shutting-down"
 		}, 10*time.Second).Should(Equal(true))
+
+		By("Ensuring interrupted machine is annotated")
+		Eventually(func() string {
+			m := &infrav1.AWSMachine{}
+			key := types.NamespacedName{
+				Namespace: interruptedMachineMeta.Namespace,
+				Name:      interruptedMachineMeta.Name,
+			}
+			Expect(k8sClient.Get(context.TODO(), key, m)).NotTo(HaveOccurred())
+			return m.GetAnnotations()[infrav1.InterruptionAnnotation]
+		}, 10*time.Second).Should(Equal(infrav1.InterruptionSpotInterruption))
+
+		By("Ensuring the lifecycle action of an unmanaged lifecycle hook is ignored")
+		Eventually(func() int32 {
+			return atomic.LoadInt32(&lifecycleMessageDeleted)
+		}, 10*time.Second).Should(Equal(int32(1)))
 	})
 })
 
@@ -159,3 +210,23 @@ const messageBodyJSON = `{
 		"state": "shutting-down"
 	}
 }`
+
+const spotInterruptionMessageBodyJSON = `{
+	"source": "aws.ec2",
+	"detail-type": "EC2 Spot Instance Interruption Warning",
+	"detail": {
+		"instance-id": "i-interrupted-instance-2",
+		"instance-action": "terminate"
+	}
+}`
+
+const unmanagedLifecycleActionMessageBodyJSON = `{
+	"source": "aws.autoscaling",
+	"detail-type": "EC2 Instance-terminate Lifecycle Action",
+	"detail": {
+		"AutoScalingGroupName": "unmanaged-asg",
+		"LifecycleHookName": "unmanaged-hook",
+		"LifecycleActionToken": "token",
+		"EC2InstanceId": "i-unmanaged-instance"
+	}
+}`
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/controllers"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// interruptionDrainTimeout bounds the drain of a node whose spot instance is going to be interrupted, which
+// leaves some of the two-minute notice for the pods to terminate.
+const interruptionDrainTimeout = 90 * time.Second
+
+// processInterruption annotates the AWSMachine of an instance that EC2 is going to interrupt or recommended to
+// rebalance, so that the AWSMachine controller reports it. The node of an instance that is going to be interrupted is
+// cordoned and drained, and the AWSMachine marked as failed so that the Machine gets replaced.
+func (r *AwsInstanceStateReconciler) processInterruption(ctx context.Context, msg message, interruption string) {
+	machine := r.getAWSMachine(ctx, msg.MessageDetail.InstanceID)
+	if machine == nil {
+		return
+	}
+
+	log := r.Log.WithValues("namespace", machine.Namespace, "awsMachine", machine.Name, "instanceID", msg.MessageDetail.InstanceID)
+
+	// A rebalance recommendation usually precedes the interruption warning, which must not be overwritten by it.
+	current := machine.Annotations[infrav1.InterruptionAnnotation]
+	if current == interruption || current == infrav1.InterruptionSpotInterruption {
+		return
+	}
+
+	patchHelper, err := patch.NewHelper(machine, r.Client)
+	if err != nil {
+		log.Error(err, "unable to create patch helper")
+		return
+	}
+
+	annotations := machine.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[infrav1.InterruptionAnnotation] = interruption
+	machine.SetAnnotations(annotations)
+
+	if err := patchHelper.Patch(ctx, machine); err != nil {
+		log.Error(err, "unable to patch AWS machine")
+		return
+	}
+	log.Info("EC2 announced the interruption of the instance", "interruption", interruption, "instanceAction", msg.MessageDetail.InstanceAction)
+
+	// The instance may still run for a long time after a rebalance recommendation, its node is kept schedulable.
+	if interruption == infrav1.InterruptionSpotInterruption {
+		r.drainInterruptedMachine(ctx, log, machine)
+	}
+}
+
+// resumeInterruptionDrains drains the nodes of the AWSMachines whose instance is going to be interrupted, as their
+// drain is cut short when the controller restarts.
+func (r *AwsInstanceStateReconciler) resumeInterruptionDrains(ctx context.Context) {
+	awsMachines := &infrav1.AWSMachineList{}
+	if err := r.List(ctx, awsMachines); err != nil {
+		r.Log.Error(err, "unable to list machines")
+		return
+	}
+
+	for i := range awsMachines.Items {
+		machine := &awsMachines.Items[i]
+		if machine.Annotations[infrav1.InterruptionAnnotation] != infrav1.InterruptionSpotInterruption || !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		r.drainInterruptedMachine(ctx, r.Log.WithValues("namespace", machine.Namespace, "awsMachine", machine.Name), machine)
+	}
+}
+
+// drainInterruptedMachine drains the node of the AWSMachine in the background, as draining takes a while, which must
+// not hold up the processing of the other messages. The drain is given up when the controller stops.
+func (r *AwsInstanceStateReconciler) drainInterruptedMachine(ctx context.Context, log logr.Logger, machine *infrav1.AWSMachine) {
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, interruptionDrainTimeout)
+		defer cancel()
+
+		if err := r.drainMachineNode(ctx, log, machine); err != nil {
+			log.Error(err, "unable to drain node of interrupted instance")
+		}
+	}()
+}
+
+// drainMachineNode cordons and drains the workload cluster node of the AWSMachine.
+func (r *AwsInstanceStateReconciler) drainMachineNode(ctx context.Context, log logr.Logger, awsMachine *infrav1.AWSMachine) error {
+	machine, err := util.GetOwnerMachine(ctx, r.Client, awsMachine.ObjectMeta)
+	if err != nil {
+		return errors.Wrap(err, "failed to get owner machine")
+	}
+	if machine == nil || machine.Status.NodeRef == nil {
+		log.Info("AWSMachine has no node to drain")
+		return nil
+	}
+
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machine.ObjectMeta)
+	if err != nil {
+		return errors.Wrap(err, "failed to get cluster")
+	}
+
+	kubeClient, err := controllers.RemoteKubeClient(ctx, r.Client, cluster)
+	if err != nil {
+		return err
+	}
+
+	return controllers.DrainNode(ctx, log, kubeClient, machine.Status.NodeRef.Name, interruptionDrainTimeout)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2/klogr"
+	"k8s.io/utils/pointer"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestProcessInterruption(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotation   string
+		interruption string
+		expected     string
+	}{
+		{
+			name:         "spot interruption",
+			interruption: infrav1.InterruptionSpotInterruption,
+			expected:     infrav1.InterruptionSpotInterruption,
+		},
+		{
+			name:         "rebalance recommendation",
+			interruption: infrav1.InterruptionRebalanceRecommendation,
+			expected:     infrav1.InterruptionRebalanceRecommendation,
+		},
+		{
+			name:         "spot interruption after rebalance recommendation",
+			annotation:   infrav1.InterruptionRebalanceRecommendation,
+			interruption: infrav1.InterruptionSpotInterruption,
+			expected:     infrav1.InterruptionSpotInterruption,
+		},
+		{
+			name:         "rebalance recommendation does not overwrite spot interruption",
+			annotation:   infrav1.InterruptionSpotInterruption,
+			interruption: infrav1.InterruptionRebalanceRecommendation,
+			expected:     infrav1.InterruptionSpotInterruption,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(infrav1.AddToScheme(scheme.Scheme)).To(Succeed())
+
+			machine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "machine",
+					Namespace: "default",
+				},
+				Spec: infrav1.AWSMachineSpec{
+					InstanceID:        pointer.StringPtr("i-1"),
+					SpotMarketOptions: &infrav1.SpotMarketOptions{},
+				},
+			}
+			if tc.annotation != "" {
+				machine.Annotations = map[string]string{infrav1.InterruptionAnnotation: tc.annotation}
+			}
+
+			r := &AwsInstanceStateReconciler{
+				Client: fake.NewFakeClientWithScheme(scheme.Scheme, machine),
+				Log:    klogr.New(),
+			}
+
+			r.processInterruption(context.TODO(), message{MessageDetail: &messageDetail{InstanceID: "i-1"}}, tc.interruption)
+
+			updated := &infrav1.AWSMachine{}
+			g.Expect(r.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "machine"}, updated)).To(Succeed())
+			g.Expect(updated.Annotations[infrav1.InterruptionAnnotation]).To(Equal(tc.expected))
+		})
+	}
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/controllers"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1alpha3"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	asg "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiv1exp "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// lifecycleActionCompletionMargin is kept from the heartbeat timeout of a lifecycle hook for the drain, so that the
+// lifecycle action is completed before the hook times out. The heartbeat timeout of a hook is at least 30 seconds.
+const lifecycleActionCompletionMargin = 10 * time.Second
+
+// processTerminateLifecycleAction cordons and drains the node of an instance whose termination is paused by a
+// lifecycle hook of an AWSMachinePool, and then completes the lifecycle action so the instance gets terminated.
+func (r *AwsInstanceStateReconciler) processTerminateLifecycleAction(ctx context.Context, qp queueParams, msg message) {
+	detail := msg.MessageDetail
+	log := r.Log.WithValues("autoScalingGroup", detail.AutoScalingGroupName, "lifecycleHook", detail.LifecycleHookName, "instanceID", detail.EC2InstanceID)
+
+	machinePool, hook := r.getLifecycleHook(ctx, qp, detail.AutoScalingGroupName, detail.LifecycleHookName)
+	if hook == nil {
+		log.V(4).Info("Lifecycle hook is not managed by an AWSMachinePool")
+		return
+	}
+	log = log.WithValues("namespace", machinePool.Namespace, "awsMachinePool", machinePool.Name)
+
+	r.drainAndCompleteLifecycleActions(ctx, log, qp.region, machinePool, []*expinfrav1.AWSLifecycleHook{hook}, detail)
+}
+
+// resumeLifecycleActions drains the nodes of the instances whose termination is paused by the lifecycle hooks of
+// the AWSMachinePools and completes their lifecycle actions, as their drain is cut short when the controller restarts
+// while their messages are already deleted from the queue.
+func (r *AwsInstanceStateReconciler) resumeLifecycleActions(ctx context.Context) {
+	awsMachinePools := &expinfrav1.AWSMachinePoolList{}
+	if err := r.List(ctx, awsMachinePools); err != nil {
+		r.Log.Error(err, "unable to list machine pools")
+		return
+	}
+
+	for i := range awsMachinePools.Items {
+		machinePool := &awsMachinePools.Items[i]
+		if len(machinePool.Spec.LifecycleHooks) == 0 || !machinePool.DeletionTimestamp.IsZero() {
+			continue
+		}
+		log := r.Log.WithValues("namespace", machinePool.Namespace, "awsMachinePool", machinePool.Name)
+
+		region, instanceIDs, err := r.getTerminatingInstances(ctx, machinePool)
+		if err != nil {
+			log.Error(err, "unable to get terminating instances")
+			continue
+		}
+		if region == "" {
+			log.Info("Cluster is neither an AWSCluster nor an AWSManagedControlPlane, not resuming lifecycle actions")
+			continue
+		}
+
+		// The terminating instances don't tell which of the hooks paused them, the lifecycle action of each hook is
+		// completed.
+		hooks := make([]*expinfrav1.AWSLifecycleHook, 0, len(machinePool.Spec.LifecycleHooks))
+		for j := range machinePool.Spec.LifecycleHooks {
+			hooks = append(hooks, &machinePool.Spec.LifecycleHooks[j])
+		}
+		for _, instanceID := range instanceIDs {
+			detail := &messageDetail{
+				AutoScalingGroupName: machinePool.Name,
+				EC2InstanceID:        instanceID,
+			}
+			r.drainAndCompleteLifecycleActions(ctx, log.WithValues("instanceID", instanceID), region, machinePool, hooks, detail)
+		}
+	}
+}
+
+// drainAndCompleteLifecycleActions drains the node of the terminating instance in the background, as draining takes
+// a while, which must not hold up the processing of the other messages. The lifecycle actions of the hooks are
+// completed once the node is drained, unless the controller stops, in which case they are resumed when it starts
+// again.
+func (r *AwsInstanceStateReconciler) drainAndCompleteLifecycleActions(ctx context.Context, log logr.Logger, region string,
+	machinePool *expinfrav1.AWSMachinePool, hooks []*expinfrav1.AWSLifecycleHook, detail *messageDetail) {
+	timeout := lifecycleDrainTimeout(hooks)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		if err := r.drainMachinePoolNode(ctx, log, machinePool, detail.EC2InstanceID, timeout); err != nil {
+			// The default result of the hooks applies once their heartbeat timeout elapses.
+			log.Error(err, "unable to drain node of terminating instance")
+			return
+		}
+
+		if err := ctx.Err(); err != nil && err != context.DeadlineExceeded {
+			log.Info("Controller stopped before the lifecycle action of terminating instance was completed")
+			return
+		}
+
+		for _, hook := range hooks {
+			hookDetail := *detail
+			hookDetail.LifecycleHookName = hook.Name
+			if err := r.completeLifecycleAction(region, &hookDetail); err != nil {
+				log.Error(err, "unable to complete lifecycle action", "lifecycleHook", hook.Name)
+				continue
+			}
+			log.Info("Completed lifecycle action of terminating instance", "lifecycleHook", hook.Name)
+		}
+	}()
+}
+
+// lifecycleDrainTimeout returns how long the node of an instance paused by the lifecycle hooks is drained, which is
+// strictly shorter than the shortest heartbeat timeout of the hooks.
+func lifecycleDrainTimeout(hooks []*expinfrav1.AWSLifecycleHook) time.Duration {
+	var timeout time.Duration
+	for i, hook := range hooks {
+		if heartbeat := asg.HeartbeatTimeout(hook); i == 0 || heartbeat < timeout {
+			timeout = heartbeat
+		}
+	}
+	return timeout - lifecycleActionCompletionMargin
+}
+
+// getLifecycleHook returns the AWSMachinePool of the Auto Scaling group and its lifecycle hook with the given name,
+// or nil if there is none. The AWSMachinePool is looked up in the cluster that owns the queue the message was
+// delivered to, as Auto Scaling groups of other clusters may have the same name.
+func (r *AwsInstanceStateReconciler) getLifecycleHook(ctx context.Context, qp queueParams, asgName, hookName string) (*expinfrav1.AWSMachinePool, *expinfrav1.AWSLifecycleHook) {
+	awsCluster := &infrav1.AWSCluster{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: qp.namespace, Name: qp.awsClusterName}, awsCluster); err != nil {
+		r.Log.Error(err, "unable to get AWS cluster of queue", "namespace", qp.namespace, "awsCluster", qp.awsClusterName)
+		return nil, nil
+	}
+	cluster, err := util.GetOwnerCluster(ctx, r.Client, awsCluster.ObjectMeta)
+	if err != nil {
+		r.Log.Error(err, "unable to get cluster of queue", "namespace", qp.namespace, "awsCluster", qp.awsClusterName)
+		return nil, nil
+	}
+	if cluster == nil {
+		return nil, nil
+	}
+
+	awsMachinePools := &expinfrav1.AWSMachinePoolList{}
+	if err := r.List(ctx, awsMachinePools, client.InNamespace(cluster.Namespace), client.MatchingLabels{clusterv1.ClusterLabelName: cluster.Name}); err != nil {
+		r.Log.Error(err, "unable to list machine pools")
+		return nil, nil
+	}
+
+	// The Auto Scaling group of an AWSMachinePool is named after it.
+	for i := range awsMachinePools.Items {
+		machinePool := &awsMachinePools.Items[i]
+		if machinePool.Name != asgName || !machinePool.DeletionTimestamp.IsZero() {
+			continue
+		}
+		for j := range machinePool.Spec.LifecycleHooks {
+			if machinePool.Spec.LifecycleHooks[j].Name == hookName {
+				return machinePool, &machinePool.Spec.LifecycleHooks[j]
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// drainMachinePoolNode cordons and drains the workload cluster node of an instance of the AWSMachinePool.
+func (r *AwsInstanceStateReconciler) drainMachinePoolNode(ctx context.Context, log logr.Logger, awsMachinePool *expinfrav1.AWSMachinePool, instanceID string, timeout time.Duration) error {
+	cluster, err := r.getMachinePoolCluster(ctx, awsMachinePool)
+	if err != nil {
+		return err
+	}
+
+	kubeClient, err := controllers.RemoteKubeClient(ctx, r.Client, cluster)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := kubeClient.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list nodes")
+	}
+
+	node := nodeForInstance(nodes.Items, instanceID)
+	if node == nil {
+		log.Info("Terminating instance has no node to drain")
+		return nil
+	}
+
+	return controllers.DrainNode(ctx, log, kubeClient, node.Name, timeout)
+}
+
+// getMachinePoolCluster returns the Cluster of the MachinePool owning the AWSMachinePool.
+func (r *AwsInstanceStateReconciler) getMachinePoolCluster(ctx context.Context, awsMachinePool *expinfrav1.AWSMachinePool) (*clusterv1.Cluster, error) {
+	for _, ref := range awsMachinePool.OwnerReferences {
+		if ref.Kind != "MachinePool" {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if gv.Group != capiv1exp.GroupVersion.Group {
+			continue
+		}
+
+		machinePool := &capiv1exp.MachinePool{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: awsMachinePool.Namespace, Name: ref.Name}, machinePool); err != nil {
+			return nil, errors.Wrap(err, "failed to get owner machine pool")
+		}
+
+		cluster, err := util.GetClusterByName(ctx, r.Client, machinePool.Namespace, machinePool.Spec.ClusterName)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get cluster")
+		}
+		return cluster, nil
+	}
+
+	return nil, errors.New("AWSMachinePool has no owner machine pool")
+}
+
+// getTerminatingInstances returns the region of the AWSMachinePool and the IDs of the instances of its Auto Scaling
+// group whose termination is paused by a lifecycle hook. The region is empty if the cluster is neither an
+// AWSCluster nor an AWSManagedControlPlane.
+func (r *AwsInstanceStateReconciler) getTerminatingInstances(ctx context.Context, awsMachinePool *expinfrav1.AWSMachinePool) (string, []string, error) {
+	cluster, err := r.getMachinePoolCluster(ctx, awsMachinePool)
+	if err != nil {
+		return "", nil, err
+	}
+
+	region, err := r.getClusterRegion(ctx, cluster)
+	if err != nil || region == "" {
+		return "", nil, err
+	}
+
+	asgSvc, err := r.getASGService(region)
+	if err != nil {
+		return "", nil, err
+	}
+
+	out, err := asgSvc.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{awsMachinePool.Name}),
+	})
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to describe ASG %q", awsMachinePool.Name)
+	}
+
+	var instanceIDs []string
+	for _, group := range out.AutoScalingGroups {
+		for _, instance := range group.Instances {
+			if aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateTerminatingWait {
+				instanceIDs = append(instanceIDs, aws.StringValue(instance.InstanceId))
+			}
+		}
+	}
+
+	return region, instanceIDs, nil
+}
+
+// getClusterRegion returns the region of the AWSManagedControlPlane or AWSCluster of the cluster, or an empty string
+// if it has neither.
+func (r *AwsInstanceStateReconciler) getClusterRegion(ctx context.Context, cluster *clusterv1.Cluster) (string, error) {
+	if cluster.Spec.ControlPlaneRef != nil && cluster.Spec.ControlPlaneRef.Kind == controllers.AWSManagedControlPlaneRefKind {
+		controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.ControlPlaneRef.Name}, controlPlane); err != nil {
+			return "", errors.Wrap(err, "failed to get AWS managed control plane")
+		}
+		return controlPlane.Spec.Region, nil
+	}
+
+	if cluster.Spec.InfrastructureRef == nil || cluster.Spec.InfrastructureRef.Kind != "AWSCluster" {
+		return "", nil
+	}
+
+	awsCluster := &infrav1.AWSCluster{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.InfrastructureRef.Name}, awsCluster); err != nil {
+		return "", errors.Wrap(err, "failed to get AWS cluster")
+	}
+	return awsCluster.Spec.Region, nil
+}
+
+// completeLifecycleAction lets the Auto Scaling group go on with the termination of the instance. The lifecycle
+// action is identified by its token when it was received through the queue, and by the instance otherwise.
+func (r *AwsInstanceStateReconciler) completeLifecycleAction(region string, detail *messageDetail) error {
+	asgSvc, err := r.getASGService(region)
+	if err != nil {
+		return err
+	}
+
+	input := &autoscaling.CompleteLifecycleActionInput{
+		AutoScalingGroupName:  aws.String(detail.AutoScalingGroupName),
+		LifecycleHookName:     aws.String(detail.LifecycleHookName),
+		InstanceId:            aws.String(detail.EC2InstanceID),
+		LifecycleActionResult: aws.String("CONTINUE"),
+	}
+	if detail.LifecycleActionToken != "" {
+		input.LifecycleActionToken = aws.String(detail.LifecycleActionToken)
+	}
+
+	_, err = asgSvc.CompleteLifecycleAction(input)
+	return err
+}
+
+// nodeForInstance returns the node whose provider ID refers to the instance, or nil if there is none.
+func nodeForInstance(nodes []corev1.Node, instanceID string) *corev1.Node {
+	for i := range nodes {
+		if strings.HasSuffix(nodes[i].Spec.ProviderID, "/"+instanceID) {
+			return &nodes[i]
+		}
+	}
+	return nil
+}
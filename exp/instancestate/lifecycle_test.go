@@ -0,0 +1,378 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2/klogr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1alpha3"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiv1exp "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func setupLifecycleScheme(g *WithT) {
+	g.Expect(infrav1.AddToScheme(scheme.Scheme)).To(Succeed())
+	g.Expect(expinfrav1.AddToScheme(scheme.Scheme)).To(Succeed())
+	g.Expect(clusterv1.AddToScheme(scheme.Scheme)).To(Succeed())
+	g.Expect(ekscontrolplanev1.AddToScheme(scheme.Scheme)).To(Succeed())
+	g.Expect(capiv1exp.AddToScheme(scheme.Scheme)).To(Succeed())
+}
+
+func newLifecycleMachinePool(hooks ...expinfrav1.AWSLifecycleHook) *expinfrav1.AWSMachinePool {
+	return &expinfrav1.AWSMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pool",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: capiv1exp.GroupVersion.String(),
+					Kind:       "MachinePool",
+					Name:       "pool",
+				},
+			},
+		},
+		Spec: expinfrav1.AWSMachinePoolSpec{
+			LifecycleHooks: hooks,
+		},
+	}
+}
+
+func TestLifecycleDrainTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		hooks    []*expinfrav1.AWSLifecycleHook
+		expected time.Duration
+	}{
+		{
+			name:     "default heartbeat timeout",
+			hooks:    []*expinfrav1.AWSLifecycleHook{{Name: "drain"}},
+			expected: 5*time.Minute - lifecycleActionCompletionMargin,
+		},
+		{
+			name: "shortest heartbeat timeout of the hooks",
+			hooks: []*expinfrav1.AWSLifecycleHook{
+				{Name: "drain", HeartbeatTimeout: &metav1.Duration{Duration: 10 * time.Minute}},
+				{Name: "backup", HeartbeatTimeout: &metav1.Duration{Duration: 2 * time.Minute}},
+			},
+			expected: 2*time.Minute - lifecycleActionCompletionMargin,
+		},
+		{
+			name:     "minimum heartbeat timeout",
+			hooks:    []*expinfrav1.AWSLifecycleHook{{Name: "drain", HeartbeatTimeout: &metav1.Duration{Duration: 30 * time.Second}}},
+			expected: 20 * time.Second,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			timeout := lifecycleDrainTimeout(tc.hooks)
+			g.Expect(timeout).To(Equal(tc.expected))
+			for _, hook := range tc.hooks {
+				if hook.HeartbeatTimeout != nil {
+					g.Expect(timeout).To(BeNumerically("<", hook.HeartbeatTimeout.Duration))
+				}
+			}
+		})
+	}
+}
+
+func newLifecycleCluster(namespace string) []runtime.Object {
+	return []runtime.Object{
+		&clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: namespace},
+		},
+		&infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "aws-cluster",
+				Namespace: namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Cluster",
+						Name:       "cluster",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetLifecycleHook(t *testing.T) {
+	pool := newLifecycleMachinePool(expinfrav1.AWSLifecycleHook{Name: "drain"})
+	pool.Labels = map[string]string{clusterv1.ClusterLabelName: "cluster"}
+
+	deleted := pool.DeepCopy()
+	deleted.Name = "deleted"
+	deleted.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	foreign := pool.DeepCopy()
+	foreign.Name = "foreign"
+	foreign.Labels = map[string]string{clusterv1.ClusterLabelName: "another-cluster"}
+
+	otherNamespace := pool.DeepCopy()
+	otherNamespace.Namespace = "other"
+
+	tests := []struct {
+		name              string
+		queueNamespace    string
+		asgName           string
+		hookName          string
+		expectedNamespace string
+	}{
+		{
+			name:              "hook of an AWSMachinePool",
+			queueNamespace:    "default",
+			asgName:           "pool",
+			hookName:          "drain",
+			expectedNamespace: "default",
+		},
+		{
+			name:              "group with the same name in the cluster of another queue",
+			queueNamespace:    "other",
+			asgName:           "pool",
+			hookName:          "drain",
+			expectedNamespace: "other",
+		},
+		{
+			name:           "hook created outside of the controller",
+			queueNamespace: "default",
+			asgName:        "pool",
+			hookName:       "external",
+		},
+		{
+			name:           "group of another AWSMachinePool",
+			queueNamespace: "default",
+			asgName:        "other",
+			hookName:       "drain",
+		},
+		{
+			name:           "AWSMachinePool being deleted",
+			queueNamespace: "default",
+			asgName:        "deleted",
+			hookName:       "drain",
+		},
+		{
+			name:           "AWSMachinePool of another cluster",
+			queueNamespace: "default",
+			asgName:        "foreign",
+			hookName:       "drain",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			setupLifecycleScheme(g)
+
+			objects := []runtime.Object{pool, deleted, foreign, otherNamespace}
+			objects = append(objects, newLifecycleCluster("default")...)
+			objects = append(objects, newLifecycleCluster("other")...)
+			r := &AwsInstanceStateReconciler{
+				Client: fake.NewFakeClientWithScheme(scheme.Scheme, objects...),
+				Log:    klogr.New(),
+			}
+
+			qp := queueParams{region: "us-east-1", namespace: tc.queueNamespace, awsClusterName: "aws-cluster"}
+			machinePool, hook := r.getLifecycleHook(context.TODO(), qp, tc.asgName, tc.hookName)
+			if tc.expectedNamespace == "" {
+				g.Expect(hook).To(BeNil())
+				return
+			}
+			g.Expect(hook).NotTo(BeNil())
+			g.Expect(hook.Name).To(Equal(tc.hookName))
+			g.Expect(machinePool.Name).To(Equal(tc.asgName))
+			g.Expect(machinePool.Namespace).To(Equal(tc.expectedNamespace))
+		})
+	}
+}
+
+func TestGetTerminatingInstances(t *testing.T) {
+	tests := []struct {
+		name           string
+		clusterSpec    clusterv1.ClusterSpec
+		expectedRegion string
+	}{
+		{
+			name: "AWSCluster",
+			clusterSpec: clusterv1.ClusterSpec{
+				InfrastructureRef: &corev1.ObjectReference{Kind: "AWSCluster", Name: "aws-cluster"},
+			},
+			expectedRegion: "us-east-1",
+		},
+		{
+			name: "AWSManagedControlPlane",
+			clusterSpec: clusterv1.ClusterSpec{
+				ControlPlaneRef:   &corev1.ObjectReference{Kind: "AWSManagedControlPlane", Name: "control-plane"},
+				InfrastructureRef: &corev1.ObjectReference{Kind: "AWSManagedControlPlane", Name: "control-plane"},
+			},
+			expectedRegion: "eu-west-1",
+		},
+		{
+			name: "cluster of another infrastructure provider",
+			clusterSpec: clusterv1.ClusterSpec{
+				InfrastructureRef: &corev1.ObjectReference{Kind: "DockerCluster", Name: "docker-cluster"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			setupLifecycleScheme(g)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+
+			objects := []runtime.Object{
+				newLifecycleMachinePool(expinfrav1.AWSLifecycleHook{Name: "drain"}),
+				&capiv1exp.MachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+					Spec:       capiv1exp.MachinePoolSpec{ClusterName: "cluster"},
+				},
+				&clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+					Spec:       tc.clusterSpec,
+				},
+				&infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "aws-cluster", Namespace: "default"},
+					Spec:       infrav1.AWSClusterSpec{Region: "us-east-1"},
+				},
+				&ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: "default"},
+					Spec:       ekscontrolplanev1.AWSManagedControlPlaneSpec{Region: "eu-west-1"},
+				},
+			}
+
+			r := &AwsInstanceStateReconciler{
+				Client: fake.NewFakeClientWithScheme(scheme.Scheme, objects...),
+				Log:    klogr.New(),
+				asgServiceFactory: func() autoscalingiface.AutoScalingAPI {
+					return asgMock
+				},
+			}
+
+			if tc.expectedRegion != "" {
+				asgMock.EXPECT().
+					DescribeAutoScalingGroups(gomock.Eq(&autoscaling.DescribeAutoScalingGroupsInput{
+						AutoScalingGroupNames: aws.StringSlice([]string{"pool"}),
+					})).
+					Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+						AutoScalingGroups: []*autoscaling.Group{
+							{
+								Instances: []*autoscaling.Instance{
+									{InstanceId: aws.String("i-1"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+									{InstanceId: aws.String("i-2"), LifecycleState: aws.String(autoscaling.LifecycleStateTerminatingWait)},
+									{InstanceId: aws.String("i-3"), LifecycleState: aws.String(autoscaling.LifecycleStateTerminatingProceed)},
+								},
+							},
+						},
+					}, nil)
+			}
+
+			region, instanceIDs, err := r.getTerminatingInstances(context.TODO(), newLifecycleMachinePool())
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(region).To(Equal(tc.expectedRegion))
+			if tc.expectedRegion == "" {
+				g.Expect(instanceIDs).To(BeEmpty())
+				return
+			}
+			g.Expect(instanceIDs).To(ConsistOf("i-2"))
+		})
+	}
+}
+
+func TestCompleteLifecycleAction(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		expectedToken *string
+	}{
+		{
+			name:          "lifecycle action received through the queue",
+			token:         "token",
+			expectedToken: aws.String("token"),
+		},
+		{
+			name: "resumed lifecycle action",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+
+			r := &AwsInstanceStateReconciler{
+				Log: klogr.New(),
+				asgServiceFactory: func() autoscalingiface.AutoScalingAPI {
+					return asgMock
+				},
+			}
+
+			asgMock.EXPECT().
+				CompleteLifecycleAction(gomock.Eq(&autoscaling.CompleteLifecycleActionInput{
+					AutoScalingGroupName:  aws.String("pool"),
+					LifecycleHookName:     aws.String("drain"),
+					LifecycleActionToken:  tc.expectedToken,
+					InstanceId:            aws.String("i-1"),
+					LifecycleActionResult: aws.String("CONTINUE"),
+				})).
+				Return(&autoscaling.CompleteLifecycleActionOutput{}, nil)
+
+			g.Expect(r.completeLifecycleAction("us-east-1", &messageDetail{
+				AutoScalingGroupName: "pool",
+				LifecycleHookName:    "drain",
+				LifecycleActionToken: tc.token,
+				EC2InstanceID:        "i-1",
+			})).To(Succeed())
+		})
+	}
+}
+
+func TestNodeForInstance(t *testing.T) {
+	g := NewWithT(t)
+
+	nodes := []corev1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}, Spec: corev1.NodeSpec{ProviderID: "aws:///us-east-1a/i-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "node-12"}, Spec: corev1.NodeSpec{ProviderID: "aws:///us-east-1a/i-12"}},
+	}
+
+	g.Expect(nodeForInstance(nodes, "i-12").Name).To(Equal("node-12"))
+	g.Expect(nodeForInstance(nodes, "i-1").Name).To(Equal("node-1"))
+	g.Expect(nodeForInstance(nodes, "i-2")).To(BeNil())
+}
@@ -19,6 +19,7 @@ package instancestate
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -30,6 +31,7 @@ import (
 	"path/filepath"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling/mock_autoscalingiface"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/instancestate/mock_sqsiface"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	clusterv1exp "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
@@ -51,6 +53,7 @@ var (
 	instanceStateReconciler *AwsInstanceStateReconciler
 	mockCtrl                *gomock.Controller
 	sqsSvs                  *mock_sqsiface.MockSQSAPI
+	asgSvs                  *mock_autoscalingiface.MockAutoScalingAPI
 	k8sManager              ctrl.Manager
 )
 
@@ -94,12 +97,16 @@ var _ = BeforeSuite(func(done Done) {
 
 	mockCtrl = gomock.NewController(GinkgoT())
 	sqsSvs = mock_sqsiface.NewMockSQSAPI(mockCtrl)
+	asgSvs = mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
 	instanceStateReconciler = &AwsInstanceStateReconciler{
 		Client: k8sManager.GetClient(),
 		Log:    ctrl.Log.WithName("controllers").WithName("AWSInstanceState"),
 		sqsServiceFactory: func() sqsiface.SQSAPI {
 			return sqsSvs
 		},
+		asgServiceFactory: func() autoscalingiface.AutoScalingAPI {
+			return asgSvs
+		},
 	}
 
 	defer mockCtrl.Finish()
@@ -46,6 +46,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/exp/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/endpoints"
+	ec2service "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/version"
 	// +kubebuilder:scaffold:imports
@@ -80,6 +81,9 @@ var (
 	webhookPort              int
 	healthAddr               string
 	serviceEndpoints         string
+	ec2LookupCacheTTL        time.Duration
+	bootstrapFailureTimeout  time.Duration
+	instanceHealthInterval   time.Duration
 )
 
 func main() {
@@ -92,6 +96,8 @@ func main() {
 
 	ctrl.SetLogger(klogr.New())
 
+	ec2service.SetLookupCacheTTL(ec2LookupCacheTTL)
+
 	if watchNamespace != "" {
 		setupLog.Info("Watching cluster-api objects only in namespace for reconciliation", "namespace", watchNamespace)
 	}
@@ -146,6 +152,9 @@ func main() {
 			Log:       ctrl.Log.WithName("controllers").WithName("AWSMachine"),
 			Recorder:  mgr.GetEventRecorderFor("awsmachine-controller"),
 			Endpoints: AWSServiceEndpoints,
+
+			BootstrapFailureTimeout:    bootstrapFailureTimeout,
+			InstanceHealthPollInterval: instanceHealthInterval,
 		}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSMachine")
 			os.Exit(1)
@@ -357,5 +366,23 @@ func initFlags(fs *pflag.FlagSet) {
 		"Set custom AWS service endpoins in semi-colon separated format: ${SigningRegion1}:${ServiceID1}=${URL},${ServiceID2}=${URL};${SigningRegion2}...",
 	)
 
+	fs.DurationVar(&ec2LookupCacheTTL,
+		"ec2-lookup-cache-ttl",
+		ec2service.DefaultLookupCacheTTL,
+		"The time AMI, image and instance type lookups are cached for, 0 disables caching (e.g. 5m)",
+	)
+
+	fs.DurationVar(&bootstrapFailureTimeout,
+		"bootstrap-failure-timeout",
+		20*time.Minute,
+		"The time an instance may be running without joining the cluster, before its console output is recorded in an event and the BootstrapSucceeded condition of its AWSMachine is set to false, 0 disables the check (e.g. 20m)",
+	)
+
+	fs.DurationVar(&instanceHealthInterval,
+		"instance-health-poll-interval",
+		5*time.Minute,
+		"The interval at which the EC2 status checks and scheduled events of instances are polled and reflected in the InstanceHealthy and NoScheduledEvents conditions of their AWSMachines, 0 disables polling (e.g. 5m)",
+	)
+
 	feature.MutableGates.AddFlag(fs)
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -42,6 +43,18 @@ const (
 	InvalidInstanceID       = "InvalidInstanceID.NotFound"
 	ResourceExists          = "ResourceExistsException"
 	NoCredentialProviders   = "NoCredentialProviders"
+
+	ReservationCapacityExceeded  = "ReservationCapacityExceeded"
+	InsufficientInstanceCapacity = "InsufficientInstanceCapacity"
+
+	LaunchTemplateNameAlreadyExists = "InvalidLaunchTemplateName.AlreadyExistsException"
+	LaunchTemplateNameNotFound      = "InvalidLaunchTemplateName.NotFoundException"
+
+	VolumeModificationRateExceeded = "VolumeModificationRateExceeded"
+
+	AccessDenied          = "AccessDenied"
+	AccessDeniedException = "AccessDeniedException"
+	UnauthorizedOperation = "UnauthorizedOperation"
 )
 
 var _ error = &EC2Error{}
@@ -97,6 +110,45 @@ func IsResourceExists(err error) bool {
 	return false
 }
 
+// IsReservationCapacityExceeded returns true if the error indicates that the targeted
+// capacity reservation has no remaining capacity.
+func IsReservationCapacityExceeded(err error) bool {
+	if code, ok := Code(err); ok {
+		return code == ReservationCapacityExceeded
+	}
+	return false
+}
+
+// IsInsufficientInstanceCapacity returns true if the error indicates that EC2 does not
+// currently have enough capacity for the requested instance type.
+func IsInsufficientInstanceCapacity(err error) bool {
+	if code, ok := Code(err); ok {
+		return code == InsufficientInstanceCapacity
+	}
+	return false
+}
+
+// IsLaunchTemplateNameAlreadyExists returns true if the error indicates that a launch template
+// with the same name already exists.
+func IsLaunchTemplateNameAlreadyExists(err error) bool {
+	if code, ok := Code(err); ok {
+		return code == LaunchTemplateNameAlreadyExists
+	}
+	return false
+}
+
+// IsAccessDenied returns true if the error indicates that the IAM policy of the caller does not
+// allow the action, as for example with the policies of older releases.
+func IsAccessDenied(err error) bool {
+	if code, ok := Code(errors.Cause(err)); ok {
+		switch code {
+		case AccessDenied, AccessDeniedException, UnauthorizedOperation:
+			return true
+		}
+	}
+	return false
+}
+
 // NewFailedDependency returns an error which indicates that a dependency failure status
 func NewFailedDependency(msg string) error {
 	return &EC2Error{
@@ -137,6 +189,8 @@ func IsInvalidNotFoundError(err error) bool {
 			return true
 		case InvalidInstanceID:
 			return true
+		case LaunchTemplateNameNotFound:
+			return true
 		case ssm.ErrCodeParameterNotFound:
 			return true
 		}
@@ -53,6 +53,14 @@ func (ec2Filters) Name(name string) *ec2.Filter {
 	}
 }
 
+// InstanceID returns a filter based on the ID of the instance a resource is associated with.
+func (ec2Filters) InstanceID(id string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("instance-id"),
+		Values: aws.StringSlice([]string{id}),
+	}
+}
+
 // ClusterOwned returns a filter using the Cluster API per-cluster tag where
 // the resource is owned
 func (ec2Filters) ClusterOwned(clusterName string) *ec2.Filter {
@@ -143,6 +151,30 @@ func (ec2Filters) SubnetStates(states ...string) *ec2.Filter {
 	}
 }
 
+// VPCEndpointStates returns a filter based on the list of VPC endpoint states passed in.
+func (ec2Filters) VPCEndpointStates(states ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("vpc-endpoint-state"),
+		Values: aws.StringSlice(states),
+	}
+}
+
+// ServiceNames returns a filter based on the names of the services of VPC endpoints.
+func (ec2Filters) ServiceNames(names ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("service-name"),
+		Values: aws.StringSlice(names),
+	}
+}
+
+// VolumeIDs returns a filter based on the IDs of EBS volumes.
+func (ec2Filters) VolumeIDs(ids ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("volume-id"),
+		Values: aws.StringSlice(ids),
+	}
+}
+
 func (ec2Filters) AvailabilityZone(zone string) *ec2.Filter {
 	return &ec2.Filter{
 		Name:   aws.String(filterAvailabilityZone),
@@ -34,12 +34,20 @@ const (
 	metricRequestCountKey    = "api_requests_total"
 	metricRequestDurationKey = "api_request_duration_seconds"
 	metricAPICallRetries     = "api_call_retries"
+	metricLookupCacheKey     = "lookup_cache_requests_total"
 	metricServiceLabel       = "service"
 	metricRegionLabel        = "region"
 	metricOperationLabel     = "operation"
 	metricControllerLabel    = "controller"
 	metricStatusCodeLabel    = "status_code"
 	metricErrorCodeLabel     = "error_code"
+	metricCacheLabel         = "cache"
+	metricResultLabel        = "result"
+
+	// LookupCacheHit is the result of a lookup served from the cache.
+	LookupCacheHit = "hit"
+	// LookupCacheMiss is the result of a lookup that was not cached, or whose entry expired.
+	LookupCacheMiss = "miss"
 )
 
 var (
@@ -59,12 +67,18 @@ var (
 		Help:      "Number of retries made against an AWS API",
 		Buckets:   []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
 	}, []string{metricControllerLabel, metricServiceLabel, metricRegionLabel, metricOperationLabel})
+	awsLookupCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricAWSSubsystem,
+		Name:      metricLookupCacheKey,
+		Help:      "Total number of cached AWS metadata lookups, by cache and result",
+	}, []string{metricCacheLabel, metricRegionLabel, metricResultLabel})
 )
 
 func init() {
 	metrics.Registry.MustRegister(awsRequestCount)
 	metrics.Registry.MustRegister(awsRequestDurationSeconds)
 	metrics.Registry.MustRegister(awsCallRetries)
+	metrics.Registry.MustRegister(awsLookupCacheRequests)
 }
 
 func CaptureRequestMetrics(controller string) func(r *request.Request) {
@@ -90,6 +104,11 @@ func CaptureRequestMetrics(controller string) func(r *request.Request) {
 	}
 }
 
+// RecordLookupCacheRequest records the result of a lookup against one of the AWS metadata caches.
+func RecordLookupCacheRequest(cache, region, result string) {
+	awsLookupCacheRequests.WithLabelValues(cache, region, result).Inc()
+}
+
 func endpointToService(endpoint string) string {
 	endpointURL, err := url.Parse(endpoint)
 	// If possible extract the service name, else return entire endpoint address
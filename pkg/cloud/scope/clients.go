@@ -63,6 +63,15 @@ func NewASGClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logr
 	return asgClient
 }
 
+// NewGlobalASGClient for creating a new ASG API client that isn't tied to a cluster
+func NewGlobalASGClient(scopeUser cloud.ScopeUsage, session cloud.Session) autoscalingiface.AutoScalingAPI {
+	asgClient := autoscaling.New(session.Session())
+	asgClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	asgClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
+
+	return asgClient
+}
+
 // NewEC2Client creates a new EC2 API client for a given session
 func NewEC2Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logr.Logger, target runtime.Object) ec2iface.EC2API {
 	ec2Client := ec2.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger)).WithLogger(awslogs.NewWrapLogr(logger)))
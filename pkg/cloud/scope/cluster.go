@@ -23,6 +23,7 @@ import (
 	awsclient "github.com/aws/aws-sdk-go/aws/client"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2/klogr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
@@ -205,6 +206,10 @@ func (s *ClusterScope) PatchObject() error {
 		}
 	}
 
+	if s.AWSCluster.Spec.DedicatedHosts != nil {
+		applicableConditions = append(applicableConditions, infrav1.DedicatedHostsReadyCondition)
+	}
+
 	conditions.SetSummary(s.AWSCluster,
 		conditions.WithConditions(applicableConditions...),
 		conditions.WithStepCounterIf(s.AWSCluster.ObjectMeta.DeletionTimestamp.IsZero()),
@@ -280,16 +285,85 @@ func (s *ClusterScope) Bastion() *infrav1.Bastion {
 	return &s.AWSCluster.Spec.Bastion
 }
 
+// GetRawBastionUserData returns the user data of the bastion host instance, or nil if the bastion
+// uses the default user data.
+func (s *ClusterScope) GetRawBastionUserData() ([]byte, error) {
+	return getRawBastionUserData(s.client, s.Namespace(), s.Bastion())
+}
+
 // SetBastionInstance sets the bastion instance in the status of the cluster.
 func (s *ClusterScope) SetBastionInstance(instance *infrav1.Instance) {
 	s.AWSCluster.Status.Bastion = instance
 }
 
-// SSHKeyName returns the SSH key name to use for instances.
+// SetBastionEndpoint sets the public IP address the bastion is reachable at in the status of the cluster.
+func (s *ClusterScope) SetBastionEndpoint(endpoint string) {
+	s.AWSCluster.Status.BastionEndpoint = endpoint
+}
+
+// BastionMode returns the mode of the bastion that was last reconciled, as recorded in the status of the cluster.
+func (s *ClusterScope) BastionMode() infrav1.BastionMode {
+	return s.AWSCluster.Status.BastionMode
+}
+
+// SetBastionMode records the mode of the reconciled bastion in the status of the cluster.
+func (s *ClusterScope) SetBastionMode(mode infrav1.BastionMode) {
+	s.AWSCluster.Status.BastionMode = mode
+}
+
+// DedicatedHostPool returns the dedicated host pool of the cluster, if any.
+func (s *ClusterScope) DedicatedHostPool() *infrav1.DedicatedHostPool {
+	return s.AWSCluster.Spec.DedicatedHosts
+}
+
+// DedicatedHosts returns the dedicated hosts allocated for the cluster, as recorded in its status.
+func (s *ClusterScope) DedicatedHosts() []infrav1.DedicatedHost {
+	return s.AWSCluster.Status.DedicatedHosts
+}
+
+// SetDedicatedHosts sets the dedicated hosts allocated for the cluster in its status.
+func (s *ClusterScope) SetDedicatedHosts(hosts []infrav1.DedicatedHost) {
+	s.AWSCluster.Status.DedicatedHosts = hosts
+}
+
+// SSHKeyName returns the SSH key name to use for instances, which is the name of the managed key pair
+// of the cluster if it has one.
 func (s *ClusterScope) SSHKeyName() *string {
+	if s.AWSCluster.Spec.ManagedSSHKeyPair != nil {
+		name := managedSSHKeyPairName(s.Name())
+		return &name
+	}
 	return s.AWSCluster.Spec.SSHKeyName
 }
 
+// SSHAccess returns how SSH access to the instances is granted.
+func (s *ClusterScope) SSHAccess() infrav1.SSHAccessMode {
+	return s.AWSCluster.Spec.SSHAccess
+}
+
+// ManagedSSHKeyPair returns the EC2 key pair settings of the cluster, or nil if it has no managed key pair.
+func (s *ClusterScope) ManagedSSHKeyPair() *infrav1.ManagedSSHKeyPair {
+	return s.AWSCluster.Spec.ManagedSSHKeyPair
+}
+
+// GetManagedSSHPublicKey returns the public key to import as the managed key pair, or nil if the key
+// pair is generated by EC2.
+func (s *ClusterScope) GetManagedSSHPublicKey() ([]byte, error) {
+	return getManagedSSHPublicKey(s.client, s.Namespace(), s.ManagedSSHKeyPair())
+}
+
+// StoreManagedSSHPrivateKey stores the private key of the generated managed key pair in a Secret owned
+// by the AWSCluster.
+func (s *ClusterScope) StoreManagedSSHPrivateKey(privateKey []byte) error {
+	owner := *metav1.NewControllerRef(s.AWSCluster, infrav1.GroupVersion.WithKind("AWSCluster"))
+	return storeManagedSSHPrivateKey(s.client, s.Namespace(), s.Name(), owner, privateKey)
+}
+
+// DeleteManagedSSHPrivateKey deletes the Secret holding the private key of the managed key pair, if any.
+func (s *ClusterScope) DeleteManagedSSHPrivateKey() error {
+	return deleteManagedSSHPrivateKey(s.client, s.Namespace(), s.Name())
+}
+
 // ControllerName returns the name of the controller that
 // created the ClusterScope.
 func (s *ClusterScope) ControllerName() string {
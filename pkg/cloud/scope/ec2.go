@@ -17,8 +17,19 @@ limitations under the License.
 package scope
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Scope is the interface for the scoep to be used with the ec2 service
@@ -40,12 +51,50 @@ type EC2Scope interface {
 	// Bastion returns the bastion details for the cluster.
 	Bastion() *infrav1.Bastion
 
+	// GetRawBastionUserData returns the user data of the bastion host instance, or nil if the bastion
+	// uses the default user data.
+	GetRawBastionUserData() ([]byte, error)
+
 	// SetBastionInstance sets the bastion instance in the status of the cluster.
 	SetBastionInstance(instance *infrav1.Instance)
 
+	// SetBastionEndpoint sets the public IP address the bastion is reachable at in the status of the cluster.
+	SetBastionEndpoint(endpoint string)
+
+	// BastionMode returns the mode of the bastion that was last reconciled, as recorded in the status of the cluster.
+	BastionMode() infrav1.BastionMode
+
+	// SetBastionMode records the mode of the reconciled bastion in the status of the cluster.
+	SetBastionMode(mode infrav1.BastionMode)
+
+	// DedicatedHostPool returns the dedicated host pool of the cluster, if any.
+	DedicatedHostPool() *infrav1.DedicatedHostPool
+
+	// DedicatedHosts returns the dedicated hosts allocated for the cluster, as recorded in its status.
+	DedicatedHosts() []infrav1.DedicatedHost
+
+	// SetDedicatedHosts sets the dedicated hosts allocated for the cluster in its status.
+	SetDedicatedHosts(hosts []infrav1.DedicatedHost)
+
 	// SSHKeyName returns the SSH key name to use for instances.
 	SSHKeyName() *string
 
+	// SSHAccess returns how SSH access to the instances is granted.
+	SSHAccess() infrav1.SSHAccessMode
+
+	// ManagedSSHKeyPair returns the EC2 key pair settings of the cluster, or nil if it has no managed key pair.
+	ManagedSSHKeyPair() *infrav1.ManagedSSHKeyPair
+
+	// GetManagedSSHPublicKey returns the public key to import as the managed key pair, or nil if the key
+	// pair is generated by EC2.
+	GetManagedSSHPublicKey() ([]byte, error)
+
+	// StoreManagedSSHPrivateKey stores the private key of the generated managed key pair in a Secret.
+	StoreManagedSSHPrivateKey(privateKey []byte) error
+
+	// DeleteManagedSSHPrivateKey deletes the Secret holding the private key of the managed key pair, if any.
+	DeleteManagedSSHPrivateKey() error
+
 	// ImageLookupFormat returns the format string to use when looking up AMIs
 	ImageLookupFormat() string
 
@@ -55,3 +104,98 @@ type EC2Scope interface {
 	// ImageLookupBaseOS returns the base operating system name to use when looking up AMIs
 	ImageLookupBaseOS() string
 }
+
+// getRawBastionUserData returns the user data of the bastion, reading it from its secret in the namespace
+// if needed.
+func getRawBastionUserData(c client.Client, namespace string, bastion *infrav1.Bastion) ([]byte, error) {
+	if bastion.UserData == nil {
+		return nil, nil
+	}
+	if bastion.UserData.SecretName == nil {
+		return []byte(aws.StringValue(bastion.UserData.Value)), nil
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: namespace, Name: *bastion.UserData.SecretName}
+	if err := c.Get(context.TODO(), key, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve bastion user data secret %s/%s", namespace, *bastion.UserData.SecretName)
+	}
+
+	value, ok := secret.Data["value"]
+	if !ok {
+		return nil, errors.Errorf("error retrieving bastion user data: secret %s/%s value key is missing", namespace, *bastion.UserData.SecretName)
+	}
+
+	return value, nil
+}
+
+// managedSSHKeyPairName returns the name of the managed EC2 key pair of the cluster, which is also the name
+// of the Secret holding its private key.
+func managedSSHKeyPairName(clusterName string) string {
+	return fmt.Sprintf("%s-ssh-key", clusterName)
+}
+
+// getManagedSSHPublicKey returns the public key to import as the managed key pair of the cluster, reading
+// it from its secret in the namespace.
+func getManagedSSHPublicKey(c client.Client, namespace string, keyPair *infrav1.ManagedSSHKeyPair) ([]byte, error) {
+	if keyPair == nil || keyPair.PublicKeySecretName == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: namespace, Name: *keyPair.PublicKeySecretName}
+	if err := c.Get(context.TODO(), key, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve SSH public key secret %s/%s", namespace, *keyPair.PublicKeySecretName)
+	}
+
+	value, ok := secret.Data["value"]
+	if !ok {
+		return nil, errors.Errorf("error retrieving SSH public key: secret %s/%s value key is missing", namespace, *keyPair.PublicKeySecretName)
+	}
+
+	return value, nil
+}
+
+// storeManagedSSHPrivateKey creates the secret holding the private key of the managed key pair of the
+// cluster, owned by the given object.
+func storeManagedSSHPrivateKey(c client.Client, namespace, clusterName string, owner metav1.OwnerReference, privateKey []byte) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      managedSSHKeyPairName(clusterName),
+			Namespace: namespace,
+			Labels: map[string]string{
+				clusterv1.ClusterLabelName: clusterName,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				owner,
+			},
+		},
+		Type: clusterv1.ClusterSecretType,
+		Data: map[string][]byte{
+			"value": privateKey,
+		},
+	}
+
+	if err := c.Create(context.TODO(), secret); err != nil {
+		return errors.Wrapf(err, "failed to create SSH private key secret %s/%s", namespace, secret.Name)
+	}
+
+	return nil
+}
+
+// deleteManagedSSHPrivateKey deletes the secret holding the private key of the managed key pair of the
+// cluster, if it exists.
+func deleteManagedSSHPrivateKey(c client.Client, namespace, clusterName string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      managedSSHKeyPairName(clusterName),
+			Namespace: namespace,
+		},
+	}
+
+	if err := c.Delete(context.TODO(), secret); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete SSH private key secret %s/%s", namespace, secret.Name)
+	}
+
+	return nil
+}
@@ -159,6 +159,21 @@ func (m *MachineScope) SetInstanceState(v infrav1.InstanceState) {
 	m.AWSMachine.Status.InstanceState = &v
 }
 
+// SetInstanceType sets the AWSMachine status instance type.
+func (m *MachineScope) SetInstanceType(v string) {
+	m.AWSMachine.Status.InstanceType = v
+}
+
+// SetAvailabilityZone sets the AWSMachine status availability zone.
+func (m *MachineScope) SetAvailabilityZone(v string) {
+	m.AWSMachine.Status.AvailabilityZone = v
+}
+
+// SetNetworkInterfaces sets the AWSMachine status network interfaces.
+func (m *MachineScope) SetNetworkInterfaces(v []infrav1.NetworkInterfaceStatus) {
+	m.AWSMachine.Status.NetworkInterfaces = v
+}
+
 // SetReady sets the AWSMachine Ready Status
 func (m *MachineScope) SetReady() {
 	m.AWSMachine.Status.Ready = true
@@ -281,6 +296,12 @@ func (m *MachineScope) PatchObject() error {
 		applicableConditions = append(applicableConditions, infrav1.ELBAttachedCondition)
 	}
 
+	// The instance health is only part of the summary once a status check failed, so that the machine is not
+	// reported as not ready while the status checks of a new instance are initializing.
+	if conditions.GetReason(m.AWSMachine, infrav1.InstanceHealthyCondition) == infrav1.InstanceStatusCheckFailedReason {
+		applicableConditions = append(applicableConditions, infrav1.InstanceHealthyCondition)
+	}
+
 	conditions.SetSummary(m.AWSMachine,
 		conditions.WithConditions(applicableConditions...),
 		conditions.WithStepCounterIf(m.AWSMachine.ObjectMeta.DeletionTimestamp.IsZero()),
@@ -295,6 +316,10 @@ func (m *MachineScope) PatchObject() error {
 			infrav1.InstanceReadyCondition,
 			infrav1.SecurityGroupsReadyCondition,
 			infrav1.ELBAttachedCondition,
+			infrav1.BootstrapSucceededCondition,
+			infrav1.InstanceHealthyCondition,
+			infrav1.NoScheduledEventsCondition,
+			infrav1.NoRebalanceRecommendationCondition,
 		}})
 }
 
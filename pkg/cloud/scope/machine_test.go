@@ -26,6 +26,7 @@ import (
 	"k8s.io/utils/pointer"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -85,8 +86,9 @@ func newAWSMachine(clusterName, machineName string) *infrav1.AWSMachine {
 			Labels: map[string]string{
 				clusterv1.ClusterLabelName: clusterName,
 			},
-			Name:      machineName,
-			Namespace: "default",
+			Name:            machineName,
+			Namespace:       "default",
+			ResourceVersion: "1",
 		},
 	}
 }
@@ -224,3 +226,28 @@ func TestSetProviderID(t *testing.T) {
 		t.Fatalf("Expected providerID %s, got %s", expectedProviderID, providerID)
 	}
 }
+
+func TestPatchObjectSummarizesFailedInstanceHealth(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conditions.MarkTrue(scope.AWSMachine, infrav1.InstanceReadyCondition)
+	conditions.MarkTrue(scope.AWSMachine, infrav1.SecurityGroupsReadyCondition)
+
+	conditions.MarkFalse(scope.AWSMachine, infrav1.InstanceHealthyCondition, infrav1.InstanceStatusCheckPendingReason, clusterv1.ConditionSeverityInfo, "")
+	if err := scope.PatchObject(); err != nil {
+		t.Fatal(err)
+	}
+	if !conditions.IsTrue(scope.AWSMachine, clusterv1.ReadyCondition) {
+		t.Fatalf("Ready should be true while the status checks are initializing")
+	}
+
+	conditions.MarkFalse(scope.AWSMachine, infrav1.InstanceHealthyCondition, infrav1.InstanceStatusCheckFailedReason, clusterv1.ConditionSeverityError, "")
+	if err := scope.PatchObject(); err != nil {
+		t.Fatal(err)
+	}
+	if !conditions.IsFalse(scope.AWSMachine, clusterv1.ReadyCondition) {
+		t.Fatalf("Ready should be false when a status check failed")
+	}
+}
@@ -209,6 +209,12 @@ func (m *MachinePoolScope) SetASGStatus(v expinfrav1.ASGStatus) {
 	m.AWSMachinePool.Status.ASGStatus = &v
 }
 
+// ReplicasExternallyManaged returns true if the desired capacity of the ASG is left to its scheduled actions and
+// scaling policies instead of following the replicas of the MachinePool.
+func (m *MachinePoolScope) ReplicasExternallyManaged() bool {
+	return len(m.AWSMachinePool.Spec.ScheduledActions) > 0 || len(m.AWSMachinePool.Spec.ScalingPolicies) > 0
+}
+
 func (m *MachinePoolScope) IsEKSManaged() bool {
 	return m.InfraCluster.InfraCluster().GetObjectKind().GroupVersionKind().Kind == "AWSManagedControlPlane"
 }
@@ -235,21 +241,27 @@ func (m *MachinePoolScope) SubnetIDs() ([]string, error) {
 
 // NodeStatus represents the status of a Kubernetes node
 type NodeStatus struct {
+	Name    string
 	Ready   bool
 	Version string
 }
 
 // UpdateInstanceStatuses ties ASG instances and Node status data together and updates AWSMachinePool
 // This updates if ASG instances ready and kubelet version running on the node..
-func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances []infrav1.Instance) error {
-	providerIDs := make([]string, len(instances))
-	for i, instance := range instances {
-		providerIDs[i] = fmt.Sprintf("aws:////%s", instance.ID)
+// Instances of the warm pool of the ASG are marked as warm.
+// It returns the status of the nodes of the instances by provider ID.
+func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances, warmInstances []infrav1.Instance) (map[string]*NodeStatus, error) {
+	providerIDs := make([]string, 0, len(instances)+len(warmInstances))
+	for _, instance := range instances {
+		providerIDs = append(providerIDs, fmt.Sprintf("aws:////%s", instance.ID))
+	}
+	for _, instance := range warmInstances {
+		providerIDs = append(providerIDs, fmt.Sprintf("aws:////%s", instance.ID))
 	}
 
 	nodeStatusByProviderID, err := m.getNodeStatusByProviderID(ctx, providerIDs)
 	if err != nil {
-		return errors.Wrap(err, "failed to get node status by provider id")
+		return nil, errors.Wrap(err, "failed to get node status by provider id")
 	}
 
 	var readyReplicas int32
@@ -268,9 +280,20 @@ func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances
 		}
 	}
 
+	for _, instance := range warmInstances {
+		instanceStatus := &expinfrav1.AWSMachinePoolInstanceStatus{
+			InstanceID: instance.ID,
+			Warm:       true,
+		}
+		if nodeStatus, ok := nodeStatusByProviderID[fmt.Sprintf("aws:////%s", instance.ID)]; ok {
+			instanceStatus.Version = &nodeStatus.Version
+		}
+		instanceStatuses = append(instanceStatuses, instanceStatus)
+	}
+
 	// TODO: readyReplicas can be used as status.replicas but this will delay machinepool to become ready. next reconcile updates this.
 	m.AWSMachinePool.Status.Instances = instanceStatuses
-	return nil
+	return nodeStatusByProviderID, nil
 }
 
 func (m *MachinePoolScope) getNodeStatusByProviderID(ctx context.Context, providerIDList []string) (map[string]*NodeStatus, error) {
@@ -295,6 +318,7 @@ func (m *MachinePoolScope) getNodeStatusByProviderID(ctx context.Context, provid
 			strList := strings.Split(node.Spec.ProviderID, "/")
 
 			if status, ok := nodeStatusMap[fmt.Sprintf("aws:////%s", strList[len(strList)-1])]; ok {
+				status.Name = node.Name
 				status.Ready = nodeIsReady(node)
 				status.Version = node.Status.NodeInfo.KubeletVersion
 			}
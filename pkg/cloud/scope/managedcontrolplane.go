@@ -266,16 +266,76 @@ func (s *ManagedControlPlaneScope) Bastion() *infrav1.Bastion {
 	return &s.ControlPlane.Spec.Bastion
 }
 
+// GetRawBastionUserData returns the user data of the bastion host instance, or nil if the bastion
+// uses the default user data.
+func (s *ManagedControlPlaneScope) GetRawBastionUserData() ([]byte, error) {
+	return getRawBastionUserData(s.Client, s.Namespace(), s.Bastion())
+}
+
 // SetBastionInstance sets the bastion instance in the status of the cluster.
 func (s *ManagedControlPlaneScope) SetBastionInstance(instance *infrav1.Instance) {
 	s.ControlPlane.Status.Bastion = instance
 }
 
+// SetBastionEndpoint sets the public IP address the bastion is reachable at in the status of the control plane.
+func (s *ManagedControlPlaneScope) SetBastionEndpoint(endpoint string) {
+	s.ControlPlane.Status.BastionEndpoint = endpoint
+}
+
+// BastionMode returns the mode of the bastion that was last reconciled, as recorded in the status of the control plane.
+func (s *ManagedControlPlaneScope) BastionMode() infrav1.BastionMode {
+	return s.ControlPlane.Status.BastionMode
+}
+
+// SetBastionMode records the mode of the reconciled bastion in the status of the control plane.
+func (s *ManagedControlPlaneScope) SetBastionMode(mode infrav1.BastionMode) {
+	s.ControlPlane.Status.BastionMode = mode
+}
+
+// DedicatedHostPool returns nil, as managed control planes have no dedicated host pool.
+func (s *ManagedControlPlaneScope) DedicatedHostPool() *infrav1.DedicatedHostPool {
+	return nil
+}
+
+// DedicatedHosts returns nil, as managed control planes have no dedicated host pool.
+func (s *ManagedControlPlaneScope) DedicatedHosts() []infrav1.DedicatedHost {
+	return nil
+}
+
+// SetDedicatedHosts does nothing, as managed control planes have no dedicated host pool.
+func (s *ManagedControlPlaneScope) SetDedicatedHosts(hosts []infrav1.DedicatedHost) {
+}
+
 // SSHKeyName returns the SSH key name to use for instances.
 func (s *ManagedControlPlaneScope) SSHKeyName() *string {
 	return s.ControlPlane.Spec.SSHKeyName
 }
 
+// SSHAccess returns how SSH access to the instances is granted.
+func (s *ManagedControlPlaneScope) SSHAccess() infrav1.SSHAccessMode {
+	return s.ControlPlane.Spec.SSHAccess
+}
+
+// ManagedSSHKeyPair returns nil, as managed control planes have no managed SSH key pair.
+func (s *ManagedControlPlaneScope) ManagedSSHKeyPair() *infrav1.ManagedSSHKeyPair {
+	return nil
+}
+
+// GetManagedSSHPublicKey returns nil, as managed control planes have no managed SSH key pair.
+func (s *ManagedControlPlaneScope) GetManagedSSHPublicKey() ([]byte, error) {
+	return nil, nil
+}
+
+// StoreManagedSSHPrivateKey returns an error, as managed control planes have no managed SSH key pair.
+func (s *ManagedControlPlaneScope) StoreManagedSSHPrivateKey(privateKey []byte) error {
+	return errors.New("managed control planes have no managed SSH key pair")
+}
+
+// DeleteManagedSSHPrivateKey does nothing, as managed control planes have no managed SSH key pair.
+func (s *ManagedControlPlaneScope) DeleteManagedSSHPrivateKey() error {
+	return nil
+}
+
 // ControllerName returns the name of the controller that
 // created the ManagedControlPlane.
 func (s *ManagedControlPlaneScope) ControllerName() string {
@@ -19,10 +19,12 @@ package asg
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
@@ -70,6 +72,11 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscaling.Group) (*expinfrav1.AutoS
 		}
 	}
 
+	// A warm pool being deleted is treated as absent.
+	if v.WarmPoolConfiguration != nil && aws.StringValue(v.WarmPoolConfiguration.Status) != autoscaling.WarmPoolStatusPendingDelete {
+		i.WarmPool = sdkToWarmPool(v.WarmPoolConfiguration)
+	}
+
 	if v.Status != nil {
 		i.Status = expinfrav1.ASGStatus(*v.Status)
 	}
@@ -85,6 +92,13 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscaling.Group) (*expinfrav1.AutoS
 				State: infrav1.InstanceState(*autoscalingInstance.LifecycleState),
 			}
 			i.Instances = append(i.Instances, *tmp)
+
+			if lt := autoscalingInstance.LaunchTemplate; lt != nil && lt.Version != nil {
+				if i.InstanceLaunchTemplateVersions == nil {
+					i.InstanceLaunchTemplateVersions = map[string]string{}
+				}
+				i.InstanceLaunchTemplateVersions[tmp.ID] = aws.StringValue(lt.Version)
+			}
 		}
 	}
 
@@ -284,7 +298,7 @@ func (s *Service) UpdateASG(scope *scope.MachinePoolScope) error {
 		CapacityRebalance:    aws.Bool(scope.AWSMachinePool.Spec.CapacityRebalance),
 	}
 
-	if scope.MachinePool.Spec.Replicas != nil {
+	if scope.MachinePool.Spec.Replicas != nil && !scope.ReplicasExternallyManaged() {
 		input.DesiredCapacity = aws.Int64(int64(*scope.MachinePool.Spec.Replicas))
 	}
 
@@ -326,32 +340,124 @@ func (s *Service) CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (boo
 	return true, nil
 }
 
-func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
+// StartASGInstanceRefresh starts an instance refresh of the ASG of the machine pool and returns its ID.
+func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) (string, error) {
 	strategy := pointer.StringPtr(autoscaling.RefreshStrategyRolling)
-	var minHealthyPercentage, instanceWarmup *int64
-	if scope.AWSMachinePool.Spec.RefreshPreferences != nil {
-		if scope.AWSMachinePool.Spec.RefreshPreferences.Strategy != nil {
-			strategy = scope.AWSMachinePool.Spec.RefreshPreferences.Strategy
-		}
-		if scope.AWSMachinePool.Spec.RefreshPreferences.InstanceWarmup != nil {
-			instanceWarmup = scope.AWSMachinePool.Spec.RefreshPreferences.InstanceWarmup
+	preferences := &autoscaling.RefreshPreferences{}
+	if prefs := scope.AWSMachinePool.Spec.RefreshPreferences; prefs != nil {
+		if prefs.Strategy != nil {
+			strategy = prefs.Strategy
 		}
-		if scope.AWSMachinePool.Spec.RefreshPreferences.MinHealthyPercentage != nil {
-			minHealthyPercentage = scope.AWSMachinePool.Spec.RefreshPreferences.MinHealthyPercentage
+		preferences.InstanceWarmup = prefs.InstanceWarmup
+		preferences.MinHealthyPercentage = prefs.MinHealthyPercentage
+		if len(prefs.CheckpointPercentages) > 0 {
+			preferences.CheckpointPercentages = aws.Int64Slice(prefs.CheckpointPercentages)
 		}
+		preferences.CheckpointDelay = prefs.CheckpointDelay
 	}
 
 	input := &autoscaling.StartInstanceRefreshInput{
 		AutoScalingGroupName: aws.String(scope.Name()),
 		Strategy:             strategy,
-		Preferences: &autoscaling.RefreshPreferences{
-			InstanceWarmup:       instanceWarmup,
-			MinHealthyPercentage: minHealthyPercentage,
-		},
+		Preferences:          preferences,
 	}
 
-	if _, err := s.ASGClient.StartInstanceRefresh(input); err != nil {
-		return errors.Wrapf(err, "failed to start ASG instance refresh %q", scope.Name())
+	out, err := s.ASGClient.StartInstanceRefresh(input)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to start ASG instance refresh %q", scope.Name())
+	}
+
+	return aws.StringValue(out.InstanceRefreshId), nil
+}
+
+// DescribeLatestInstanceRefresh returns the progress of the latest instance refresh of the ASG of the machine pool,
+// or nil if it never had one.
+func (s *Service) DescribeLatestInstanceRefresh(scope *scope.MachinePoolScope) (*expinfrav1.InstanceRefreshStatus, error) {
+	out, err := s.ASGClient.DescribeInstanceRefreshes(&autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(scope.Name()),
+		MaxRecords:           aws.Int64(1),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instance refreshes of ASG %q", scope.Name())
+	}
+
+	// Instance refreshes are returned newest first.
+	if len(out.InstanceRefreshes) == 0 {
+		return nil, nil
+	}
+	refresh := out.InstanceRefreshes[0]
+
+	status := &expinfrav1.InstanceRefreshStatus{
+		ID:                 aws.StringValue(refresh.InstanceRefreshId),
+		Status:             aws.StringValue(refresh.Status),
+		StatusReason:       aws.StringValue(refresh.StatusReason),
+		PercentageComplete: refresh.PercentageComplete,
+		InstancesToUpdate:  refresh.InstancesToUpdate,
+	}
+	if refresh.StartTime != nil {
+		startTime := metav1.NewTime(*refresh.StartTime)
+		status.StartTime = &startTime
+	}
+	if refresh.EndTime != nil {
+		endTime := metav1.NewTime(*refresh.EndTime)
+		status.EndTime = &endTime
+	}
+
+	return status, nil
+}
+
+// CancelASGInstanceRefresh cancels the instance refresh in progress of the ASG of the machine pool.
+func (s *Service) CancelASGInstanceRefresh(scope *scope.MachinePoolScope) error {
+	if _, err := s.ASGClient.CancelInstanceRefresh(&autoscaling.CancelInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(scope.Name()),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to cancel ASG instance refresh %q", scope.Name())
+	}
+
+	return nil
+}
+
+// launchActivityDescriptionPrefix starts the description of the scaling activities that launch an instance.
+const launchActivityDescriptionPrefix = "Launching a new EC2 instance"
+
+// HasFailedLaunchActivities returns true if an instance of the ASG of the machine pool failed to launch after the
+// given time. The ASG launches its instances from the latest version of the launch template, so the launches after
+// an instance refresh started use the launch template version it rolls out. Other failed activities, such as
+// scale-ins, are ignored.
+func (s *Service) HasFailedLaunchActivities(scope *scope.MachinePoolScope, since time.Time) (bool, error) {
+	failed := false
+	// Scaling activities are returned newest first, so the pages are read until an older activity is found.
+	if err := s.ASGClient.DescribeScalingActivitiesPages(&autoscaling.DescribeScalingActivitiesInput{
+		AutoScalingGroupName: aws.String(scope.Name()),
+	}, func(out *autoscaling.DescribeScalingActivitiesOutput, _ bool) bool {
+		for _, activity := range out.Activities {
+			if activity.StartTime != nil && activity.StartTime.Before(since) {
+				return false
+			}
+			if aws.StringValue(activity.StatusCode) == autoscaling.ScalingActivityStatusCodeFailed &&
+				strings.HasPrefix(aws.StringValue(activity.Description), launchActivityDescriptionPrefix) {
+				failed = true
+				return false
+			}
+		}
+		return true
+	}); err != nil {
+		return false, errors.Wrapf(err, "failed to describe scaling activities of ASG %q", scope.Name())
+	}
+
+	return failed, nil
+}
+
+// TerminateASGInstance terminates an instance of an ASG, which launches a replacement instance as the desired
+// capacity of the group is left unchanged.
+func (s *Service) TerminateASGInstance(instanceID string) error {
+	s.scope.V(2).Info("Terminating ASG instance", "instance-id", instanceID)
+
+	if _, err := s.ASGClient.TerminateInstanceInAutoScalingGroup(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String(instanceID),
+		ShouldDecrementDesiredCapacity: aws.Bool(false),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to terminate ASG instance %q", instanceID)
 	}
 
 	return nil
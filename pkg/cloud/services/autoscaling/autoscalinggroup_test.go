@@ -19,10 +19,12 @@ package asg
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
@@ -197,6 +199,96 @@ func TestService_SDKToAutoScalingGroup(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid input - with warm pool",
+			input: &autoscaling.Group{
+				AutoScalingGroupARN:  aws.String("test-id"),
+				AutoScalingGroupName: aws.String("test-name"),
+				DesiredCapacity:      aws.Int64(1234),
+				MaxSize:              aws.Int64(1234),
+				MinSize:              aws.Int64(1234),
+				WarmPoolConfiguration: &autoscaling.WarmPoolConfiguration{
+					MinSize:                  aws.Int64(2),
+					MaxGroupPreparedCapacity: aws.Int64(-1),
+					PoolState:                aws.String("Hibernated"),
+					InstanceReusePolicy: &autoscaling.InstanceReusePolicy{
+						ReuseOnScaleIn: aws.Bool(true),
+					},
+				},
+			},
+			want: &expinfrav1.AutoScalingGroup{
+				ID:              "test-id",
+				Name:            "test-name",
+				DesiredCapacity: aws.Int32(1234),
+				MaxSize:         int32(1234),
+				MinSize:         int32(1234),
+				WarmPool: &expinfrav1.WarmPool{
+					MinSize:        2,
+					PoolState:      expinfrav1.WarmPoolStateHibernated,
+					ReuseOnScaleIn: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid input - with warm pool being deleted",
+			input: &autoscaling.Group{
+				AutoScalingGroupARN:  aws.String("test-id"),
+				AutoScalingGroupName: aws.String("test-name"),
+				DesiredCapacity:      aws.Int64(1234),
+				MaxSize:              aws.Int64(1234),
+				MinSize:              aws.Int64(1234),
+				WarmPoolConfiguration: &autoscaling.WarmPoolConfiguration{
+					PoolState: aws.String("Stopped"),
+					Status:    aws.String("PendingDelete"),
+				},
+			},
+			want: &expinfrav1.AutoScalingGroup{
+				ID:              "test-id",
+				Name:            "test-name",
+				DesiredCapacity: aws.Int32(1234),
+				MaxSize:         int32(1234),
+				MinSize:         int32(1234),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid input - with instances",
+			input: &autoscaling.Group{
+				AutoScalingGroupARN:  aws.String("test-id"),
+				AutoScalingGroupName: aws.String("test-name"),
+				DesiredCapacity:      aws.Int64(1234),
+				MaxSize:              aws.Int64(1234),
+				MinSize:              aws.Int64(1234),
+				Instances: []*autoscaling.Instance{
+					{
+						InstanceId:     aws.String("i-1"),
+						LifecycleState: aws.String("InService"),
+						LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+							LaunchTemplateId: aws.String("lt-1"),
+							Version:          aws.String("2"),
+						},
+					},
+					{
+						InstanceId:     aws.String("i-2"),
+						LifecycleState: aws.String("Pending"),
+					},
+				},
+			},
+			want: &expinfrav1.AutoScalingGroup{
+				ID:              "test-id",
+				Name:            "test-name",
+				DesiredCapacity: aws.Int32(1234),
+				MaxSize:         int32(1234),
+				MinSize:         int32(1234),
+				Instances: []infrav1.Instance{
+					{ID: "i-1", State: "InService"},
+					{ID: "i-2", State: "Pending"},
+				},
+				InstanceLaunchTemplateVersions: map[string]string{"i-1": "2"},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -212,3 +304,266 @@ func TestService_SDKToAutoScalingGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestService_StartASGInstanceRefresh(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    &clusterv1.Cluster{},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	s := NewService(cs)
+	s.ASGClient = asgMock
+
+	asgMock.EXPECT().StartInstanceRefresh(gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String("test-asg"),
+		Strategy:             aws.String("Rolling"),
+		Preferences: &autoscaling.RefreshPreferences{
+			MinHealthyPercentage:  aws.Int64(80),
+			CheckpointPercentages: aws.Int64Slice([]int64{20, 100}),
+			CheckpointDelay:       aws.Int64(600),
+		},
+	})).
+		Return(&autoscaling.StartInstanceRefreshOutput{InstanceRefreshId: aws.String("refresh-1")}, nil)
+
+	mps := &scope.MachinePoolScope{
+		AWSMachinePool: &expinfrav1.AWSMachinePool{
+			Spec: expinfrav1.AWSMachinePoolSpec{
+				RefreshPreferences: &expinfrav1.RefreshPreferences{
+					MinHealthyPercentage:  aws.Int64(80),
+					CheckpointPercentages: []int64{20, 100},
+					CheckpointDelay:       aws.Int64(600),
+				},
+			},
+		},
+	}
+	mps.AWSMachinePool.Name = "test-asg"
+
+	id, err := s.StartASGInstanceRefresh(mps)
+	if err != nil {
+		t.Fatalf("Service.StartASGInstanceRefresh() error = %v", err)
+	}
+	if id != "refresh-1" {
+		t.Errorf("Service.StartASGInstanceRefresh() = %v, want %v", id, "refresh-1")
+	}
+}
+
+func TestService_DescribeLatestInstanceRefresh(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	startTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	describeInput := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String("test-asg"),
+		MaxRecords:           aws.Int64(1),
+	}
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		want    *expinfrav1.InstanceRefreshStatus
+		wantErr bool
+	}{
+		{
+			name: "returns nothing without instance refreshes",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeInstanceRefreshes(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeInstanceRefreshesOutput{}, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "returns the progress of the latest instance refresh",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeInstanceRefreshes(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeInstanceRefreshesOutput{
+						InstanceRefreshes: []*autoscaling.InstanceRefresh{{
+							InstanceRefreshId:  aws.String("refresh-1"),
+							Status:             aws.String("InProgress"),
+							StatusReason:       aws.String("Waiting for instances to warm up"),
+							PercentageComplete: aws.Int64(40),
+							InstancesToUpdate:  aws.Int64(3),
+							StartTime:          aws.Time(startTime),
+						}},
+					}, nil)
+			},
+			want: &expinfrav1.InstanceRefreshStatus{
+				ID:                 "refresh-1",
+				Status:             "InProgress",
+				StatusReason:       "Waiting for instances to warm up",
+				PercentageComplete: aws.Int64(40),
+				InstancesToUpdate:  aws.Int64(3),
+				StartTime:          &metav1.Time{Time: startTime},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fails to describe instance refreshes",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeInstanceRefreshes(gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tt.expect(asgMock.EXPECT())
+			s := NewService(cs)
+			s.ASGClient = asgMock
+
+			mps := &scope.MachinePoolScope{AWSMachinePool: &expinfrav1.AWSMachinePool{}}
+			mps.AWSMachinePool.Name = "test-asg"
+
+			got, err := s.DescribeLatestInstanceRefresh(mps)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.DescribeLatestInstanceRefresh() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.DescribeLatestInstanceRefresh() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_HasFailedLaunchActivities(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	since := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		activities [][]*autoscaling.Activity
+		pagesRead  int
+		want       bool
+	}{
+		{
+			name: "launch failed after the given time",
+			activities: [][]*autoscaling.Activity{{
+				{StatusCode: aws.String("Successful"), Description: aws.String("Launching a new EC2 instance: i-1"), StartTime: aws.Time(since.Add(2 * time.Minute))},
+				{StatusCode: aws.String("Failed"), Description: aws.String("Launching a new EC2 instance.  Status Reason: Insufficient capacity."), StartTime: aws.Time(since.Add(time.Minute))},
+			}},
+			pagesRead: 1,
+			want:      true,
+		},
+		{
+			name: "launch failed before the given time",
+			activities: [][]*autoscaling.Activity{{
+				{StatusCode: aws.String("Successful"), Description: aws.String("Launching a new EC2 instance: i-1"), StartTime: aws.Time(since.Add(time.Minute))},
+				{StatusCode: aws.String("Failed"), Description: aws.String("Launching a new EC2 instance"), StartTime: aws.Time(since.Add(-time.Minute))},
+			}},
+			pagesRead: 1,
+			want:      false,
+		},
+		{
+			name: "scale-in failed after the given time",
+			activities: [][]*autoscaling.Activity{{
+				{StatusCode: aws.String("Failed"), Description: aws.String("Terminating EC2 instance: i-1"), StartTime: aws.Time(since.Add(time.Minute))},
+			}},
+			pagesRead: 1,
+			want:      false,
+		},
+		{
+			name: "launch failed after the given time on a later page",
+			activities: [][]*autoscaling.Activity{
+				{{StatusCode: aws.String("Successful"), Description: aws.String("Launching a new EC2 instance: i-1"), StartTime: aws.Time(since.Add(3 * time.Minute))}},
+				{{StatusCode: aws.String("Failed"), Description: aws.String("Launching a new EC2 instance"), StartTime: aws.Time(since.Add(time.Minute))}},
+			},
+			pagesRead: 2,
+			want:      true,
+		},
+		{
+			name: "pages older than the given time are not read",
+			activities: [][]*autoscaling.Activity{
+				{{StatusCode: aws.String("Successful"), Description: aws.String("Launching a new EC2 instance: i-1"), StartTime: aws.Time(since.Add(-time.Minute))}},
+				{{StatusCode: aws.String("Failed"), Description: aws.String("Launching a new EC2 instance"), StartTime: aws.Time(since.Add(-2 * time.Minute))}},
+			},
+			pagesRead: 1,
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			pagesRead := 0
+			asgMock.EXPECT().DescribeScalingActivitiesPages(gomock.Eq(&autoscaling.DescribeScalingActivitiesInput{
+				AutoScalingGroupName: aws.String("test-asg"),
+			}), gomock.Any()).
+				Do(func(_ *autoscaling.DescribeScalingActivitiesInput, fn func(*autoscaling.DescribeScalingActivitiesOutput, bool) bool) {
+					for i, activities := range tt.activities {
+						pagesRead++
+						if !fn(&autoscaling.DescribeScalingActivitiesOutput{Activities: activities}, i == len(tt.activities)-1) {
+							return
+						}
+					}
+				}).
+				Return(nil)
+			s := NewService(cs)
+			s.ASGClient = asgMock
+
+			mps := &scope.MachinePoolScope{AWSMachinePool: &expinfrav1.AWSMachinePool{}}
+			mps.AWSMachinePool.Name = "test-asg"
+
+			got, err := s.HasFailedLaunchActivities(mps, since)
+			if err != nil {
+				t.Fatalf("Service.HasFailedLaunchActivities() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Service.HasFailedLaunchActivities() = %v, want %v", got, tt.want)
+			}
+			if pagesRead != tt.pagesRead {
+				t.Errorf("Service.HasFailedLaunchActivities() read %d pages, want %d", pagesRead, tt.pagesRead)
+			}
+		})
+	}
+}
+
+func TestService_TerminateASGInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    &clusterv1.Cluster{},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	s := NewService(cs)
+	s.ASGClient = asgMock
+
+	asgMock.EXPECT().TerminateInstanceInAutoScalingGroup(gomock.Eq(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String("i-1"),
+		ShouldDecrementDesiredCapacity: aws.Bool(false),
+	})).
+		Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+
+	if err := s.TerminateASGInstance("i-1"); err != nil {
+		t.Fatalf("Service.TerminateASGInstance() error = %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	// lifecycleTransitionTerminating is the transition of the lifecycle hooks that pause the termination of instances.
+	lifecycleTransitionTerminating = "autoscaling:EC2_INSTANCE_TERMINATING"
+
+	// defaultHeartbeatTimeout is the heartbeat timeout of lifecycle hooks that don't set one.
+	defaultHeartbeatTimeout = 300 * time.Second
+)
+
+// ReconcileLifecycleHooks creates or updates the lifecycle hooks of the ASG of the machine pool, and deletes
+// the ones the controller created that the AWSMachinePool no longer has. The names of the lifecycle hooks created
+// by the controller are recorded in the status of the AWSMachinePool, so that the ones created outside of it are kept.
+func (s *Service) ReconcileLifecycleHooks(scope *scope.MachinePoolScope) error {
+	out, err := s.ASGClient.DescribeLifecycleHooks(&autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: aws.String(scope.Name()),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe lifecycle hooks of ASG %q", scope.Name())
+	}
+
+	existing := map[string]*autoscaling.LifecycleHook{}
+	for _, hook := range out.LifecycleHooks {
+		existing[aws.StringValue(hook.LifecycleHookName)] = hook
+	}
+
+	owned := sets.NewString(scope.AWSMachinePool.Status.LifecycleHooks...)
+	defer func() {
+		scope.AWSMachinePool.Status.LifecycleHooks = nil
+		if owned.Len() > 0 {
+			scope.AWSMachinePool.Status.LifecycleHooks = owned.List()
+		}
+	}()
+
+	desired := sets.NewString()
+	for i := range scope.AWSMachinePool.Spec.LifecycleHooks {
+		hook := &scope.AWSMachinePool.Spec.LifecycleHooks[i]
+		desired.Insert(hook.Name)
+		if current, ok := existing[hook.Name]; ok && !lifecycleHookNeedsUpdate(hook, current) {
+			owned.Insert(hook.Name)
+			continue
+		}
+
+		s.scope.V(2).Info("Updating ASG lifecycle hook", "name", scope.Name(), "hook", hook.Name)
+		if _, err := s.ASGClient.PutLifecycleHook(&autoscaling.PutLifecycleHookInput{
+			AutoScalingGroupName: aws.String(scope.Name()),
+			LifecycleHookName:    aws.String(hook.Name),
+			LifecycleTransition:  aws.String(lifecycleTransitionTerminating),
+			HeartbeatTimeout:     aws.Int64(int64(HeartbeatTimeout(hook).Seconds())),
+			DefaultResult:        aws.String(string(defaultResult(hook))),
+		}); err != nil {
+			record.Warnf(scope.AWSMachinePool, "FailedUpdateLifecycleHook", "Failed to update lifecycle hook %q of ASG %q: %v", hook.Name, scope.Name(), err)
+			return errors.Wrapf(err, "failed to update lifecycle hook %q of ASG %q", hook.Name, scope.Name())
+		}
+		owned.Insert(hook.Name)
+	}
+
+	for _, name := range owned.Difference(desired).List() {
+		if _, ok := existing[name]; !ok {
+			owned.Delete(name)
+			continue
+		}
+
+		s.scope.V(2).Info("Deleting ASG lifecycle hook", "name", scope.Name(), "hook", name)
+		if _, err := s.ASGClient.DeleteLifecycleHook(&autoscaling.DeleteLifecycleHookInput{
+			AutoScalingGroupName: aws.String(scope.Name()),
+			LifecycleHookName:    aws.String(name),
+		}); err != nil {
+			record.Warnf(scope.AWSMachinePool, "FailedDeleteLifecycleHook", "Failed to delete lifecycle hook %q of ASG %q: %v", name, scope.Name(), err)
+			return errors.Wrapf(err, "failed to delete lifecycle hook %q of ASG %q", name, scope.Name())
+		}
+		owned.Delete(name)
+		record.Eventf(scope.AWSMachinePool, "SuccessfulDeleteLifecycleHook", "Deleted lifecycle hook %q of ASG %q", name, scope.Name())
+	}
+
+	return nil
+}
+
+// lifecycleHookNeedsUpdate compares a lifecycle hook of the AWSMachinePool against the one of the existing ASG.
+func lifecycleHookNeedsUpdate(hook *expinfrav1.AWSLifecycleHook, existing *autoscaling.LifecycleHook) bool {
+	return aws.StringValue(existing.LifecycleTransition) != lifecycleTransitionTerminating ||
+		aws.Int64Value(existing.HeartbeatTimeout) != int64(HeartbeatTimeout(hook).Seconds()) ||
+		aws.StringValue(existing.DefaultResult) != string(defaultResult(hook))
+}
+
+// HeartbeatTimeout returns the heartbeat timeout of the lifecycle hook, defaulting to 5 minutes.
+func HeartbeatTimeout(hook *expinfrav1.AWSLifecycleHook) time.Duration {
+	if hook.HeartbeatTimeout == nil {
+		return defaultHeartbeatTimeout
+	}
+	return hook.HeartbeatTimeout.Duration
+}
+
+// defaultResult returns the default result of the lifecycle hook, defaulting to CONTINUE.
+func defaultResult(hook *expinfrav1.AWSLifecycleHook) expinfrav1.LifecycleHookDefaultResult {
+	if hook.DefaultResult == "" {
+		return expinfrav1.LifecycleHookDefaultResultContinue
+	}
+	return hook.DefaultResult
+}
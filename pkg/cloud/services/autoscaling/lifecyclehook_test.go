@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestService_ReconcileLifecycleHooks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeInput := &autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: aws.String("test-asg"),
+	}
+
+	tests := []struct {
+		name          string
+		hooks         []expinfrav1.AWSLifecycleHook
+		owned         []string
+		expect        func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		expectedOwned []string
+		wantErr       bool
+	}{
+		{
+			name:  "creates a missing hook with default settings",
+			hooks: []expinfrav1.AWSLifecycleHook{{Name: "drain"}},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeLifecycleHooks(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeLifecycleHooksOutput{}, nil)
+				m.PutLifecycleHook(gomock.Eq(&autoscaling.PutLifecycleHookInput{
+					AutoScalingGroupName: aws.String("test-asg"),
+					LifecycleHookName:    aws.String("drain"),
+					LifecycleTransition:  aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+					HeartbeatTimeout:     aws.Int64(300),
+					DefaultResult:        aws.String("CONTINUE"),
+				})).
+					Return(&autoscaling.PutLifecycleHookOutput{}, nil)
+			},
+			expectedOwned: []string{"drain"},
+			wantErr:       false,
+		},
+		{
+			name: "updates a changed hook",
+			hooks: []expinfrav1.AWSLifecycleHook{{
+				Name:             "drain",
+				HeartbeatTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+				DefaultResult:    expinfrav1.LifecycleHookDefaultResultAbandon,
+			}},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeLifecycleHooks(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeLifecycleHooksOutput{
+						LifecycleHooks: []*autoscaling.LifecycleHook{{
+							LifecycleHookName:   aws.String("drain"),
+							LifecycleTransition: aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+							HeartbeatTimeout:    aws.Int64(300),
+							DefaultResult:       aws.String("CONTINUE"),
+						}},
+					}, nil)
+				m.PutLifecycleHook(gomock.Eq(&autoscaling.PutLifecycleHookInput{
+					AutoScalingGroupName: aws.String("test-asg"),
+					LifecycleHookName:    aws.String("drain"),
+					LifecycleTransition:  aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+					HeartbeatTimeout:     aws.Int64(600),
+					DefaultResult:        aws.String("ABANDON"),
+				})).
+					Return(&autoscaling.PutLifecycleHookOutput{}, nil)
+			},
+			expectedOwned: []string{"drain"},
+			wantErr:       false,
+		},
+		{
+			name:  "keeps an unchanged hook and deletes a removed one",
+			hooks: []expinfrav1.AWSLifecycleHook{{Name: "drain"}},
+			owned: []string{"drain", "removed"},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeLifecycleHooks(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeLifecycleHooksOutput{
+						LifecycleHooks: []*autoscaling.LifecycleHook{
+							{
+								LifecycleHookName:   aws.String("drain"),
+								LifecycleTransition: aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+								HeartbeatTimeout:    aws.Int64(300),
+								DefaultResult:       aws.String("CONTINUE"),
+							},
+							{
+								LifecycleHookName:   aws.String("removed"),
+								LifecycleTransition: aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+							},
+						},
+					}, nil)
+				m.DeleteLifecycleHook(gomock.Eq(&autoscaling.DeleteLifecycleHookInput{
+					AutoScalingGroupName: aws.String("test-asg"),
+					LifecycleHookName:    aws.String("removed"),
+				})).
+					Return(&autoscaling.DeleteLifecycleHookOutput{}, nil)
+			},
+			expectedOwned: []string{"drain"},
+			wantErr:       false,
+		},
+		{
+			name:  "keeps a hook created outside of the controller",
+			owned: []string{"drain"},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeLifecycleHooks(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeLifecycleHooksOutput{
+						LifecycleHooks: []*autoscaling.LifecycleHook{
+							{
+								LifecycleHookName:   aws.String("external"),
+								LifecycleTransition: aws.String("autoscaling:EC2_INSTANCE_LAUNCHING"),
+							},
+						},
+					}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "keeps a hook that failed to be deleted",
+			owned: []string{"removed"},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeLifecycleHooks(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeLifecycleHooksOutput{
+						LifecycleHooks: []*autoscaling.LifecycleHook{
+							{LifecycleHookName: aws.String("removed")},
+						},
+					}, nil)
+				m.DeleteLifecycleHook(gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			expectedOwned: []string{"removed"},
+			wantErr:       true,
+		},
+		{
+			name:  "fails to describe hooks",
+			hooks: []expinfrav1.AWSLifecycleHook{{Name: "drain"}},
+			owned: []string{"drain"},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeLifecycleHooks(gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			expectedOwned: []string{"drain"},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tt.expect(asgMock.EXPECT())
+			s := NewService(cs)
+			s.ASGClient = asgMock
+
+			mps := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{
+						LifecycleHooks: tt.hooks,
+					},
+					Status: expinfrav1.AWSMachinePoolStatus{
+						LifecycleHooks: tt.owned,
+					},
+				},
+			}
+			mps.AWSMachinePool.Name = "test-asg"
+
+			if err := s.ReconcileLifecycleHooks(mps); (err != nil) != tt.wantErr {
+				t.Errorf("Service.ReconcileLifecycleHooks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(mps.AWSMachinePool.Status.LifecycleHooks, tt.expectedOwned) {
+				t.Errorf("Service.ReconcileLifecycleHooks() owned hooks = %v, want %v", mps.AWSMachinePool.Status.LifecycleHooks, tt.expectedOwned)
+			}
+		})
+	}
+}
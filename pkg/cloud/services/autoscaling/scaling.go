@@ -0,0 +1,346 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	policyTypeTargetTracking = "TargetTrackingScaling"
+	policyTypeStepScaling    = "StepScaling"
+
+	// defaultMetricAggregationType is the aggregation of the metric of step scaling policies that don't set one.
+	defaultMetricAggregationType = "Average"
+)
+
+// ReconcileScheduledActions creates or updates the scheduled actions of the ASG of the machine pool, and deletes
+// the ones the controller created that the AWSMachinePool no longer has. The names of the scheduled actions created
+// by the controller are recorded in the status of the AWSMachinePool, so that the ones created outside of it are kept.
+func (s *Service) ReconcileScheduledActions(scope *scope.MachinePoolScope) error {
+	existing, err := s.describeScheduledActions(scope.Name())
+	if err != nil {
+		return err
+	}
+
+	owned := sets.NewString(scope.AWSMachinePool.Status.ScheduledActions...)
+	defer func() {
+		scope.AWSMachinePool.Status.ScheduledActions = nil
+		if owned.Len() > 0 {
+			scope.AWSMachinePool.Status.ScheduledActions = owned.List()
+		}
+	}()
+
+	desired := sets.NewString()
+	for i := range scope.AWSMachinePool.Spec.ScheduledActions {
+		action := &scope.AWSMachinePool.Spec.ScheduledActions[i]
+		desired.Insert(action.Name)
+		current, ok := existing[action.Name]
+
+		input := scheduledActionInput(scope.Name(), action)
+		if ok && !scheduledActionNeedsUpdate(input, current) {
+			owned.Insert(action.Name)
+			continue
+		}
+		// One-time actions are deleted by Auto Scaling once they ran, and cannot be scheduled in the past.
+		if !ok && input.Recurrence == nil && input.StartTime.Before(time.Now()) {
+			owned.Delete(action.Name)
+			continue
+		}
+
+		s.scope.V(2).Info("Updating ASG scheduled action", "name", scope.Name(), "action", action.Name)
+		if _, err := s.ASGClient.PutScheduledUpdateGroupAction(input); err != nil {
+			record.Warnf(scope.AWSMachinePool, "FailedUpdateScheduledAction", "Failed to update scheduled action %q of ASG %q: %v", action.Name, scope.Name(), err)
+			return errors.Wrapf(err, "failed to update scheduled action %q of ASG %q", action.Name, scope.Name())
+		}
+		owned.Insert(action.Name)
+	}
+
+	for _, name := range owned.Difference(desired).List() {
+		if _, ok := existing[name]; !ok {
+			owned.Delete(name)
+			continue
+		}
+
+		s.scope.V(2).Info("Deleting ASG scheduled action", "name", scope.Name(), "action", name)
+		if _, err := s.ASGClient.DeleteScheduledAction(&autoscaling.DeleteScheduledActionInput{
+			AutoScalingGroupName: aws.String(scope.Name()),
+			ScheduledActionName:  aws.String(name),
+		}); err != nil {
+			record.Warnf(scope.AWSMachinePool, "FailedDeleteScheduledAction", "Failed to delete scheduled action %q of ASG %q: %v", name, scope.Name(), err)
+			return errors.Wrapf(err, "failed to delete scheduled action %q of ASG %q", name, scope.Name())
+		}
+		owned.Delete(name)
+		record.Eventf(scope.AWSMachinePool, "SuccessfulDeleteScheduledAction", "Deleted scheduled action %q of ASG %q", name, scope.Name())
+	}
+
+	return nil
+}
+
+// ReconcileScalingPolicies creates or updates the scaling policies of the ASG of the machine pool, deletes the
+// ones the controller created that the AWSMachinePool no longer has, and returns the ARNs of the policies by name.
+// The scaling policies created by the controller are the ones whose ARNs are recorded in the status of the
+// AWSMachinePool, so that the ones created outside of it are kept.
+func (s *Service) ReconcileScalingPolicies(scope *scope.MachinePoolScope) (map[string]string, error) {
+	existing, err := s.describeScalingPolicies(scope.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	desired := sets.NewString()
+	arns := map[string]string{}
+	for i := range scope.AWSMachinePool.Spec.ScalingPolicies {
+		policy := &scope.AWSMachinePool.Spec.ScalingPolicies[i]
+		desired.Insert(policy.Name)
+		current, ok := existing[policy.Name]
+
+		input := scalingPolicyInput(scope.Name(), policy)
+		if ok && !scalingPolicyNeedsUpdate(input, current) {
+			arns[policy.Name] = aws.StringValue(current.PolicyARN)
+			continue
+		}
+
+		s.scope.V(2).Info("Updating ASG scaling policy", "name", scope.Name(), "policy", policy.Name)
+		out, err := s.ASGClient.PutScalingPolicy(input)
+		if err != nil {
+			record.Warnf(scope.AWSMachinePool, "FailedUpdateScalingPolicy", "Failed to update scaling policy %q of ASG %q: %v", policy.Name, scope.Name(), err)
+			return nil, errors.Wrapf(err, "failed to update scaling policy %q of ASG %q", policy.Name, scope.Name())
+		}
+		arns[policy.Name] = aws.StringValue(out.PolicyARN)
+	}
+
+	for name := range scope.AWSMachinePool.Status.ScalingPolicyARNs {
+		if _, ok := existing[name]; !ok || desired.Has(name) {
+			continue
+		}
+
+		s.scope.V(2).Info("Deleting ASG scaling policy", "name", scope.Name(), "policy", name)
+		if _, err := s.ASGClient.DeletePolicy(&autoscaling.DeletePolicyInput{
+			AutoScalingGroupName: aws.String(scope.Name()),
+			PolicyName:           aws.String(name),
+		}); err != nil {
+			record.Warnf(scope.AWSMachinePool, "FailedDeleteScalingPolicy", "Failed to delete scaling policy %q of ASG %q: %v", name, scope.Name(), err)
+			return nil, errors.Wrapf(err, "failed to delete scaling policy %q of ASG %q", name, scope.Name())
+		}
+		record.Eventf(scope.AWSMachinePool, "SuccessfulDeleteScalingPolicy", "Deleted scaling policy %q of ASG %q", name, scope.Name())
+	}
+
+	return arns, nil
+}
+
+func (s *Service) describeScheduledActions(name string) (map[string]*autoscaling.ScheduledUpdateGroupAction, error) {
+	input := &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: aws.String(name),
+	}
+
+	actions := map[string]*autoscaling.ScheduledUpdateGroupAction{}
+	for {
+		out, err := s.ASGClient.DescribeScheduledActions(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe scheduled actions of ASG %q", name)
+		}
+
+		for _, action := range out.ScheduledUpdateGroupActions {
+			actions[aws.StringValue(action.ScheduledActionName)] = action
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	return actions, nil
+}
+
+func (s *Service) describeScalingPolicies(name string) (map[string]*autoscaling.ScalingPolicy, error) {
+	input := &autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: aws.String(name),
+	}
+
+	policies := map[string]*autoscaling.ScalingPolicy{}
+	for {
+		out, err := s.ASGClient.DescribePolicies(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe scaling policies of ASG %q", name)
+		}
+
+		for _, policy := range out.ScalingPolicies {
+			policies[aws.StringValue(policy.PolicyName)] = policy
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	return policies, nil
+}
+
+func scheduledActionInput(asgName string, action *expinfrav1.ScheduledAction) *autoscaling.PutScheduledUpdateGroupActionInput {
+	input := &autoscaling.PutScheduledUpdateGroupActionInput{
+		AutoScalingGroupName: aws.String(asgName),
+		ScheduledActionName:  aws.String(action.Name),
+		MinSize:              int32ToInt64Ptr(action.MinSize),
+		MaxSize:              int32ToInt64Ptr(action.MaxSize),
+		DesiredCapacity:      int32ToInt64Ptr(action.DesiredCapacity),
+	}
+	if action.Recurrence != "" {
+		input.Recurrence = aws.String(action.Recurrence)
+	}
+	if action.TimeZone != "" {
+		input.TimeZone = aws.String(action.TimeZone)
+	}
+	if action.StartTime != nil {
+		input.StartTime = aws.Time(action.StartTime.UTC())
+	}
+	if action.EndTime != nil {
+		input.EndTime = aws.Time(action.EndTime.UTC())
+	}
+	return input
+}
+
+// scheduledActionNeedsUpdate compares a scheduled action of the AWSMachinePool against the one of the existing ASG.
+func scheduledActionNeedsUpdate(desired *autoscaling.PutScheduledUpdateGroupActionInput, existing *autoscaling.ScheduledUpdateGroupAction) bool {
+	// The start time of a recurring action is reported as its next occurrence.
+	if desired.Recurrence == nil && !timeEqual(desired.StartTime, existing.StartTime) {
+		return true
+	}
+
+	return aws.StringValue(desired.Recurrence) != aws.StringValue(existing.Recurrence) ||
+		aws.StringValue(desired.TimeZone) != aws.StringValue(existing.TimeZone) ||
+		!timeEqual(desired.EndTime, existing.EndTime) ||
+		!reflect.DeepEqual(desired.MinSize, existing.MinSize) ||
+		!reflect.DeepEqual(desired.MaxSize, existing.MaxSize) ||
+		!reflect.DeepEqual(desired.DesiredCapacity, existing.DesiredCapacity)
+}
+
+func scalingPolicyInput(asgName string, policy *expinfrav1.ScalingPolicy) *autoscaling.PutScalingPolicyInput {
+	input := &autoscaling.PutScalingPolicyInput{
+		AutoScalingGroupName: aws.String(asgName),
+		PolicyName:           aws.String(policy.Name),
+	}
+	if policy.EstimatedInstanceWarmup != nil {
+		input.EstimatedInstanceWarmup = aws.Int64(int64(policy.EstimatedInstanceWarmup.Seconds()))
+	}
+
+	if tt := policy.TargetTracking; tt != nil {
+		input.PolicyType = aws.String(policyTypeTargetTracking)
+		input.TargetTrackingConfiguration = &autoscaling.TargetTrackingConfiguration{
+			TargetValue:    aws.Float64(float64(tt.TargetValue)),
+			DisableScaleIn: aws.Bool(tt.DisableScaleIn),
+		}
+		if tt.PredefinedMetric != "" {
+			input.TargetTrackingConfiguration.PredefinedMetricSpecification = &autoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: aws.String(string(tt.PredefinedMetric)),
+			}
+			if tt.ResourceLabel != "" {
+				input.TargetTrackingConfiguration.PredefinedMetricSpecification.ResourceLabel = aws.String(tt.ResourceLabel)
+			}
+		}
+		if tt.CustomMetric != nil {
+			input.TargetTrackingConfiguration.CustomizedMetricSpecification = sdkCustomizedMetric(tt.CustomMetric)
+		}
+		return input
+	}
+
+	if step := policy.StepScaling; step != nil {
+		input.PolicyType = aws.String(policyTypeStepScaling)
+		input.AdjustmentType = aws.String(step.AdjustmentType)
+		input.MetricAggregationType = aws.String(defaultMetricAggregationType)
+		if step.MetricAggregationType != "" {
+			input.MetricAggregationType = aws.String(step.MetricAggregationType)
+		}
+		for _, adjustment := range step.StepAdjustments {
+			input.StepAdjustments = append(input.StepAdjustments, &autoscaling.StepAdjustment{
+				MetricIntervalLowerBound: int64ToFloat64Ptr(adjustment.LowerBound),
+				MetricIntervalUpperBound: int64ToFloat64Ptr(adjustment.UpperBound),
+				ScalingAdjustment:        aws.Int64(adjustment.ScalingAdjustment),
+			})
+		}
+	}
+
+	return input
+}
+
+// scalingPolicyNeedsUpdate compares a scaling policy of the AWSMachinePool against the one of the existing ASG.
+func scalingPolicyNeedsUpdate(desired *autoscaling.PutScalingPolicyInput, existing *autoscaling.ScalingPolicy) bool {
+	return aws.StringValue(desired.PolicyType) != aws.StringValue(existing.PolicyType) ||
+		aws.StringValue(desired.AdjustmentType) != aws.StringValue(existing.AdjustmentType) ||
+		aws.StringValue(desired.MetricAggregationType) != aws.StringValue(existing.MetricAggregationType) ||
+		aws.Int64Value(desired.EstimatedInstanceWarmup) != aws.Int64Value(existing.EstimatedInstanceWarmup) ||
+		!reflect.DeepEqual(desired.StepAdjustments, existing.StepAdjustments) ||
+		!reflect.DeepEqual(desired.TargetTrackingConfiguration, existing.TargetTrackingConfiguration)
+}
+
+func sdkCustomizedMetric(metric *expinfrav1.CustomMetric) *autoscaling.CustomizedMetricSpecification {
+	spec := &autoscaling.CustomizedMetricSpecification{
+		Namespace:  aws.String(metric.Namespace),
+		MetricName: aws.String(metric.MetricName),
+		Statistic:  aws.String(metric.Statistic),
+	}
+	if metric.Unit != "" {
+		spec.Unit = aws.String(metric.Unit)
+	}
+
+	// Dimensions are sorted so that they compare equal to the ones of the existing policy.
+	names := make([]string, 0, len(metric.Dimensions))
+	for name := range metric.Dimensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		spec.Dimensions = append(spec.Dimensions, &autoscaling.MetricDimension{
+			Name:  aws.String(name),
+			Value: aws.String(metric.Dimensions[name]),
+		})
+	}
+
+	return spec
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func int32ToInt64Ptr(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+	return aws.Int64(int64(*v))
+}
+
+func int64ToFloat64Ptr(v *int64) *float64 {
+	if v == nil {
+		return nil
+	}
+	return aws.Float64(float64(*v))
+}
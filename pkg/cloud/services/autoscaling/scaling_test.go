@@ -0,0 +1,351 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestService_ReconcileScheduledActions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeInput := &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: aws.String("test-asg"),
+	}
+	past := metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name          string
+		actions       []expinfrav1.ScheduledAction
+		owned         []string
+		expect        func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		expectedOwned []string
+		wantErr       bool
+	}{
+		{
+			name: "creates a missing recurring action",
+			actions: []expinfrav1.ScheduledAction{{
+				Name:            "scale-up",
+				Recurrence:      "0 8 * * MON-FRI",
+				TimeZone:        "Europe/Berlin",
+				DesiredCapacity: aws.Int32(5),
+			}},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScheduledActions(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeScheduledActionsOutput{}, nil)
+				m.PutScheduledUpdateGroupAction(gomock.Eq(&autoscaling.PutScheduledUpdateGroupActionInput{
+					AutoScalingGroupName: aws.String("test-asg"),
+					ScheduledActionName:  aws.String("scale-up"),
+					Recurrence:           aws.String("0 8 * * MON-FRI"),
+					TimeZone:             aws.String("Europe/Berlin"),
+					DesiredCapacity:      aws.Int64(5),
+				})).
+					Return(&autoscaling.PutScheduledUpdateGroupActionOutput{}, nil)
+			},
+			expectedOwned: []string{"scale-up"},
+			wantErr:       false,
+		},
+		{
+			name:  "keeps an unchanged action, skips a past one-time action and deletes a removed one",
+			owned: []string{"launch", "removed", "scale-up"},
+			actions: []expinfrav1.ScheduledAction{
+				{
+					Name:       "scale-up",
+					Recurrence: "0 8 * * MON-FRI",
+					MinSize:    aws.Int32(2),
+				},
+				{
+					Name:      "launch",
+					StartTime: &past,
+					MinSize:   aws.Int32(10),
+				},
+			},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScheduledActions(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeScheduledActionsOutput{
+						ScheduledUpdateGroupActions: []*autoscaling.ScheduledUpdateGroupAction{
+							{
+								ScheduledActionName: aws.String("scale-up"),
+								Recurrence:          aws.String("0 8 * * MON-FRI"),
+								StartTime:           aws.Time(time.Now().Add(time.Hour)),
+								MinSize:             aws.Int64(2),
+							},
+							{
+								ScheduledActionName: aws.String("removed"),
+								Recurrence:          aws.String("0 20 * * *"),
+							},
+						},
+					}, nil)
+				m.DeleteScheduledAction(gomock.Eq(&autoscaling.DeleteScheduledActionInput{
+					AutoScalingGroupName: aws.String("test-asg"),
+					ScheduledActionName:  aws.String("removed"),
+				})).
+					Return(&autoscaling.DeleteScheduledActionOutput{}, nil)
+			},
+			expectedOwned: []string{"scale-up"},
+			wantErr:       false,
+		},
+		{
+			name: "keeps an action created outside of the controller",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScheduledActions(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribeScheduledActionsOutput{
+						ScheduledUpdateGroupActions: []*autoscaling.ScheduledUpdateGroupAction{
+							{
+								ScheduledActionName: aws.String("external"),
+								Recurrence:          aws.String("0 20 * * *"),
+							},
+						},
+					}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "fails to describe actions",
+			actions: []expinfrav1.ScheduledAction{{Name: "scale-up", Recurrence: "0 8 * * *"}},
+			owned:   []string{"scale-up"},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			expectedOwned: []string{"scale-up"},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tt.expect(asgMock.EXPECT())
+			s := NewService(cs)
+			s.ASGClient = asgMock
+
+			mps := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{
+						ScheduledActions: tt.actions,
+					},
+					Status: expinfrav1.AWSMachinePoolStatus{
+						ScheduledActions: tt.owned,
+					},
+				},
+			}
+			mps.AWSMachinePool.Name = "test-asg"
+
+			if err := s.ReconcileScheduledActions(mps); (err != nil) != tt.wantErr {
+				t.Errorf("Service.ReconcileScheduledActions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(mps.AWSMachinePool.Status.ScheduledActions, tt.expectedOwned) {
+				t.Errorf("Service.ReconcileScheduledActions() owned actions = %v, want %v", mps.AWSMachinePool.Status.ScheduledActions, tt.expectedOwned)
+			}
+		})
+	}
+}
+
+func TestService_ReconcileScalingPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeInput := &autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: aws.String("test-asg"),
+	}
+	cpuPolicy := expinfrav1.ScalingPolicy{
+		Name: "cpu",
+		TargetTracking: &expinfrav1.TargetTrackingScaling{
+			PredefinedMetric: expinfrav1.PredefinedMetricTypeCPUUtilization,
+			TargetValue:      60,
+		},
+	}
+	cpuConfiguration := &autoscaling.TargetTrackingConfiguration{
+		PredefinedMetricSpecification: &autoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws.String("ASGAverageCPUUtilization"),
+		},
+		TargetValue:    aws.Float64(60),
+		DisableScaleIn: aws.Bool(false),
+	}
+
+	tests := []struct {
+		name     string
+		policies []expinfrav1.ScalingPolicy
+		owned    map[string]string
+		expect   func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		want     map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "creates missing target tracking and step scaling policies",
+			policies: []expinfrav1.ScalingPolicy{
+				cpuPolicy,
+				{
+					Name:                    "queue",
+					EstimatedInstanceWarmup: &metav1.Duration{Duration: 2 * time.Minute},
+					StepScaling: &expinfrav1.StepScaling{
+						AdjustmentType: "ChangeInCapacity",
+						StepAdjustments: []expinfrav1.StepAdjustment{
+							{UpperBound: aws.Int64(100), ScalingAdjustment: 1},
+							{LowerBound: aws.Int64(100), ScalingAdjustment: 3},
+						},
+					},
+				},
+			},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribePoliciesOutput{}, nil)
+				m.PutScalingPolicy(gomock.Eq(&autoscaling.PutScalingPolicyInput{
+					AutoScalingGroupName:        aws.String("test-asg"),
+					PolicyName:                  aws.String("cpu"),
+					PolicyType:                  aws.String("TargetTrackingScaling"),
+					TargetTrackingConfiguration: cpuConfiguration,
+				})).
+					Return(&autoscaling.PutScalingPolicyOutput{PolicyARN: aws.String("arn:cpu")}, nil)
+				m.PutScalingPolicy(gomock.Eq(&autoscaling.PutScalingPolicyInput{
+					AutoScalingGroupName:    aws.String("test-asg"),
+					PolicyName:              aws.String("queue"),
+					PolicyType:              aws.String("StepScaling"),
+					AdjustmentType:          aws.String("ChangeInCapacity"),
+					MetricAggregationType:   aws.String("Average"),
+					EstimatedInstanceWarmup: aws.Int64(120),
+					StepAdjustments: []*autoscaling.StepAdjustment{
+						{MetricIntervalUpperBound: aws.Float64(100), ScalingAdjustment: aws.Int64(1)},
+						{MetricIntervalLowerBound: aws.Float64(100), ScalingAdjustment: aws.Int64(3)},
+					},
+				})).
+					Return(&autoscaling.PutScalingPolicyOutput{PolicyARN: aws.String("arn:queue")}, nil)
+			},
+			want:    map[string]string{"cpu": "arn:cpu", "queue": "arn:queue"},
+			wantErr: false,
+		},
+		{
+			name:     "keeps an unchanged policy and deletes a removed one",
+			policies: []expinfrav1.ScalingPolicy{cpuPolicy},
+			owned:    map[string]string{"cpu": "arn:cpu", "removed": "arn:removed"},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribePoliciesOutput{
+						ScalingPolicies: []*autoscaling.ScalingPolicy{
+							{
+								PolicyName:                  aws.String("cpu"),
+								PolicyARN:                   aws.String("arn:cpu"),
+								PolicyType:                  aws.String("TargetTrackingScaling"),
+								TargetTrackingConfiguration: cpuConfiguration,
+							},
+							{
+								PolicyName: aws.String("removed"),
+								PolicyARN:  aws.String("arn:removed"),
+								PolicyType: aws.String("SimpleScaling"),
+							},
+						},
+					}, nil)
+				m.DeletePolicy(gomock.Eq(&autoscaling.DeletePolicyInput{
+					AutoScalingGroupName: aws.String("test-asg"),
+					PolicyName:           aws.String("removed"),
+				})).
+					Return(&autoscaling.DeletePolicyOutput{}, nil)
+			},
+			want:    map[string]string{"cpu": "arn:cpu"},
+			wantErr: false,
+		},
+		{
+			name:  "keeps a policy created outside of the controller",
+			owned: map[string]string{"cpu": "arn:cpu"},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribePoliciesOutput{
+						ScalingPolicies: []*autoscaling.ScalingPolicy{
+							{
+								PolicyName: aws.String("external"),
+								PolicyARN:  aws.String("arn:external"),
+								PolicyType: aws.String("SimpleScaling"),
+							},
+						},
+					}, nil)
+			},
+			want:    map[string]string{},
+			wantErr: false,
+		},
+		{
+			name:     "fails to update a policy",
+			policies: []expinfrav1.ScalingPolicy{cpuPolicy},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(gomock.Eq(describeInput)).
+					Return(&autoscaling.DescribePoliciesOutput{}, nil)
+				m.PutScalingPolicy(gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tt.expect(asgMock.EXPECT())
+			s := NewService(cs)
+			s.ASGClient = asgMock
+
+			mps := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{
+						ScalingPolicies: tt.policies,
+					},
+					Status: expinfrav1.AWSMachinePoolStatus{
+						ScalingPolicyARNs: tt.owned,
+					},
+				},
+			}
+			mps.AWSMachinePool.Name = "test-asg"
+
+			got, err := s.ReconcileScalingPolicies(mps)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.ReconcileScalingPolicies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ReconcileScalingPolicies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
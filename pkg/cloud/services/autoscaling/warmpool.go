@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// UpdateWarmPool creates or updates the warm pool of the ASG of the machine pool, or deletes it if the
+// AWSMachinePool no longer has one.
+func (s *Service) UpdateWarmPool(scope *scope.MachinePoolScope) error {
+	warmPool := scope.AWSMachinePool.Spec.WarmPool
+	if warmPool == nil {
+		s.scope.V(2).Info("Deleting ASG warm pool", "name", scope.Name())
+
+		// Without ForceDelete, the instances of the warm pool are terminated before it is deleted.
+		input := &autoscaling.DeleteWarmPoolInput{
+			AutoScalingGroupName: aws.String(scope.Name()),
+		}
+		if _, err := s.ASGClient.DeleteWarmPool(input); err != nil {
+			record.Warnf(scope.AWSMachinePool, "FailedDeleteWarmPool", "Failed to delete warm pool of ASG %q: %v", scope.Name(), err)
+			return errors.Wrapf(err, "failed to delete warm pool of ASG %q", scope.Name())
+		}
+		record.Eventf(scope.AWSMachinePool, "SuccessfulDeleteWarmPool", "Deleted warm pool of ASG %q", scope.Name())
+
+		return nil
+	}
+
+	s.scope.V(2).Info("Updating ASG warm pool", "name", scope.Name())
+
+	input := &autoscaling.PutWarmPoolInput{
+		AutoScalingGroupName: aws.String(scope.Name()),
+		MinSize:              aws.Int64(int64(warmPool.MinSize)),
+		// -1 resets the maximum prepared capacity to the maximum size of the group.
+		MaxGroupPreparedCapacity: aws.Int64(-1),
+		PoolState:                aws.String(string(warmPoolState(warmPool))),
+		InstanceReusePolicy: &autoscaling.InstanceReusePolicy{
+			ReuseOnScaleIn: aws.Bool(warmPool.ReuseOnScaleIn),
+		},
+	}
+	if warmPool.MaxPreparedCapacity != nil {
+		input.MaxGroupPreparedCapacity = aws.Int64(int64(*warmPool.MaxPreparedCapacity))
+	}
+
+	if _, err := s.ASGClient.PutWarmPool(input); err != nil {
+		record.Warnf(scope.AWSMachinePool, "FailedUpdateWarmPool", "Failed to update warm pool of ASG %q: %v", scope.Name(), err)
+		return errors.Wrapf(err, "failed to update warm pool of ASG %q", scope.Name())
+	}
+
+	return nil
+}
+
+// DescribeWarmPoolInstances returns the instances in the warm pool of the ASG.
+func (s *Service) DescribeWarmPoolInstances(name string) ([]infrav1.Instance, error) {
+	input := &autoscaling.DescribeWarmPoolInput{
+		AutoScalingGroupName: aws.String(name),
+	}
+
+	instances := []infrav1.Instance{}
+	for {
+		out, err := s.ASGClient.DescribeWarmPool(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe warm pool of ASG %q", name)
+		}
+
+		for _, instance := range out.Instances {
+			instances = append(instances, infrav1.Instance{
+				ID:    aws.StringValue(instance.InstanceId),
+				State: infrav1.InstanceState(aws.StringValue(instance.LifecycleState)),
+			})
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	return instances, nil
+}
+
+// warmPoolState returns the state of the instances of the warm pool, defaulting to Stopped.
+func warmPoolState(warmPool *expinfrav1.WarmPool) expinfrav1.WarmPoolState {
+	if warmPool.PoolState == "" {
+		return expinfrav1.WarmPoolStateStopped
+	}
+	return warmPool.PoolState
+}
+
+func sdkToWarmPool(v *autoscaling.WarmPoolConfiguration) *expinfrav1.WarmPool {
+	warmPool := &expinfrav1.WarmPool{
+		MinSize:   int32(aws.Int64Value(v.MinSize)),
+		PoolState: expinfrav1.WarmPoolState(aws.StringValue(v.PoolState)),
+	}
+
+	// The maximum prepared capacity is either omitted or -1 when it defaults to the maximum size of the group.
+	if v.MaxGroupPreparedCapacity != nil && *v.MaxGroupPreparedCapacity >= 0 {
+		warmPool.MaxPreparedCapacity = aws.Int32(int32(*v.MaxGroupPreparedCapacity))
+	}
+
+	if v.InstanceReusePolicy != nil {
+		warmPool.ReuseOnScaleIn = aws.BoolValue(v.InstanceReusePolicy.ReuseOnScaleIn)
+	}
+
+	return warmPool
+}
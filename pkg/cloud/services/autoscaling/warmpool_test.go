@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestService_UpdateWarmPool(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	tests := []struct {
+		name     string
+		warmPool *expinfrav1.WarmPool
+		expect   func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		wantErr  bool
+	}{
+		{
+			name:     "puts a warm pool with default settings",
+			warmPool: &expinfrav1.WarmPool{},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.PutWarmPool(gomock.Eq(&autoscaling.PutWarmPoolInput{
+					AutoScalingGroupName:     aws.String("test-asg"),
+					MinSize:                  aws.Int64(0),
+					MaxGroupPreparedCapacity: aws.Int64(-1),
+					PoolState:                aws.String("Stopped"),
+					InstanceReusePolicy: &autoscaling.InstanceReusePolicy{
+						ReuseOnScaleIn: aws.Bool(false),
+					},
+				})).
+					Return(&autoscaling.PutWarmPoolOutput{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "puts a warm pool with all settings",
+			warmPool: &expinfrav1.WarmPool{
+				MinSize:             2,
+				MaxPreparedCapacity: aws.Int32(10),
+				PoolState:           expinfrav1.WarmPoolStateHibernated,
+				ReuseOnScaleIn:      true,
+			},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.PutWarmPool(gomock.Eq(&autoscaling.PutWarmPoolInput{
+					AutoScalingGroupName:     aws.String("test-asg"),
+					MinSize:                  aws.Int64(2),
+					MaxGroupPreparedCapacity: aws.Int64(10),
+					PoolState:                aws.String("Hibernated"),
+					InstanceReusePolicy: &autoscaling.InstanceReusePolicy{
+						ReuseOnScaleIn: aws.Bool(true),
+					},
+				})).
+					Return(&autoscaling.PutWarmPoolOutput{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:     "deletes the warm pool when it is removed",
+			warmPool: nil,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DeleteWarmPool(gomock.Eq(&autoscaling.DeleteWarmPoolInput{
+					AutoScalingGroupName: aws.String("test-asg"),
+				})).
+					Return(&autoscaling.DeleteWarmPoolOutput{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:     "fails to put the warm pool",
+			warmPool: &expinfrav1.WarmPool{},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.PutWarmPool(gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tt.expect(asgMock.EXPECT())
+			s := NewService(cs)
+			s.ASGClient = asgMock
+
+			mps := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{
+						WarmPool: tt.warmPool,
+					},
+				},
+			}
+			mps.AWSMachinePool.Name = "test-asg"
+
+			if err := s.UpdateWarmPool(mps); (err != nil) != tt.wantErr {
+				t.Errorf("Service.UpdateWarmPool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_DescribeWarmPoolInstances(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    &clusterv1.Cluster{},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	asgMock.EXPECT().
+		DescribeWarmPool(gomock.Eq(&autoscaling.DescribeWarmPoolInput{
+			AutoScalingGroupName: aws.String("test-asg"),
+		})).
+		Return(&autoscaling.DescribeWarmPoolOutput{
+			Instances: []*autoscaling.Instance{
+				{InstanceId: aws.String("i-1"), LifecycleState: aws.String("Warmed:Stopped")},
+			},
+			NextToken: aws.String("next"),
+		}, nil)
+	asgMock.EXPECT().
+		DescribeWarmPool(gomock.Eq(&autoscaling.DescribeWarmPoolInput{
+			AutoScalingGroupName: aws.String("test-asg"),
+			NextToken:            aws.String("next"),
+		})).
+		Return(&autoscaling.DescribeWarmPoolOutput{
+			Instances: []*autoscaling.Instance{
+				{InstanceId: aws.String("i-2"), LifecycleState: aws.String("Warmed:Pending")},
+			},
+		}, nil)
+
+	s := NewService(cs)
+	s.ASGClient = asgMock
+
+	instances, err := s.DescribeWarmPoolInstances("test-asg")
+	if err != nil {
+		t.Fatalf("did not expect error: %v", err)
+	}
+
+	want := []infrav1.Instance{
+		{ID: "i-1", State: infrav1.InstanceState("Warmed:Stopped")},
+		{ID: "i-2", State: infrav1.InstanceState("Warmed:Pending")},
+	}
+	if !reflect.DeepEqual(instances, want) {
+		t.Errorf("Service.DescribeWarmPoolInstances() = %v, want %v", instances, want)
+	}
+}
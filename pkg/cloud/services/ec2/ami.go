@@ -30,6 +30,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/blang/semver"
 	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 )
 
@@ -50,38 +52,79 @@ const (
 	// 4. a `-` followed by any additional characters
 	defaultAmiNameFormat = "capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*"
 
+	// defaultBastionAmiNameFormat is the AMI name format used to look up bastion AMIs, which are not
+	// built for a Kubernetes version.
+	defaultBastionAmiNameFormat = "capa-ami-{{.BaseOS}}-*"
+
 	// Amazon's AMI timestamp format
 	createDateTimestampFormat = "2006-01-02T15:04:05.000Z"
 
 	// EKS AMI ID SSM Parameter name
-	eksAmiSSMParameterFormat = "/aws/service/eks/optimized-ami/%s/amazon-linux-2/recommended/image_id"
+	eksAmiSSMParameterFormat = "/aws/service/eks/optimized-ami/%s/%s/recommended/image_id"
+
+	// ArchitectureX86_64 is the EC2 name of the x86_64 CPU architecture.
+	ArchitectureX86_64 = ec2.ArchitectureTypeX8664
+
+	// ArchitectureArm64 is the EC2 name of the arm64 CPU architecture.
+	ArchitectureArm64 = ec2.ArchitectureTypeArm64
 )
 
-// AMILookup contains the parameters used to template AMI names used for lookup.
+// AMILookup contains the parameters used to template AMI names and SSM parameters used for lookup.
 type AMILookup struct {
 	BaseOS     string
 	K8sVersion string
+	// K8sMajorMinorVersion is the Kubernetes version without patch level, for example 1.19.
+	K8sMajorMinorVersion string
+	// Arch is the EC2 name of the CPU architecture, x86_64 or arm64.
+	Arch string
+	// GoArch is the Go name of the CPU architecture, amd64 or arm64.
+	GoArch string
 }
 
-func amiName(amiNameFormat, baseOS, kubernetesVersion string) (string, error) {
-	amiNameParameters := AMILookup{baseOS, strings.TrimPrefix(kubernetesVersion, "v")}
-	// revert to default if not specified
-	if amiNameFormat == "" {
-		amiNameFormat = defaultAmiNameFormat
+func newAMILookup(baseOS, kubernetesVersion, architecture string) AMILookup {
+	if architecture == "" {
+		architecture = ArchitectureX86_64
+	}
+	goArch := architecture
+	if architecture == ArchitectureX86_64 {
+		goArch = "amd64"
+	}
+	// The major.minor version is only needed for some SSM parameters, so an unparsable
+	// version is left to fail the lookup itself.
+	majorMinorVersion, _ := formatVersionForEKS(kubernetesVersion)
+	return AMILookup{
+		BaseOS:               baseOS,
+		K8sVersion:           strings.TrimPrefix(kubernetesVersion, "v"),
+		K8sMajorMinorVersion: majorMinorVersion,
+		Arch:                 architecture,
+		GoArch:               goArch,
 	}
+}
+
+func (l AMILookup) execute(name, format string) (string, error) {
 	var templateBytes bytes.Buffer
-	template, err := template.New("amiName").Parse(amiNameFormat)
+	template, err := template.New(name).Parse(format)
 	if err != nil {
-		return amiNameFormat, errors.Wrapf(err, "failed create template from string: %q", amiNameFormat)
+		return format, errors.Wrapf(err, "failed create template from string: %q", format)
 	}
-	err = template.Execute(&templateBytes, amiNameParameters)
+	err = template.Execute(&templateBytes, l)
 	if err != nil {
-		return amiNameFormat, errors.Wrapf(err, "failed to substitute string: %q", amiNameFormat)
+		return format, errors.Wrapf(err, "failed to substitute string: %q", format)
 	}
 	return templateBytes.String(), nil
 }
 
-func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVersion, amiNameFormat string) (*ec2.Image, error) {
+func amiName(amiNameFormat, baseOS, kubernetesVersion, architecture string) (string, error) {
+	// revert to default if not specified
+	if amiNameFormat == "" {
+		amiNameFormat = defaultAmiNameFormat
+	}
+	return newAMILookup(baseOS, kubernetesVersion, architecture).execute("amiName", amiNameFormat)
+}
+
+// DefaultAMILookup looks up the latest AMI matching the name format for the given owner, base OS,
+// Kubernetes version and CPU architecture. The architecture defaults to x86_64.
+func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVersion, architecture, amiNameFormat string) (*ec2.Image, error) {
 	if amiNameFormat == "" {
 		amiNameFormat = defaultAmiNameFormat
 	}
@@ -91,8 +134,11 @@ func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVers
 	if baseOS == "" {
 		baseOS = defaultMachineAMILookupBaseOS
 	}
+	if architecture == "" {
+		architecture = ArchitectureX86_64
+	}
 
-	amiName, err := amiName(amiNameFormat, baseOS, kubernetesVersion)
+	amiName, err := amiName(amiNameFormat, baseOS, kubernetesVersion, architecture)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to process ami format: %q", amiNameFormat)
 	}
@@ -108,7 +154,7 @@ func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVers
 			},
 			{
 				Name:   aws.String("architecture"),
-				Values: []*string{aws.String("x86_64")},
+				Values: []*string{aws.String(architecture)},
 			},
 			{
 				Name:   aws.String("state"),
@@ -137,8 +183,8 @@ func DefaultAMILookup(ec2Client ec2iface.EC2API, ownerID, baseOS, kubernetesVers
 }
 
 // defaultAMIIDLookup returns the default AMI based on region
-func (s *Service) defaultAMIIDLookup(amiNameFormat, ownerID, baseOS, kubernetesVersion string) (string, error) {
-	latestImage, err := DefaultAMILookup(s.EC2Client, ownerID, baseOS, kubernetesVersion, amiNameFormat)
+func (s *Service) defaultAMIIDLookup(amiNameFormat, ownerID, baseOS, kubernetesVersion, architecture string) (string, error) {
+	latestImage, err := DefaultAMILookup(s.EC2Client, ownerID, baseOS, kubernetesVersion, architecture, amiNameFormat)
 	if err != nil {
 		record.Eventf(s.scope.InfraCluster(), "FailedDescribeImages", "Failed to find ami %q: %v", amiName, err)
 		return "", errors.Wrapf(err, "failed to find ami")
@@ -181,6 +227,28 @@ func getLatestImage(imgs []*ec2.Image) (*ec2.Image, error) {
 	return imgs[len(imgs)-1], nil
 }
 
+// bastionAMILookup returns the ID of the AMI to use for a bastion of the given instance type that has no AMI ID
+// set: the latest AMI matching the image lookup settings of the bastion if any is set, or else the default bastion
+// AMI of the region.
+func (s *Service) bastionAMILookup(instanceType string) (string, error) {
+	bastion := s.scope.Bastion()
+	if bastion.ImageLookupFormat == "" && bastion.ImageLookupOrg == "" && bastion.ImageLookupBaseOS == "" {
+		return s.defaultBastionAMILookup(s.scope.Region()), nil
+	}
+
+	architecture, err := s.instanceTypeArchitecture(instanceType)
+	if err != nil {
+		return "", err
+	}
+
+	imageLookupFormat := bastion.ImageLookupFormat
+	if imageLookupFormat == "" {
+		imageLookupFormat = defaultBastionAmiNameFormat
+	}
+
+	return s.defaultAMIIDLookup(imageLookupFormat, bastion.ImageLookupOrg, bastion.ImageLookupBaseOS, "", architecture)
+}
+
 func (s *Service) defaultBastionAMILookup(region string) string {
 	switch region {
 	case "ap-northeast-1":
@@ -222,15 +290,34 @@ func (s *Service) defaultBastionAMILookup(region string) string {
 	}
 }
 
-func (s *Service) eksAMILookup(kubernetesVersion string) (string, error) {
+func (s *Service) eksAMILookup(kubernetesVersion, architecture string) (string, error) {
 	// format ssm parameter path properly
 	formattedVersion, err := formatVersionForEKS(kubernetesVersion)
 	if err != nil {
 		return "", err
 	}
 
-	paramName := fmt.Sprintf(eksAmiSSMParameterFormat, formattedVersion)
+	amiType := "amazon-linux-2"
+	if architecture == ArchitectureArm64 {
+		amiType = "amazon-linux-2-arm64"
+	}
+
+	return s.ssmParameterAMIIDLookup(fmt.Sprintf(eksAmiSSMParameterFormat, formattedVersion, amiType))
+}
 
+// ssmParameterFormatAMILookup returns the AMI stored in the SSM parameter named by substituting
+// the base OS, Kubernetes version and CPU architecture into the parameter format.
+func (s *Service) ssmParameterFormatAMILookup(paramFormat, baseOS, kubernetesVersion, architecture string) (string, error) {
+	paramName, err := newAMILookup(baseOS, kubernetesVersion, architecture).execute("ssmParameter", paramFormat)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to process SSM parameter format: %q", paramFormat)
+	}
+
+	return s.ssmParameterAMIIDLookup(paramName)
+}
+
+// ssmParameterAMIIDLookup returns the AMI stored in the given SSM parameter.
+func (s *Service) ssmParameterAMIIDLookup(paramName string) (string, error) {
 	input := &ssm.GetParameterInput{
 		Name: aws.String(paramName),
 	}
@@ -247,7 +334,7 @@ func (s *Service) eksAMILookup(kubernetesVersion string) (string, error) {
 	}
 
 	id := aws.StringValue(out.Parameter.Value)
-	s.scope.Info("found AMI", "id", id, "version", formattedVersion)
+	s.scope.Info("found AMI", "id", id, "parameter", paramName)
 
 	return id, nil
 }
@@ -260,3 +347,105 @@ func formatVersionForEKS(version string) (string, error) {
 
 	return fmt.Sprintf("%d.%d", parsed.Major, parsed.Minor), nil
 }
+
+// instanceTypeArchitecture returns the CPU architecture of the given instance type, so that for
+// example Graviton instance types get arm64 images. It defaults to x86_64 when the instance
+// type supports it, when no instance type is given, or when the IAM policy does not allow
+// describing instance types.
+func (s *Service) instanceTypeArchitecture(instanceType string) (string, error) {
+	if instanceType == "" {
+		return ArchitectureX86_64, nil
+	}
+
+	key, cacheable := s.lookupCacheKey(instanceTypeLookupCache, instanceType)
+	if cacheable {
+		if architecture, ok := lookups.get(instanceTypeLookupCache, s.scope.Region(), key); ok {
+			return architecture.(string), nil
+		}
+	}
+
+	architecture, err := s.describeInstanceTypeArchitecture(instanceType)
+	switch {
+	case awserrors.IsAccessDenied(err):
+		s.scope.Info("Not allowed to describe instance types, assuming x86_64", "instance-type", instanceType)
+		architecture = ArchitectureX86_64
+	case err != nil:
+		return "", err
+	}
+
+	if cacheable {
+		lookups.set(key, architecture)
+	}
+	return architecture, nil
+}
+
+func (s *Service) describeInstanceTypeArchitecture(instanceType string) (string, error) {
+	out, err := s.EC2Client.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice([]string{instanceType}),
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeInstanceTypes", "Failed to describe instance type %q: %v", instanceType, err)
+		return "", errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+	if len(out.InstanceTypes) == 0 || out.InstanceTypes[0].ProcessorInfo == nil {
+		return "", errors.Errorf("no processor information found for instance type %q", instanceType)
+	}
+
+	architectures := aws.StringValueSlice(out.InstanceTypes[0].ProcessorInfo.SupportedArchitectures)
+	for _, architecture := range architectures {
+		if architecture == ArchitectureX86_64 {
+			return ArchitectureX86_64, nil
+		}
+	}
+	for _, architecture := range architectures {
+		if architecture == ArchitectureArm64 {
+			return ArchitectureArm64, nil
+		}
+	}
+
+	return "", errors.Errorf("instance type %q supports none of the architectures %q and %q", instanceType, ArchitectureX86_64, ArchitectureArm64)
+}
+
+// lookupAMI returns the ID of the AMI to use for a machine of the given instance type that has
+// no AMI ID set: either from the SSM parameter of the AMI reference, the EKS optimized AMI for
+// EKS managed clusters without image lookup settings, or the latest AMI matching the image lookup
+// settings.
+// Lookups are cached per region, account and Kubernetes version.
+func (s *Service) lookupAMI(ami infrav1.AMIReference, instanceType, imageLookupFormat, imageLookupOrg, imageLookupBaseOS string, kubernetesVersion *string, eksManaged bool) (string, error) {
+	if ami.SSMParameter == nil && kubernetesVersion == nil {
+		return "", errors.New("a Kubernetes version is required to look up the AMI")
+	}
+
+	architecture, err := s.instanceTypeArchitecture(instanceType)
+	if err != nil {
+		return "", err
+	}
+
+	key, cacheable := s.lookupCacheKey(amiLookupCache, aws.StringValue(ami.SSMParameter), imageLookupFormat, imageLookupOrg, imageLookupBaseOS, architecture, fmt.Sprint(eksManaged), aws.StringValue(kubernetesVersion))
+	if cacheable {
+		if id, ok := lookups.get(amiLookupCache, s.scope.Region(), key); ok {
+			return id.(string), nil
+		}
+	}
+
+	id, err := s.lookupAMIForArchitecture(ami, architecture, imageLookupFormat, imageLookupOrg, imageLookupBaseOS, kubernetesVersion, eksManaged)
+	if err != nil {
+		return "", err
+	}
+
+	if cacheable {
+		lookups.set(key, id)
+	}
+	return id, nil
+}
+
+func (s *Service) lookupAMIForArchitecture(ami infrav1.AMIReference, architecture, imageLookupFormat, imageLookupOrg, imageLookupBaseOS string, kubernetesVersion *string, eksManaged bool) (string, error) {
+	switch {
+	case ami.SSMParameter != nil:
+		return s.ssmParameterFormatAMILookup(*ami.SSMParameter, imageLookupBaseOS, aws.StringValue(kubernetesVersion), architecture)
+	case eksManaged && imageLookupFormat == "" && imageLookupOrg == "" && imageLookupBaseOS == "":
+		return s.eksAMILookup(*kubernetesVersion, architecture)
+	default:
+		return s.defaultAMIIDLookup(imageLookupFormat, imageLookupOrg, imageLookupBaseOS, *kubernetesVersion, architecture)
+	}
+}
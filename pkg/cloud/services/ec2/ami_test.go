@@ -22,10 +22,14 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/golang/mock/gomock"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm/mock_ssmiface"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 )
 
@@ -78,7 +82,7 @@ func TestAMIs(t *testing.T) {
 			s := NewService(scope)
 			s.EC2Client = ec2Mock
 
-			id, err := s.defaultAMIIDLookup("", "", "base os-baseos version", "1.11.1")
+			id, err := s.defaultAMIIDLookup("", "", "base os-baseos version", "1.11.1", ArchitectureX86_64)
 			if err != nil {
 				t.Fatalf("did not expect error calling a mock: %v", err)
 			}
@@ -138,10 +142,153 @@ func TestAMIsWithInvalidCreationDate(t *testing.T) {
 			s := NewService(scope)
 			s.EC2Client = ec2Mock
 
-			_, err = s.defaultAMIIDLookup("", "", "base os-baseos version", "1.11.1")
+			_, err = s.defaultAMIIDLookup("", "", "base os-baseos version", "1.11.1", ArchitectureX86_64)
 			if err == nil {
 				t.Fatalf("expected an error but did not get one")
 			}
 		})
 	}
 }
+
+func TestLookupAMI(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	graviton := func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+		m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+			InstanceTypes: aws.StringSlice([]string{"m6g.large"}),
+		})).
+			Return(&ec2.DescribeInstanceTypesOutput{
+				InstanceTypes: []*ec2.InstanceTypeInfo{
+					{
+						ProcessorInfo: &ec2.ProcessorInfo{
+							SupportedArchitectures: aws.StringSlice([]string{"arm64"}),
+						},
+					},
+				},
+			}, nil)
+	}
+
+	testCases := []struct {
+		name       string
+		ami        infrav1.AMIReference
+		eksManaged bool
+		expectEC2  func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectSSM  func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		expectedID string
+	}{
+		{
+			name: "SSM parameter format is resolved for the instance type architecture",
+			ami: infrav1.AMIReference{
+				SSMParameter: aws.String("/aws/service/bottlerocket/aws-k8s-{{.K8sMajorMinorVersion}}/{{.Arch}}/latest/image_id"),
+			},
+			expectEC2: graviton,
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Eq(&ssm.GetParameterInput{
+					Name: aws.String("/aws/service/bottlerocket/aws-k8s-1.19/arm64/latest/image_id"),
+				})).
+					Return(&ssm.GetParameterOutput{
+						Parameter: &ssm.Parameter{
+							Value: aws.String("ami-bottlerocket"),
+						},
+					}, nil)
+			},
+			expectedID: "ami-bottlerocket",
+		},
+		{
+			name:       "EKS optimized AMI for arm64 instance types",
+			eksManaged: true,
+			expectEC2:  graviton,
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Eq(&ssm.GetParameterInput{
+					Name: aws.String("/aws/service/eks/optimized-ami/1.19/amazon-linux-2-arm64/recommended/image_id"),
+				})).
+					Return(&ssm.GetParameterOutput{
+						Parameter: &ssm.Parameter{
+							Value: aws.String("ami-eks-arm64"),
+						},
+					}, nil)
+			},
+			expectedID: "ami-eks-arm64",
+		},
+		{
+			name: "image lookup filters on the instance type architecture",
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				graviton(m)
+				m.DescribeImages(gomock.Any()).
+					DoAndReturn(func(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+						for _, f := range input.Filters {
+							if aws.StringValue(f.Name) == "architecture" && aws.StringValue(f.Values[0]) != "arm64" {
+								t.Fatalf("expected arm64 architecture filter, got %q", aws.StringValue(f.Values[0]))
+							}
+						}
+						return &ec2.DescribeImagesOutput{
+							Images: []*ec2.Image{
+								{
+									ImageId:      aws.String("ami-capa-arm64"),
+									CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+								},
+							},
+						}, nil
+					})
+			},
+			expectSSM:  func(m *mock_ssmiface.MockSSMAPIMockRecorder) {},
+			expectedID: "ami-capa-arm64",
+		},
+		{
+			name: "image lookup falls back to x86_64 when instance types cannot be described",
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).
+					Return(nil, awserr.New(awserrors.UnauthorizedOperation, "not authorized", nil))
+				m.DescribeImages(gomock.Any()).
+					DoAndReturn(func(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+						for _, f := range input.Filters {
+							if aws.StringValue(f.Name) == "architecture" && aws.StringValue(f.Values[0]) != "x86_64" {
+								t.Fatalf("expected x86_64 architecture filter, got %q", aws.StringValue(f.Values[0]))
+							}
+						}
+						return &ec2.DescribeImagesOutput{
+							Images: []*ec2.Image{
+								{
+									ImageId:      aws.String("ami-capa-x86_64"),
+									CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+								},
+							},
+						}, nil
+					})
+			},
+			expectSSM:  func(m *mock_ssmiface.MockSSMAPIMockRecorder) {},
+			expectedID: "ami-capa-x86_64",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tc.expectEC2(ec2Mock.EXPECT())
+			tc.expectSSM(ssmMock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			s.SSMClient = ssmMock
+
+			id, err := s.lookupAMI(tc.ami, "m6g.large", "", "", "", aws.String("v1.19.3"), tc.eksManaged)
+			if err != nil {
+				t.Fatalf("did not expect error: %v", err)
+			}
+			if id != tc.expectedID {
+				t.Fatalf("returned %q expected %q", id, tc.expectedID)
+			}
+		})
+	}
+}
@@ -47,18 +47,28 @@ var (
 func (s *Service) ReconcileBastion() error {
 	if !s.scope.Bastion().Enabled {
 		s.scope.V(4).Info("Skipping bastion reconcile")
-		_, err := s.describeBastionInstance()
-		if err != nil {
-			if awserrors.IsNotFound(err) {
-				return nil
-			}
+		if err := s.deleteBastion(); err != nil {
 			return err
 		}
-		return s.DeleteBastion()
+		s.scope.SetBastionMode("")
+		return nil
+	}
+
+	if s.scope.Bastion().Mode == infrav1.BastionModeSessionManager {
+		return s.reconcileSessionManagerBastion()
 	}
 
 	s.scope.V(2).Info("Reconciling bastion host")
 
+	if err := s.deleteRecordedSessionManagerEndpoints(); err != nil {
+		return err
+	}
+	mode := s.scope.Bastion().Mode
+	if mode == "" {
+		mode = infrav1.BastionModeInstance
+	}
+	s.scope.SetBastionMode(mode)
+
 	subnets := s.scope.Subnets()
 	if len(subnets.FilterPrivate()) == 0 {
 		s.scope.V(2).Info("No private subnets available, skipping bastion host")
@@ -67,6 +77,14 @@ func (s *Service) ReconcileBastion() error {
 		return errors.New("failed to reconcile bastion host, no public subnets are available")
 	}
 
+	if s.scope.Bastion().Mode == infrav1.BastionModeAutoScalingGroup {
+		return s.reconcileBastionAutoScalingGroup()
+	}
+
+	if err := s.deleteBastionAutoScalingGroup(); err != nil {
+		return err
+	}
+
 	// Describe bastion instance, if any.
 	instance, err := s.describeBastionInstance()
 	if awserrors.IsNotFound(err) { // nolint:nestif
@@ -76,7 +94,12 @@ func (s *Service) ReconcileBastion() error {
 				return errors.Wrap(err, "failed to patch conditions")
 			}
 		}
-		instance, err = s.runInstance("bastion", s.getDefaultBastion(s.scope.Bastion().InstanceType, s.scope.Bastion().AMI))
+		defaultBastion, err := s.getDefaultBastion(s.scope.Bastion().InstanceType, s.scope.Bastion().AMI)
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateBastion", "Failed to create bastion instance: %v", err)
+			return err
+		}
+		instance, err = s.runInstance("bastion", defaultBastion)
 		if err != nil {
 			record.Warnf(s.scope.InfraCluster(), "FailedCreateBastion", "Failed to create bastion instance: %v", err)
 			return err
@@ -92,14 +115,75 @@ func (s *Service) ReconcileBastion() error {
 	// TODO(vincepri): check for possible changes between the default spec and the instance.
 
 	s.scope.SetBastionInstance(instance.DeepCopy())
+	s.scope.SetBastionEndpoint(aws.StringValue(instance.PublicIP))
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition)
 	s.scope.V(2).Info("Reconcile bastion completed successfully")
 
 	return nil
 }
 
-// DeleteBastion deletes the Bastion instance
+// reconcileSessionManagerBastion replaces the bastion host instance with the VPC endpoints of the AWS Systems
+// Manager Session Manager.
+func (s *Service) reconcileSessionManagerBastion() error {
+	s.scope.V(2).Info("Reconciling session manager bastion")
+
+	if err := s.deleteBastionInstance(); err != nil {
+		return err
+	}
+	if err := s.deleteBastionAutoScalingGroup(); err != nil {
+		return err
+	}
+	s.scope.SetBastionInstance(nil)
+	s.scope.SetBastionEndpoint("")
+
+	// The mode is recorded before any endpoint is created, so that the endpoints are deleted once the
+	// mode changes even if their creation fails halfway.
+	s.scope.SetBastionMode(infrav1.BastionModeSessionManager)
+	if err := s.reconcileSessionManagerEndpoints(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, infrav1.BastionHostFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition)
+	s.scope.V(2).Info("Reconcile session manager bastion completed successfully")
+
+	return nil
+}
+
+// DeleteBastion deletes the Bastion instance, the bastion auto scaling group and the session manager VPC endpoints
 func (s *Service) DeleteBastion() error {
+	if err := s.deleteBastion(); err != nil {
+		return err
+	}
+	s.scope.SetBastionMode("")
+	return nil
+}
+
+func (s *Service) deleteBastion() error {
+	if err := s.deleteRecordedSessionManagerEndpoints(); err != nil {
+		return err
+	}
+	if err := s.deleteBastionAutoScalingGroup(); err != nil {
+		return err
+	}
+
+	return s.deleteBastionInstance()
+}
+
+// deleteRecordedSessionManagerEndpoints deletes the session manager VPC endpoints if the bastion was last
+// reconciled in the SessionManager mode, so that clusters that never used it make no VPC endpoint calls.
+func (s *Service) deleteRecordedSessionManagerEndpoints() error {
+	if s.scope.BastionMode() != infrav1.BastionModeSessionManager {
+		return nil
+	}
+	if err := s.deleteSessionManagerEndpoints(); err != nil {
+		return err
+	}
+	s.scope.SetBastionMode("")
+	return nil
+}
+
+func (s *Service) deleteBastionInstance() error {
 	instance, err := s.describeBastionInstance()
 	if err != nil {
 		if awserrors.IsNotFound(err) {
@@ -149,6 +233,10 @@ func (s *Service) describeBastionInstance() (*infrav1.Instance, error) {
 	// the first non-terminated.
 	for _, res := range out.Reservations {
 		for _, instance := range res.Instances {
+			// Instances of the bastion auto scaling group are managed through the group.
+			if hasTagKey(instance.Tags, autoScalingGroupNameTagKey) {
+				continue
+			}
 			if aws.StringValue(instance.State.Name) != ec2.InstanceStateNameTerminated {
 				return s.SDKToInstance(instance)
 			}
@@ -158,13 +246,28 @@ func (s *Service) describeBastionInstance() (*infrav1.Instance, error) {
 	return nil, awserrors.NewNotFound("bastion host not found")
 }
 
-func (s *Service) getDefaultBastion(instanceType, ami string) *infrav1.Instance {
+func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance, error) {
 	name := fmt.Sprintf("%s-bastion", s.scope.Name())
-	userData, _ := userdata.NewBastion(&userdata.BastionInput{})
 
-	// If SSHKeyName WAS NOT provided, use the defaultSSHKeyName
+	userData, err := s.scope.GetRawBastionUserData()
+	if err != nil {
+		return nil, err
+	}
+	if userData == nil {
+		defaultUserData, err := userdata.NewBastion(&userdata.BastionInput{})
+		if err != nil {
+			return nil, err
+		}
+		userData = []byte(defaultUserData)
+	}
+
+	// If SSHKeyName WAS NOT provided, use the defaultSSHKeyName, unless keys are pushed through
+	// EC2 Instance Connect
 	keyName := s.scope.SSHKeyName()
-	if keyName == nil {
+	switch {
+	case s.scope.SSHAccess() == infrav1.SSHAccessModeInstanceConnect:
+		keyName = nil
+	case keyName == nil:
 		keyName = aws.String(defaultSSHKeyName)
 	}
 
@@ -179,7 +282,10 @@ func (s *Service) getDefaultBastion(instanceType, ami string) *infrav1.Instance
 	}
 
 	if ami == "" {
-		ami = s.defaultBastionAMILookup(s.scope.Region())
+		ami, err = s.bastionAMILookup(instanceType)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	i := &infrav1.Instance{
@@ -187,7 +293,7 @@ func (s *Service) getDefaultBastion(instanceType, ami string) *infrav1.Instance
 		SubnetID:   subnet.ID,
 		ImageID:    ami,
 		SSHKeyName: keyName,
-		UserData:   aws.String(base64.StdEncoding.EncodeToString([]byte(userData))),
+		UserData:   aws.String(base64.StdEncoding.EncodeToString(userData)),
 		SecurityGroupIDs: []string{
 			s.scope.Network().SecurityGroups[infrav1.SecurityGroupBastion].ID,
 		},
@@ -200,5 +306,5 @@ func (s *Service) getDefaultBastion(instanceType, ami string) *infrav1.Instance
 		}),
 	}
 
-	return i
+	return i, nil
 }
@@ -18,19 +18,24 @@ package ec2
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling/mock_autoscalingiface"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -69,8 +74,18 @@ func TestDeleteBastion(t *testing.T) {
 		},
 	}
 
+	describeEndpointsInput := &ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC("vpcID"),
+			filter.EC2.ClusterOwned(clusterName),
+			filter.EC2.ServiceNames("com.amazonaws.us-east-1.ssm", "com.amazonaws.us-east-1.ssmmessages", "com.amazonaws.us-east-1.ec2messages"),
+			filter.EC2.VPCEndpointStates("pendingAcceptance", "pending", "available"),
+		},
+	}
+
 	tests := []struct {
 		name        string
+		bastionMode infrav1.BastionMode
 		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder)
 		expectError bool
 	}{
@@ -131,6 +146,46 @@ func TestDeleteBastion(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name:        "session manager endpoints delete fails",
+			bastionMode: infrav1.BastionModeSessionManager,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					DescribeVpcEndpoints(gomock.Eq(describeEndpointsInput)).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []*ec2.VpcEndpoint{{VpcEndpointId: aws.String("vpce-1")}},
+					}, nil)
+				m.
+					DeleteVpcEndpoints(gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+						VpcEndpointIds: aws.StringSlice([]string{"vpce-1"}),
+					})).
+					Return(nil, errors.New("some error"))
+			},
+			expectError: true,
+		},
+		{
+			name:        "success with session manager endpoints",
+			bastionMode: infrav1.BastionModeSessionManager,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					DescribeVpcEndpoints(gomock.Eq(describeEndpointsInput)).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []*ec2.VpcEndpoint{
+							{VpcEndpointId: aws.String("vpce-1")},
+							{VpcEndpointId: aws.String("vpce-2")},
+						},
+					}, nil)
+				m.
+					DeleteVpcEndpoints(gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+						VpcEndpointIds: aws.StringSlice([]string{"vpce-1", "vpce-2"}),
+					})).
+					Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+				m.
+					DescribeInstances(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstancesOutput{}, nil)
+			},
+			expectError: false,
+		},
 		{
 			name: "success",
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
@@ -168,18 +223,23 @@ func TestDeleteBastion(t *testing.T) {
 				defer mockControl.Finish()
 
 				ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+				asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockControl)
 
 				scheme, err := setupScheme()
 				g.Expect(err).To(BeNil())
 
 				awsCluster := &infrav1.AWSCluster{
 					Spec: infrav1.AWSClusterSpec{
+						Region: "us-east-1",
 						NetworkSpec: infrav1.NetworkSpec{
 							VPC: infrav1.VPCSpec{
 								ID: "vpcID",
 							},
 						},
 					},
+					Status: infrav1.AWSClusterStatus{
+						BastionMode: tc.bastionMode,
+					},
 				}
 
 				client := fake.NewFakeClientWithScheme(scheme)
@@ -204,9 +264,17 @@ func TestDeleteBastion(t *testing.T) {
 					}
 				}
 
+				asgMock.EXPECT().
+					DescribeAutoScalingGroups(gomock.Eq(&autoscaling.DescribeAutoScalingGroupsInput{
+						AutoScalingGroupNames: aws.StringSlice([]string{"cluster-bastion"}),
+					})).
+					Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil).
+					AnyTimes()
+
 				tc.expect(ec2Mock.EXPECT())
 				s := NewService(scope)
 				s.EC2Client = ec2Mock
+				s.ASGClient = asgMock
 
 				err = s.DeleteBastion()
 				if tc.expectError {
@@ -215,7 +283,279 @@ func TestDeleteBastion(t *testing.T) {
 				}
 
 				g.Expect(err).To(BeNil())
+				g.Expect(awsCluster.Status.BastionMode).To(BeEmpty())
 			})
 		}
 	}
 }
+
+func TestReconcileDisabledBastion(t *testing.T) {
+	tests := []struct {
+		name        string
+		bastionMode infrav1.BastionMode
+		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder)
+	}{
+		{
+			name:   "does not look for session manager endpoints when the bastion never used session manager",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:        "deletes the session manager endpoints of a previous session manager bastion",
+			bastionMode: infrav1.BastionModeSessionManager,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					DescribeVpcEndpoints(gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []*ec2.VpcEndpoint{{VpcEndpointId: aws.String("vpce-1")}},
+					}, nil)
+				m.
+					DeleteVpcEndpoints(gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+						VpcEndpointIds: aws.StringSlice([]string{"vpce-1"}),
+					})).
+					Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockControl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).To(BeNil())
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							ID: "vpcID",
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					BastionMode: tc.bastionMode,
+				},
+			}
+
+			client := fake.NewFakeClientWithScheme(scheme)
+			client.Create(context.TODO(), awsCluster)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "cluster",
+					},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+
+			asgMock.EXPECT().
+				DescribeAutoScalingGroups(gomock.Any()).
+				Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil).
+				AnyTimes()
+			ec2Mock.EXPECT().
+				DescribeInstances(gomock.Any()).
+				Return(&ec2.DescribeInstancesOutput{}, nil)
+
+			tc.expect(ec2Mock.EXPECT())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			s.ASGClient = asgMock
+
+			g.Expect(s.ReconcileBastion()).To(Succeed())
+			g.Expect(awsCluster.Status.BastionMode).To(BeEmpty())
+		})
+	}
+}
+
+func TestGetDefaultBastion(t *testing.T) {
+	defaultUserData, err := userdata.NewBastion(&userdata.BastionInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		bastion        infrav1.Bastion
+		sshAccess      infrav1.SSHAccessMode
+		expect         func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantUserData   string
+		wantImageID    string
+		wantSSHKeyName *string
+		wantErrSubstr  string
+	}{
+		{
+			name:           "default user data and AMI",
+			bastion:        infrav1.Bastion{Enabled: true},
+			wantUserData:   defaultUserData,
+			wantImageID:    "ami-0dba2cb6798deb6d8",
+			wantSSHKeyName: aws.String(defaultSSHKeyName),
+		},
+		{
+			name:         "no key pair with EC2 Instance Connect ssh access",
+			bastion:      infrav1.Bastion{Enabled: true, AMI: "ami-bastion"},
+			sshAccess:    infrav1.SSHAccessModeInstanceConnect,
+			wantUserData: defaultUserData,
+			wantImageID:  "ami-bastion",
+		},
+		{
+			name: "inline user data",
+			bastion: infrav1.Bastion{
+				Enabled: true,
+				AMI:     "ami-bastion",
+				UserData: &infrav1.BastionUserData{
+					Value: aws.String("#cloud-config\n"),
+				},
+			},
+			wantUserData:   "#cloud-config\n",
+			wantImageID:    "ami-bastion",
+			wantSSHKeyName: aws.String(defaultSSHKeyName),
+		},
+		{
+			name: "user data from a secret",
+			bastion: infrav1.Bastion{
+				Enabled: true,
+				AMI:     "ami-bastion",
+				UserData: &infrav1.BastionUserData{
+					SecretName: aws.String("bastion-user-data"),
+				},
+			},
+			wantUserData:   `{"ignition":{"version":"3.0.0"}}`,
+			wantImageID:    "ami-bastion",
+			wantSSHKeyName: aws.String(defaultSSHKeyName),
+		},
+		{
+			name: "user data from a missing secret",
+			bastion: infrav1.Bastion{
+				Enabled: true,
+				AMI:     "ami-bastion",
+				UserData: &infrav1.BastionUserData{
+					SecretName: aws.String("missing"),
+				},
+			},
+			wantErrSubstr: "failed to retrieve bastion user data secret ns/missing",
+		},
+		{
+			name: "AMI looked up with the image lookup settings",
+			bastion: infrav1.Bastion{
+				Enabled:           true,
+				InstanceType:      "c6g.medium",
+				ImageLookupOrg:    "123456789012",
+				ImageLookupBaseOS: "amazon-2",
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: aws.StringSlice([]string{"c6g.medium"}),
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: aws.StringSlice([]string{ec2.ArchitectureTypeArm64}),
+								},
+							},
+						},
+					}, nil).
+					MaxTimes(1)
+				m.DescribeImages(gomock.Any()).
+					DoAndReturn(func(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+						values := map[string]string{}
+						for _, f := range input.Filters {
+							values[aws.StringValue(f.Name)] = aws.StringValue(f.Values[0])
+						}
+						g := NewWithT(t)
+						g.Expect(values).To(HaveKeyWithValue("owner-id", "123456789012"))
+						g.Expect(values).To(HaveKeyWithValue("name", "capa-ami-amazon-2-*"))
+						g.Expect(values).To(HaveKeyWithValue("architecture", ec2.ArchitectureTypeArm64))
+						return &ec2.DescribeImagesOutput{
+							Images: []*ec2.Image{
+								{ImageId: aws.String("ami-old"), CreationDate: aws.String("2021-01-01T00:00:00.000Z")},
+								{ImageId: aws.String("ami-new"), CreationDate: aws.String("2021-02-01T00:00:00.000Z")},
+							},
+						}, nil
+					})
+			},
+			wantUserData:   defaultUserData,
+			wantImageID:    "ami-new",
+			wantSSHKeyName: aws.String(defaultSSHKeyName),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).To(BeNil())
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							{ID: "subnet-public-1a", AvailabilityZone: "us-east-1a", IsPublic: true},
+						},
+					},
+					Bastion:   tc.bastion,
+					SSHAccess: tc.sshAccess,
+				},
+			}
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "bastion-user-data",
+				},
+				Data: map[string][]byte{
+					"value": []byte(`{"ignition":{"version":"3.0.0"}}`),
+				},
+			}
+
+			client := fake.NewFakeClientWithScheme(scheme, secret)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "cluster",
+					},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			instance, err := s.getDefaultBastion(tc.bastion.InstanceType, tc.bastion.AMI)
+			if tc.wantErrSubstr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.wantErrSubstr)))
+				return
+			}
+
+			g.Expect(err).To(BeNil())
+			g.Expect(instance.ImageID).To(Equal(tc.wantImageID))
+			g.Expect(instance.SSHKeyName).To(Equal(tc.wantSSHKeyName))
+			g.Expect(aws.StringValue(instance.UserData)).To(Equal(base64.StdEncoding.EncodeToString([]byte(tc.wantUserData))))
+		})
+	}
+}
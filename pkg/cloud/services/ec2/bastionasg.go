@@ -0,0 +1,329 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	asg "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// BastionAutoScalingGroupResyncPeriod is how often clusters with an Auto Scaling group bastion are reconciled, so
+	// that the Elastic IP of the bastion follows a replaced instance without waiting for the next cluster change.
+	BastionAutoScalingGroupResyncPeriod = time.Minute
+
+	// autoScalingGroupNameTagKey is the tag Auto Scaling sets on the instances of a group.
+	autoScalingGroupNameTagKey = "aws:autoscaling:groupName"
+
+	// autoScalingHealthStatusHealthy is the health status of healthy instances of an Auto Scaling group.
+	autoScalingHealthStatusHealthy = "Healthy"
+)
+
+// reconcileBastionAutoScalingGroup runs the bastion host instance in an Auto Scaling group of one instance spanning
+// the public subnets, and keeps the Elastic IP of the bastion associated with the instance of the group. When the
+// instance is replaced, the Elastic IP follows it during the next reconcile.
+func (s *Service) reconcileBastionAutoScalingGroup() error {
+	s.scope.V(2).Info("Reconciling bastion auto scaling group")
+
+	if err := s.deleteBastionInstance(); err != nil {
+		return err
+	}
+
+	name := s.bastionName()
+
+	group, err := s.describeBastionAutoScalingGroup(name)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		if err := s.createBastionAutoScalingGroup(name); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateBastion", "Failed to create bastion auto scaling group: %v", err)
+			return err
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateBastion", "Created bastion auto scaling group %q", name)
+	}
+
+	// The group launches its instance asynchronously. The cluster is reconciled again after
+	// BastionAutoScalingGroupResyncPeriod, which associates the Elastic IP once the instance is in service.
+	instanceID := bastionGroupInstanceID(group)
+	if instanceID == "" {
+		s.scope.V(2).Info("Waiting for bastion auto scaling group instance", "name", name)
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, infrav1.BastionCreationStartedReason, clusterv1.ConditionSeverityInfo,
+			"waiting for an instance of bastion auto scaling group %q", name)
+		return nil
+	}
+
+	address, err := s.getOrAllocateBastionAddress(name)
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(address.InstanceId) != instanceID {
+		if _, err := s.EC2Client.AssociateAddress(&ec2.AssociateAddressInput{
+			AllocationId:       address.AllocationId,
+			InstanceId:         aws.String(instanceID),
+			AllowReassociation: aws.Bool(true),
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedAssociateBastionEIP", "Failed to associate Elastic IP %q with bastion instance %q: %v", aws.StringValue(address.PublicIp), instanceID, err)
+			return errors.Wrapf(err, "failed to associate Elastic IP %q with bastion instance %q", aws.StringValue(address.AllocationId), instanceID)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulAssociateBastionEIP", "Associated Elastic IP %q with bastion instance %q", aws.StringValue(address.PublicIp), instanceID)
+	}
+
+	instance, err := s.InstanceIfExists(aws.String(instanceID))
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return errors.Errorf("bastion instance %q not found", instanceID)
+	}
+
+	s.scope.SetBastionInstance(instance.DeepCopy())
+	s.scope.SetBastionEndpoint(aws.StringValue(address.PublicIp))
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition)
+	s.scope.V(2).Info("Reconcile bastion auto scaling group completed successfully")
+
+	return nil
+}
+
+// deleteBastionAutoScalingGroup deletes the Auto Scaling group of the bastion, if any, along with its launch
+// template and Elastic IP. The group is deleted asynchronously, so an error is returned until it is gone, and the
+// deletion resumes during the next reconcile.
+func (s *Service) deleteBastionAutoScalingGroup() error {
+	name := s.bastionName()
+
+	groups, err := s.describeBastionAutoScalingGroups(name)
+	if err != nil {
+		return err
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+
+	for _, group := range groups {
+		// A group with a status is already being deleted.
+		if aws.StringValue(group.Status) == "" {
+			if _, err := s.ASGClient.DeleteAutoScalingGroup(&autoscaling.DeleteAutoScalingGroupInput{
+				AutoScalingGroupName: aws.String(name),
+				ForceDelete:          aws.Bool(true),
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedTerminateBastion", "Failed to delete bastion auto scaling group %q: %v", name, err)
+				return errors.Wrapf(err, "failed to delete bastion auto scaling group %q", name)
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulTerminateBastion", "Deleted bastion auto scaling group %q", name)
+		}
+
+		if group.LaunchTemplate != nil {
+			if _, err := s.EC2Client.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+				LaunchTemplateId: group.LaunchTemplate.LaunchTemplateId,
+			}); err != nil && !awserrors.IsNotFound(err) {
+				return errors.Wrapf(err, "failed to delete bastion launch template %q", aws.StringValue(group.LaunchTemplate.LaunchTemplateId))
+			}
+		}
+	}
+
+	addresses, err := s.describeBastionAddresses(name)
+	if err != nil {
+		return err
+	}
+	for _, address := range addresses {
+		if address.AssociationId != nil {
+			if _, err := s.EC2Client.DisassociateAddress(&ec2.DisassociateAddressInput{AssociationId: address.AssociationId}); err != nil {
+				return errors.Wrapf(err, "failed to disassociate Elastic IP %q", aws.StringValue(address.AllocationId))
+			}
+		}
+		if _, err := s.EC2Client.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: address.AllocationId}); err != nil {
+			return errors.Wrapf(err, "failed to release Elastic IP %q", aws.StringValue(address.AllocationId))
+		}
+	}
+
+	s.scope.SetBastionEndpoint("")
+	return errors.Errorf("bastion auto scaling group %q is being deleted", name)
+}
+
+// describeBastionAutoScalingGroup returns the Auto Scaling group of the bastion, ignoring a group being deleted.
+func (s *Service) describeBastionAutoScalingGroup(name string) (*autoscaling.Group, error) {
+	groups, err := s.describeBastionAutoScalingGroups(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		// A group being deleted cannot be reused, and is gone once the deletion completes.
+		if aws.StringValue(group.Status) == "" {
+			return group, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// describeBastionAutoScalingGroups returns the Auto Scaling groups of the bastion, including a group being deleted.
+func (s *Service) describeBastionAutoScalingGroups(name string) ([]*autoscaling.Group, error) {
+	out, err := s.ASGClient.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe bastion auto scaling group %q", name)
+	}
+
+	return out.AutoScalingGroups, nil
+}
+
+// createBastionAutoScalingGroup creates the launch template of the bastion host instance, and the Auto Scaling
+// group of one instance launching it in any of the public subnets.
+func (s *Service) createBastionAutoScalingGroup(name string) error {
+	defaultBastion, err := s.getDefaultBastion(s.scope.Bastion().InstanceType, s.scope.Bastion().AMI)
+	if err != nil {
+		return err
+	}
+
+	input, err := s.runInstancesInput("bastion", defaultBastion)
+	if err != nil {
+		return err
+	}
+
+	data := getLaunchTemplateDataFromRunInstancesInput(input)
+	data.InstanceType = input.InstanceType
+
+	launchTemplateID, err := s.createOrReplaceLaunchTemplate(name, name, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.ASGClient.CreateAutoScalingGroup(&autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(name),
+		MinSize:              aws.Int64(1),
+		MaxSize:              aws.Int64(1),
+		DesiredCapacity:      aws.Int64(1),
+		VPCZoneIdentifier:    aws.String(strings.Join(s.scope.Subnets().FilterPublic().IDs(), ",")),
+		LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+			LaunchTemplateId: aws.String(launchTemplateID),
+			Version:          aws.String(launchTemplateDefaultVersion),
+		},
+		Tags: asg.BuildTagsFromMap(name, infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.Name(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        aws.String(name),
+			Role:        aws.String(infrav1.BastionRoleTagValue),
+			Additional:  s.scope.AdditionalTags(),
+		})),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create bastion auto scaling group %q", name)
+	}
+
+	return nil
+}
+
+// getOrAllocateBastionAddress returns the Elastic IP of the bastion, allocating it if needed.
+func (s *Service) getOrAllocateBastionAddress(name string) (*ec2.Address, error) {
+	addresses, err := s.describeBastionAddresses(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(addresses) > 0 {
+		return addresses[0], nil
+	}
+
+	out, err := s.EC2Client.AllocateAddress(&ec2.AllocateAddressInput{
+		Domain: aws.String("vpc"),
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAllocateEIP", "Failed to allocate Elastic IP for the bastion: %v", err)
+		return nil, errors.Wrap(err, "failed to allocate Elastic IP for the bastion")
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		tagsBuilder := tags.New(&infrav1.BuildParams{
+			ClusterName: s.scope.Name(),
+			ResourceID:  aws.StringValue(out.AllocationId),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        aws.String(name),
+			Role:        aws.String(infrav1.BastionRoleTagValue),
+			Additional:  s.scope.AdditionalTags(),
+		}, tags.WithEC2(s.EC2Client))
+		if err := tagsBuilder.Apply(); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.EIPNotFound); err != nil {
+		return nil, errors.Wrapf(err, "failed to tag Elastic IP %q", aws.StringValue(out.AllocationId))
+	}
+
+	return &ec2.Address{
+		AllocationId: out.AllocationId,
+		PublicIp:     out.PublicIp,
+	}, nil
+}
+
+func (s *Service) describeBastionAddresses(name string) ([]*ec2.Address, error) {
+	out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Cluster(s.scope.Name()),
+			filter.EC2.Name(name),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe bastion Elastic IPs")
+	}
+	return out.Addresses, nil
+}
+
+func (s *Service) bastionName() string {
+	return fmt.Sprintf("%s-bastion", s.scope.Name())
+}
+
+// bastionGroupInstanceID returns the ID of the healthy instance in service of the bastion Auto Scaling group, if any.
+func bastionGroupInstanceID(group *autoscaling.Group) string {
+	if group == nil {
+		return ""
+	}
+	for _, instance := range group.Instances {
+		if aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateInService &&
+			aws.StringValue(instance.HealthStatus) == autoScalingHealthStatusHealthy {
+			return aws.StringValue(instance.InstanceId)
+		}
+	}
+	return ""
+}
+
+func hasTagKey(tags []*ec2.Tag, key string) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return true
+		}
+	}
+	return false
+}
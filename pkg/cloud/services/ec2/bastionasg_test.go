@@ -0,0 +1,405 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileBastionAutoScalingGroup(t *testing.T) {
+	describeGroupInput := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{"cluster-bastion"}),
+	}
+
+	describeAddressesInput := &ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Cluster("cluster"),
+			filter.EC2.Name("cluster-bastion"),
+		},
+	}
+
+	group := func(instanceID string) *autoscaling.DescribeAutoScalingGroupsOutput {
+		return &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{
+					AutoScalingGroupName: aws.String("cluster-bastion"),
+					Instances: []*autoscaling.Instance{
+						{
+							InstanceId:     aws.String(instanceID),
+							LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+							HealthStatus:   aws.String("Healthy"),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	describeInstanceInput := func(instanceID string) *ec2.DescribeInstancesInput {
+		return &ec2.DescribeInstancesInput{InstanceIds: aws.StringSlice([]string{instanceID})}
+	}
+
+	describeInstanceOutput := func(instanceID string) *ec2.DescribeInstancesOutput {
+		return &ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{
+				{
+					Instances: []*ec2.Instance{
+						{
+							InstanceId:      aws.String(instanceID),
+							State:           &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)},
+							PublicIpAddress: aws.String("1.2.3.4"),
+							Placement:       &ec2.Placement{AvailabilityZone: aws.String("us-east-1a")},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		expect func(m *mock_ec2iface.MockEC2APIMockRecorder, a *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		ready  bool
+	}{
+		{
+			name: "creates the group and does not wait for its instance",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, a *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				a.DescribeAutoScalingGroups(gomock.Eq(describeGroupInput)).
+					Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+				m.CreateLaunchTemplate(gomock.Any()).
+					DoAndReturn(func(input *ec2.CreateLaunchTemplateInput) (*ec2.CreateLaunchTemplateOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.LaunchTemplateName)).To(Equal("cluster-bastion"))
+						g.Expect(aws.StringValue(input.LaunchTemplateData.InstanceType)).To(Equal("t3.small"))
+						g.Expect(aws.StringValue(input.LaunchTemplateData.ImageId)).To(Equal("ami-bastion"))
+						g.Expect(aws.StringValueSlice(input.LaunchTemplateData.SecurityGroupIds)).To(ConsistOf("sg-bastion"))
+						return &ec2.CreateLaunchTemplateOutput{
+							LaunchTemplate: &ec2.LaunchTemplate{LaunchTemplateId: aws.String("lt-bastion")},
+						}, nil
+					})
+				a.CreateAutoScalingGroup(gomock.Any()).
+					DoAndReturn(func(input *autoscaling.CreateAutoScalingGroupInput) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.AutoScalingGroupName)).To(Equal("cluster-bastion"))
+						g.Expect(aws.Int64Value(input.MinSize)).To(Equal(int64(1)))
+						g.Expect(aws.Int64Value(input.MaxSize)).To(Equal(int64(1)))
+						g.Expect(aws.StringValue(input.VPCZoneIdentifier)).To(Equal("subnet-public-1a,subnet-public-1b"))
+						g.Expect(aws.StringValue(input.LaunchTemplate.LaunchTemplateId)).To(Equal("lt-bastion"))
+						return &autoscaling.CreateAutoScalingGroupOutput{}, nil
+					})
+			},
+		},
+		{
+			name: "associates a new Elastic IP with the instance of the group",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, a *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				a.DescribeAutoScalingGroups(gomock.Eq(describeGroupInput)).
+					Return(group("i-1"), nil)
+				m.DescribeAddresses(gomock.Eq(describeAddressesInput)).
+					Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.AllocateAddress(gomock.Eq(&ec2.AllocateAddressInput{Domain: aws.String("vpc")})).
+					Return(&ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-1"), PublicIp: aws.String("1.2.3.4")}, nil)
+				m.CreateTags(gomock.Any()).
+					Return(&ec2.CreateTagsOutput{}, nil)
+				m.AssociateAddress(gomock.Eq(&ec2.AssociateAddressInput{
+					AllocationId:       aws.String("eipalloc-1"),
+					InstanceId:         aws.String("i-1"),
+					AllowReassociation: aws.Bool(true),
+				})).
+					Return(&ec2.AssociateAddressOutput{}, nil)
+				m.DescribeInstances(gomock.Eq(describeInstanceInput("i-1"))).
+					Return(describeInstanceOutput("i-1"), nil)
+			},
+			ready: true,
+		},
+		{
+			name: "moves the Elastic IP to the instance replacing the lost one",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, a *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				a.DescribeAutoScalingGroups(gomock.Eq(describeGroupInput)).
+					Return(group("i-2"), nil)
+				m.DescribeAddresses(gomock.Eq(describeAddressesInput)).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{AllocationId: aws.String("eipalloc-1"), PublicIp: aws.String("1.2.3.4"), InstanceId: aws.String("i-1")},
+						},
+					}, nil)
+				m.AssociateAddress(gomock.Eq(&ec2.AssociateAddressInput{
+					AllocationId:       aws.String("eipalloc-1"),
+					InstanceId:         aws.String("i-2"),
+					AllowReassociation: aws.Bool(true),
+				})).
+					Return(&ec2.AssociateAddressOutput{}, nil)
+				m.DescribeInstances(gomock.Eq(describeInstanceInput("i-2"))).
+					Return(describeInstanceOutput("i-2"), nil)
+			},
+			ready: true,
+		},
+		{
+			name: "keeps the Elastic IP associated with the instance",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, a *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				a.DescribeAutoScalingGroups(gomock.Eq(describeGroupInput)).
+					Return(group("i-1"), nil)
+				m.DescribeAddresses(gomock.Eq(describeAddressesInput)).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{AllocationId: aws.String("eipalloc-1"), PublicIp: aws.String("1.2.3.4"), InstanceId: aws.String("i-1")},
+						},
+					}, nil)
+				m.DescribeInstances(gomock.Eq(describeInstanceInput("i-1"))).
+					Return(describeInstanceOutput("i-1"), nil)
+			},
+			ready: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockControl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).To(BeNil())
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							ID: "vpc-1",
+						},
+						Subnets: infrav1.Subnets{
+							{ID: "subnet-private-1a", AvailabilityZone: "us-east-1a"},
+							{ID: "subnet-public-1a", AvailabilityZone: "us-east-1a", IsPublic: true},
+							{ID: "subnet-public-1b", AvailabilityZone: "us-east-1b", IsPublic: true},
+						},
+					},
+					Bastion: infrav1.Bastion{
+						Enabled:      true,
+						Mode:         infrav1.BastionModeAutoScalingGroup,
+						InstanceType: "t3.small",
+						AMI:          "ami-bastion",
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupBastion: {ID: "sg-bastion"},
+						},
+					},
+				},
+			}
+
+			client := fake.NewFakeClientWithScheme(scheme)
+			ctx := context.TODO()
+			client.Create(ctx, awsCluster)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "cluster",
+					},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+
+			// No bastion instance is left over from another mode.
+			ec2Mock.EXPECT().
+				DescribeInstances(gomock.Eq(&ec2.DescribeInstancesInput{
+					Filters: []*ec2.Filter{
+						filter.EC2.ProviderRole(infrav1.BastionRoleTagValue),
+						filter.EC2.Cluster("cluster"),
+						filter.EC2.InstanceStates(
+							ec2.InstanceStateNamePending,
+							ec2.InstanceStateNameRunning,
+							ec2.InstanceStateNameStopping,
+							ec2.InstanceStateNameStopped,
+						),
+					},
+				})).
+				Return(&ec2.DescribeInstancesOutput{}, nil)
+
+			tc.expect(ec2Mock.EXPECT(), asgMock.EXPECT())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			s.ASGClient = asgMock
+
+			g.Expect(s.ReconcileBastion()).To(Succeed())
+			g.Expect(awsCluster.Status.BastionMode).To(Equal(infrav1.BastionModeAutoScalingGroup))
+			if !tc.ready {
+				g.Expect(conditions.GetReason(awsCluster, infrav1.BastionHostReadyCondition)).To(Equal(infrav1.BastionCreationStartedReason))
+				g.Expect(awsCluster.Status.BastionEndpoint).To(BeEmpty())
+				return
+			}
+			g.Expect(conditions.IsTrue(awsCluster, infrav1.BastionHostReadyCondition)).To(BeTrue())
+			g.Expect(awsCluster.Status.BastionEndpoint).To(Equal("1.2.3.4"))
+			g.Expect(awsCluster.Status.Bastion).NotTo(BeNil())
+			g.Expect(aws.StringValue(awsCluster.Status.Bastion.PublicIP)).To(Equal("1.2.3.4"))
+		})
+	}
+}
+
+func TestDeleteBastionAutoScalingGroup(t *testing.T) {
+	describeGroupInput := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{"cluster-bastion"}),
+	}
+
+	describeAddressesInput := &ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Cluster("cluster"),
+			filter.EC2.Name("cluster-bastion"),
+		},
+	}
+
+	group := func(status *string) *autoscaling.DescribeAutoScalingGroupsOutput {
+		return &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{
+					AutoScalingGroupName: aws.String("cluster-bastion"),
+					LaunchTemplate:       &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-bastion")},
+					Status:               status,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder, a *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		expectedErr string
+	}{
+		{
+			name: "deletes the group, its launch template and Elastic IP without waiting",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, a *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				a.DescribeAutoScalingGroups(gomock.Eq(describeGroupInput)).
+					Return(group(nil), nil)
+				a.DeleteAutoScalingGroup(gomock.Eq(&autoscaling.DeleteAutoScalingGroupInput{
+					AutoScalingGroupName: aws.String("cluster-bastion"),
+					ForceDelete:          aws.Bool(true),
+				})).
+					Return(&autoscaling.DeleteAutoScalingGroupOutput{}, nil)
+				m.DeleteLaunchTemplate(gomock.Eq(&ec2.DeleteLaunchTemplateInput{LaunchTemplateId: aws.String("lt-bastion")})).
+					Return(&ec2.DeleteLaunchTemplateOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(describeAddressesInput)).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{AllocationId: aws.String("eipalloc-1"), AssociationId: aws.String("eipassoc-1")},
+						},
+					}, nil)
+				m.DisassociateAddress(gomock.Eq(&ec2.DisassociateAddressInput{AssociationId: aws.String("eipassoc-1")})).
+					Return(&ec2.DisassociateAddressOutput{}, nil)
+				m.ReleaseAddress(gomock.Eq(&ec2.ReleaseAddressInput{AllocationId: aws.String("eipalloc-1")})).
+					Return(&ec2.ReleaseAddressOutput{}, nil)
+			},
+			expectedErr: `bastion auto scaling group "cluster-bastion" is being deleted`,
+		},
+		{
+			name: "resumes the deletion of a group being deleted",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, a *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				a.DescribeAutoScalingGroups(gomock.Eq(describeGroupInput)).
+					Return(group(aws.String("Delete in progress")), nil)
+				m.DeleteLaunchTemplate(gomock.Eq(&ec2.DeleteLaunchTemplateInput{LaunchTemplateId: aws.String("lt-bastion")})).
+					Return(nil, awserr.New(awserrors.LaunchTemplateNameNotFound, "not found", nil))
+				m.DescribeAddresses(gomock.Eq(describeAddressesInput)).
+					Return(&ec2.DescribeAddressesOutput{}, nil)
+			},
+			expectedErr: `bastion auto scaling group "cluster-bastion" is being deleted`,
+		},
+		{
+			name: "the group is gone",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, a *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				a.DescribeAutoScalingGroups(gomock.Eq(describeGroupInput)).
+					Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockControl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).To(BeNil())
+
+			awsCluster := &infrav1.AWSCluster{
+				Status: infrav1.AWSClusterStatus{
+					BastionEndpoint: "1.2.3.4",
+				},
+			}
+
+			client := fake.NewFakeClientWithScheme(scheme)
+			client.Create(context.TODO(), awsCluster)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "cluster",
+					},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(ec2Mock.EXPECT(), asgMock.EXPECT())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			s.ASGClient = asgMock
+
+			err = s.deleteBastionAutoScalingGroup()
+			if tc.expectedErr == "" {
+				g.Expect(err).To(BeNil())
+				g.Expect(awsCluster.Status.BastionEndpoint).To(Equal("1.2.3.4"))
+				return
+			}
+			g.Expect(err).To(MatchError(tc.expectedErr))
+			g.Expect(awsCluster.Status.BastionEndpoint).To(BeEmpty())
+			g.Expect(conditions.GetReason(awsCluster, infrav1.BastionHostReadyCondition)).To(Equal(clusterv1.DeletingReason))
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/metrics"
+)
+
+const (
+	// DefaultLookupCacheTTL is the default time AMI, image and instance type lookups are cached for.
+	DefaultLookupCacheTTL = 5 * time.Minute
+
+	amiLookupCache          = "ami"
+	imageLookupCache        = "image"
+	instanceTypeLookupCache = "instance_type"
+)
+
+// lookups caches the results of AMI, image and instance type lookups across services and
+// reconciles, so that machines created from the same template share a single set of
+// Describe calls.
+var lookups = newLookupCache(DefaultLookupCacheTTL)
+
+// accountIDs caches the account of the controller credentials by region, as the sessions
+// and their credentials are shared by all clusters of a region.
+var accountIDs sync.Map
+
+// SetLookupCacheTTL sets the time AMI, image and instance type lookups are cached for, and drops
+// all cached lookups. A TTL of zero disables caching.
+func SetLookupCacheTTL(ttl time.Duration) {
+	lookups.mu.Lock()
+	defer lookups.mu.Unlock()
+
+	lookups.ttl = ttl
+	lookups.entries = map[string]lookupCacheEntry{}
+}
+
+type lookupCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+type lookupCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]lookupCacheEntry
+	// nextPrune is when expired entries are next removed from the cache.
+	nextPrune time.Time
+	now       func() time.Time
+}
+
+func newLookupCache(ttl time.Duration) *lookupCache {
+	return &lookupCache{
+		ttl:     ttl,
+		entries: map[string]lookupCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+func (c *lookupCache) enabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ttl > 0
+}
+
+// get returns the cached value for the key in the given cache.
+func (c *lookupCache) get(cache, region, key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ttl <= 0 {
+		return nil, false
+	}
+
+	entry, ok := c.entries[key]
+	if !ok || c.now().After(entry.expires) {
+		delete(c.entries, key)
+		metrics.RecordLookupCacheRequest(cache, region, metrics.LookupCacheMiss)
+		return nil, false
+	}
+
+	metrics.RecordLookupCacheRequest(cache, region, metrics.LookupCacheHit)
+	return entry.value, true
+}
+
+// set caches the value for the key. Expired entries are removed from the cache at most once per
+// TTL, so that lookups that are not repeated, for example for retired images, don't pile up.
+func (c *lookupCache) set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ttl <= 0 {
+		return
+	}
+
+	now := c.now()
+	if now.After(c.nextPrune) {
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.nextPrune = now.Add(c.ttl)
+	}
+
+	c.entries[key] = lookupCacheEntry{
+		value:   value,
+		expires: now.Add(c.ttl),
+	}
+}
+
+// lookupCacheKey returns the key for a lookup in the given cache. Keys include the region and
+// the account of the credentials used by the service, as the images visible to an account
+// differ between accounts and regions. It returns false if the lookup should not be cached.
+func (s *Service) lookupCacheKey(cache string, parts ...string) (string, bool) {
+	if !lookups.enabled() {
+		return "", false
+	}
+
+	accountID, err := s.accountID()
+	if err != nil {
+		s.scope.V(2).Info("Not caching lookup, unable to get the account ID", "cache", cache, "error", err.Error())
+		return "", false
+	}
+
+	return strings.Join(append([]string{cache, s.scope.Region(), accountID}, parts...), "/"), true
+}
+
+// accountID returns the account of the credentials used by the service.
+func (s *Service) accountID() (string, error) {
+	region := s.scope.Region()
+	if accountID, ok := accountIDs.Load(region); ok {
+		return accountID.(string), nil
+	}
+
+	out, err := s.STSClient.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get caller identity")
+	}
+
+	accountID := aws.StringValue(out.Account)
+	accountIDs.Store(region, accountID)
+	return accountID, nil
+}
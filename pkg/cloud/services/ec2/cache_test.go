@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/golang/mock/gomock"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm/mock_ssmiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestMain(m *testing.M) {
+	// Disable the lookup cache, so that tests don't depend on lookups of earlier tests.
+	SetLookupCacheTTL(0)
+	os.Exit(m.Run())
+}
+
+func TestLookupCache(t *testing.T) {
+	now := time.Now()
+	c := newLookupCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.set("ami/us-east-1/123456789012/v1.19.3", "ami-1-19")
+	c.set("ami/us-east-1/123456789012/v1.20.0", "ami-1-20")
+
+	if v, ok := c.get(amiLookupCache, "us-east-1", "ami/us-east-1/123456789012/v1.19.3"); !ok || v != "ami-1-19" {
+		t.Fatalf("expected cache hit for ami-1-19, got %v, %v", v, ok)
+	}
+	if v, ok := c.get(amiLookupCache, "us-east-1", "ami/us-east-1/123456789012/v1.20.0"); !ok || v != "ami-1-20" {
+		t.Fatalf("expected cache hit for ami-1-20, got %v, %v", v, ok)
+	}
+	if _, ok := c.get(amiLookupCache, "us-east-1", "ami/us-east-1/210987654321/v1.19.3"); ok {
+		t.Fatal("expected cache miss for another account")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.get(amiLookupCache, "us-east-1", "ami/us-east-1/123456789012/v1.19.3"); ok {
+		t.Fatal("expected entry to be expired")
+	}
+
+	// Setting an entry after the TTL removes the expired entries that were never read again.
+	c.set("image/us-east-1/123456789012/ami-2", "image")
+	if len(c.entries) != 1 {
+		t.Fatalf("expected expired entries to be pruned, got %d entries", len(c.entries))
+	}
+}
+
+// fakeSTSClient returns a fixed account for GetCallerIdentity.
+type fakeSTSClient struct {
+	stsiface.STSAPI
+	account string
+}
+
+func (f *fakeSTSClient) GetCallerIdentity(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+	return &sts.GetCallerIdentityOutput{Account: aws.String(f.account)}, nil
+}
+
+func TestLookupAMICached(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	SetLookupCacheTTL(time.Minute)
+	defer SetLookupCacheTTL(0)
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+
+	// The instance type is described once, and the SSM parameter once per Kubernetes version, even
+	// when machines of both versions are reconciled in turn during an upgrade.
+	ec2Mock.EXPECT().
+		DescribeInstanceTypes(gomock.Any()).
+		Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{
+				{
+					ProcessorInfo: &ec2.ProcessorInfo{
+						SupportedArchitectures: aws.StringSlice([]string{"x86_64"}),
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+	ssmMock.EXPECT().
+		GetParameter(gomock.Eq(&ssm.GetParameterInput{
+			Name: aws.String("/aws/service/bottlerocket/aws-k8s-1.19/x86_64/latest/image_id"),
+		})).
+		Return(&ssm.GetParameterOutput{
+			Parameter: &ssm.Parameter{
+				Value: aws.String("ami-1-19"),
+			},
+		}, nil).
+		Times(1)
+	ssmMock.EXPECT().
+		GetParameter(gomock.Eq(&ssm.GetParameterInput{
+			Name: aws.String("/aws/service/bottlerocket/aws-k8s-1.20/x86_64/latest/image_id"),
+		})).
+		Return(&ssm.GetParameterOutput{
+			Parameter: &ssm.Parameter{
+				Value: aws.String("ami-1-20"),
+			},
+		}, nil).
+		Times(1)
+
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    &clusterv1.Cluster{},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+	s.SSMClient = ssmMock
+	s.STSClient = &fakeSTSClient{account: "123456789012"}
+
+	ami := infrav1.AMIReference{
+		SSMParameter: aws.String("/aws/service/bottlerocket/aws-k8s-{{.K8sMajorMinorVersion}}/{{.Arch}}/latest/image_id"),
+	}
+
+	for _, tc := range []struct {
+		version    string
+		expectedID string
+	}{
+		{version: "v1.19.3", expectedID: "ami-1-19"},
+		{version: "v1.20.0", expectedID: "ami-1-20"},
+		{version: "v1.19.3", expectedID: "ami-1-19"},
+		{version: "v1.20.0", expectedID: "ami-1-20"},
+	} {
+		id, err := s.lookupAMI(ami, "m5.large", "", "", "", aws.String(tc.version), false)
+		if err != nil {
+			t.Fatalf("did not expect error: %v", err)
+		}
+		if id != tc.expectedID {
+			t.Fatalf("returned %q expected %q", id, tc.expectedID)
+		}
+	}
+}
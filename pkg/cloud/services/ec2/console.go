@@ -22,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 )
 
@@ -34,7 +35,9 @@ func (s *Service) GetConsoleOutput(instanceID string) (string, error) {
 
 	out, err := s.EC2Client.GetConsoleOutput(input)
 	if err != nil {
-		record.Eventf(s.scope.InfraCluster(), "FailedGetConsoleOutput", "failed to get console output for instance %q: %v", instanceID, err)
+		if !awserrors.IsAccessDenied(err) {
+			record.Eventf(s.scope.InfraCluster(), "FailedGetConsoleOutput", "failed to get console output for instance %q: %v", instanceID, err)
+		}
 		return "", errors.Wrapf(err, "failed to get console output for instance %q", instanceID)
 	}
 
@@ -0,0 +1,290 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ReconcileDedicatedHosts allocates the Dedicated Hosts of the dedicated host pool of the cluster, and releases the
+// hosts that are no longer part of the pool and do not run any instance.
+func (s *Service) ReconcileDedicatedHosts() error {
+	pool := s.scope.DedicatedHostPool()
+
+	// Clusters without a pool that never had hosts, which are most of them, don't need any API call.
+	if pool == nil && len(s.scope.DedicatedHosts()) == 0 {
+		s.scope.V(4).Info("Skipping dedicated hosts reconcile")
+		return nil
+	}
+
+	hosts, err := s.describeDedicatedHosts()
+	if err != nil {
+		return err
+	}
+
+	s.scope.V(2).Info("Reconciling dedicated hosts")
+
+	desired := map[string]int64{}
+	if pool != nil {
+		count := pool.HostsPerAvailabilityZone
+		if count == 0 {
+			count = 1
+		}
+		for _, zone := range s.dedicatedHostAvailabilityZones(pool) {
+			desired[zone] = count
+		}
+	}
+
+	hostsByZone := map[string][]*ec2.Host{}
+	for _, host := range hosts {
+		zone := aws.StringValue(host.AvailabilityZone)
+		hostsByZone[zone] = append(hostsByZone[zone], host)
+	}
+
+	// Only hosts that do not run any instance are released, the others are released once their instances are gone.
+	release := []string{}
+	remaining := []infrav1.DedicatedHost{}
+	for zone, zoneHosts := range hostsByZone {
+		surplus := int64(len(zoneHosts)) - desired[zone]
+		for _, host := range zoneHosts {
+			if surplus > 0 && len(host.Instances) == 0 {
+				release = append(release, aws.StringValue(host.HostId))
+				surplus--
+				continue
+			}
+			remaining = append(remaining, sdkToDedicatedHost(host))
+		}
+	}
+
+	if len(release) > 0 {
+		sort.Strings(release)
+		if err := s.releaseDedicatedHosts(release); err != nil {
+			return err
+		}
+	}
+
+	zones := make([]string, 0, len(desired))
+	for zone := range desired {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+
+	for _, zone := range zones {
+		missing := desired[zone] - int64(len(hostsByZone[zone]))
+		if missing <= 0 {
+			continue
+		}
+
+		ids, err := s.allocateDedicatedHosts(pool, zone, missing)
+		if err != nil {
+			conditions.MarkFalse(s.scope.InfraCluster(), infrav1.DedicatedHostsReadyCondition, infrav1.DedicatedHostsFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			record.Warnf(s.scope.InfraCluster(), "FailedAllocateDedicatedHosts", "Failed to allocate dedicated hosts in %q: %v", zone, err)
+			s.setDedicatedHosts(remaining)
+			return err
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulAllocateDedicatedHosts", "Allocated dedicated hosts %s in %q", strings.Join(ids, ", "), zone)
+
+		for _, id := range ids {
+			remaining = append(remaining, infrav1.DedicatedHost{ID: id, AvailabilityZone: zone})
+		}
+	}
+
+	s.setDedicatedHosts(remaining)
+	if pool != nil {
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.DedicatedHostsReadyCondition)
+	}
+	s.scope.V(2).Info("Reconcile dedicated hosts completed successfully")
+
+	return nil
+}
+
+// DeleteDedicatedHosts releases all Dedicated Hosts allocated for the cluster. It fails if any of them still runs
+// instances, as those hosts cannot be released yet.
+func (s *Service) DeleteDedicatedHosts() error {
+	if s.scope.DedicatedHostPool() == nil && len(s.scope.DedicatedHosts()) == 0 {
+		s.scope.V(4).Info("No dedicated hosts to release")
+		return nil
+	}
+
+	hosts, err := s.describeDedicatedHosts()
+	if err != nil {
+		return err
+	}
+	if len(hosts) == 0 {
+		s.scope.V(4).Info("No dedicated hosts to release")
+		return nil
+	}
+
+	release := []string{}
+	busy := []string{}
+	for _, host := range hosts {
+		if len(host.Instances) > 0 {
+			busy = append(busy, aws.StringValue(host.HostId))
+			continue
+		}
+		release = append(release, aws.StringValue(host.HostId))
+	}
+
+	if len(release) > 0 {
+		if err := s.releaseDedicatedHosts(release); err != nil {
+			return err
+		}
+	}
+
+	if len(busy) > 0 {
+		return errors.Errorf("dedicated hosts %s still run instances", strings.Join(busy, ", "))
+	}
+
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.DedicatedHostsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	s.setDedicatedHosts(nil)
+
+	return nil
+}
+
+// dedicatedHostAvailabilityZones returns the availability zones in which hosts of the pool are allocated.
+func (s *Service) dedicatedHostAvailabilityZones(pool *infrav1.DedicatedHostPool) []string {
+	if len(pool.AvailabilityZones) > 0 {
+		return pool.AvailabilityZones
+	}
+
+	zones := []string{}
+	seen := map[string]bool{}
+	for _, subnet := range s.scope.Subnets().FilterPrivate() {
+		if subnet.AvailabilityZone == "" || seen[subnet.AvailabilityZone] {
+			continue
+		}
+		seen[subnet.AvailabilityZone] = true
+		zones = append(zones, subnet.AvailabilityZone)
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+func (s *Service) describeDedicatedHosts() ([]*ec2.Host, error) {
+	input := &ec2.DescribeHostsInput{
+		Filter: []*ec2.Filter{
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.ProviderRole(infrav1.DedicatedHostRoleTagValue),
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.AllocationStatePending, ec2.AllocationStateAvailable, ec2.AllocationStateUnderAssessment}),
+			},
+		},
+	}
+
+	hosts := []*ec2.Host{}
+	if err := s.EC2Client.DescribeHostsPages(input, func(out *ec2.DescribeHostsOutput, _ bool) bool {
+		hosts = append(hosts, out.Hosts...)
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to describe dedicated hosts")
+	}
+
+	return hosts, nil
+}
+
+func (s *Service) allocateDedicatedHosts(pool *infrav1.DedicatedHostPool, zone string, quantity int64) ([]string, error) {
+	s.scope.V(2).Info("Allocating dedicated hosts", "availability-zone", zone, "quantity", quantity)
+
+	input := &ec2.AllocateHostsInput{
+		AvailabilityZone: aws.String(zone),
+		Quantity:         aws.Int64(quantity),
+		AutoPlacement:    aws.String(ec2.AutoPlacementOn),
+		HostRecovery:     aws.String(ec2.HostRecoveryOff),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeDedicatedHost, infrav1.BuildParams{
+				ClusterName: s.scope.Name(),
+				Lifecycle:   infrav1.ResourceLifecycleOwned,
+				Name:        aws.String(fmt.Sprintf("%s-dedicated-host", s.scope.Name())),
+				Role:        aws.String(infrav1.DedicatedHostRoleTagValue),
+				Additional:  s.scope.AdditionalTags(),
+			}),
+		},
+	}
+	if pool.InstanceType != "" {
+		input.InstanceType = aws.String(pool.InstanceType)
+	} else {
+		input.InstanceFamily = aws.String(pool.InstanceFamily)
+	}
+	if pool.HostRecovery {
+		input.HostRecovery = aws.String(ec2.HostRecoveryOn)
+	}
+
+	out, err := s.EC2Client.AllocateHosts(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to allocate dedicated hosts in %q", zone)
+	}
+
+	return aws.StringValueSlice(out.HostIds), nil
+}
+
+func (s *Service) releaseDedicatedHosts(ids []string) error {
+	s.scope.V(2).Info("Releasing dedicated hosts", "host-ids", ids)
+
+	out, err := s.EC2Client.ReleaseHosts(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice(ids)})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedReleaseDedicatedHosts", "Failed to release dedicated hosts %s: %v", strings.Join(ids, ", "), err)
+		return errors.Wrapf(err, "failed to release dedicated hosts %v", ids)
+	}
+
+	if len(out.Unsuccessful) > 0 {
+		failures := make([]string, 0, len(out.Unsuccessful))
+		for _, item := range out.Unsuccessful {
+			message := ""
+			if item.Error != nil {
+				message = aws.StringValue(item.Error.Message)
+			}
+			failures = append(failures, fmt.Sprintf("%s: %s", aws.StringValue(item.ResourceId), message))
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedReleaseDedicatedHosts", "Failed to release dedicated hosts: %s", strings.Join(failures, "; "))
+		return errors.Errorf("failed to release dedicated hosts: %s", strings.Join(failures, "; "))
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulReleaseDedicatedHosts", "Released dedicated hosts %s", strings.Join(ids, ", "))
+	return nil
+}
+
+// setDedicatedHosts sets the dedicated hosts of the cluster, ordered by availability zone and ID.
+func (s *Service) setDedicatedHosts(hosts []infrav1.DedicatedHost) {
+	sort.Slice(hosts, func(i, j int) bool {
+		if hosts[i].AvailabilityZone != hosts[j].AvailabilityZone {
+			return hosts[i].AvailabilityZone < hosts[j].AvailabilityZone
+		}
+		return hosts[i].ID < hosts[j].ID
+	})
+	s.scope.SetDedicatedHosts(hosts)
+}
+
+func sdkToDedicatedHost(host *ec2.Host) infrav1.DedicatedHost {
+	return infrav1.DedicatedHost{
+		ID:               aws.StringValue(host.HostId),
+		AvailabilityZone: aws.StringValue(host.AvailabilityZone),
+		State:            aws.StringValue(host.State),
+	}
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestReconcileDedicatedHosts(t *testing.T) {
+	clusterName := "cluster"
+
+	describeInput := &ec2.DescribeHostsInput{
+		Filter: []*ec2.Filter{
+			filter.EC2.ClusterOwned(clusterName),
+			filter.EC2.ProviderRole(infrav1.DedicatedHostRoleTagValue),
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{"pending", "available", "under-assessment"}),
+			},
+		},
+	}
+
+	describeHosts := func(m *mock_ec2iface.MockEC2APIMockRecorder, hosts ...*ec2.Host) {
+		m.DescribeHostsPages(gomock.Eq(describeInput), gomock.Any()).
+			Do(func(_ *ec2.DescribeHostsInput, fn func(*ec2.DescribeHostsOutput, bool) bool) {
+				fn(&ec2.DescribeHostsOutput{Hosts: hosts}, true)
+			}).
+			Return(nil)
+	}
+
+	subnets := infrav1.Subnets{
+		{ID: "subnet-private-1a", AvailabilityZone: "us-east-1a", IsPublic: false},
+		{ID: "subnet-public-1a", AvailabilityZone: "us-east-1a", IsPublic: true},
+		{ID: "subnet-private-1b", AvailabilityZone: "us-east-1b", IsPublic: false},
+	}
+
+	testCases := []struct {
+		name          string
+		pool          *infrav1.DedicatedHostPool
+		hosts         []infrav1.DedicatedHost
+		expect        func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectError   bool
+		expectedHosts []infrav1.DedicatedHost
+	}{
+		{
+			name:   "does nothing without a pool or hosts",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "releases the hosts once the pool is removed",
+			hosts: []infrav1.DedicatedHost{
+				{ID: "h-1", AvailabilityZone: "us-east-1a", State: "available"},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeHosts(m, &ec2.Host{
+					HostId:           aws.String("h-1"),
+					AvailabilityZone: aws.String("us-east-1a"),
+					State:            aws.String("available"),
+				})
+				m.ReleaseHosts(gomock.Eq(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice([]string{"h-1"})})).
+					Return(&ec2.ReleaseHostsOutput{}, nil)
+			},
+			expectedHosts: []infrav1.DedicatedHost{},
+		},
+		{
+			name: "allocates hosts in the availability zones of the private subnets",
+			pool: &infrav1.DedicatedHostPool{
+				InstanceFamily: "m5",
+				HostRecovery:   true,
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeHosts(m, &ec2.Host{
+					HostId:           aws.String("h-1a"),
+					AvailabilityZone: aws.String("us-east-1a"),
+					State:            aws.String("available"),
+				})
+				m.AllocateHosts(gomock.Any()).
+					Do(func(input *ec2.AllocateHostsInput) {
+						if aws.StringValue(input.AvailabilityZone) != "us-east-1b" ||
+							aws.Int64Value(input.Quantity) != 1 ||
+							aws.StringValue(input.InstanceFamily) != "m5" ||
+							aws.StringValue(input.AutoPlacement) != "on" ||
+							aws.StringValue(input.HostRecovery) != "on" {
+							t.Fatalf("unexpected allocate hosts input: %v", input)
+						}
+					}).
+					Return(&ec2.AllocateHostsOutput{HostIds: aws.StringSlice([]string{"h-1b"})}, nil)
+			},
+			expectedHosts: []infrav1.DedicatedHost{
+				{ID: "h-1a", AvailabilityZone: "us-east-1a", State: "available"},
+				{ID: "h-1b", AvailabilityZone: "us-east-1b"},
+			},
+		},
+		{
+			name: "releases surplus hosts that do not run instances",
+			pool: &infrav1.DedicatedHostPool{
+				InstanceType:      "m5.large",
+				AvailabilityZones: []string{"us-east-1a"},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeHosts(m,
+					&ec2.Host{
+						HostId:           aws.String("h-busy"),
+						AvailabilityZone: aws.String("us-east-1a"),
+						State:            aws.String("available"),
+						Instances:        []*ec2.HostInstance{{InstanceId: aws.String("i-1")}},
+					},
+					&ec2.Host{
+						HostId:           aws.String("h-idle"),
+						AvailabilityZone: aws.String("us-east-1a"),
+						State:            aws.String("available"),
+					},
+					&ec2.Host{
+						HostId:           aws.String("h-other-zone"),
+						AvailabilityZone: aws.String("us-east-1b"),
+						State:            aws.String("available"),
+					},
+				)
+				m.ReleaseHosts(gomock.Eq(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice([]string{"h-idle", "h-other-zone"})})).
+					Return(&ec2.ReleaseHostsOutput{}, nil)
+			},
+			expectedHosts: []infrav1.DedicatedHost{
+				{ID: "h-busy", AvailabilityZone: "us-east-1a", State: "available"},
+			},
+		},
+		{
+			name: "fails if hosts can't be released",
+			pool: &infrav1.DedicatedHostPool{
+				InstanceType:      "m5.large",
+				AvailabilityZones: []string{"us-east-1a"},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeHosts(m,
+					&ec2.Host{
+						HostId:           aws.String("h-1"),
+						AvailabilityZone: aws.String("us-east-1a"),
+					},
+					&ec2.Host{
+						HostId:           aws.String("h-2"),
+						AvailabilityZone: aws.String("us-east-1a"),
+					},
+				)
+				m.ReleaseHosts(gomock.Any()).
+					Return(&ec2.ReleaseHostsOutput{
+						Unsuccessful: []*ec2.UnsuccessfulItem{{
+							ResourceId: aws.String("h-2"),
+							Error:      &ec2.UnsuccessfulItemError{Message: aws.String("host is busy")},
+						}},
+					}, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							Subnets: subnets,
+						},
+						DedicatedHosts: tc.pool,
+					},
+					Status: infrav1.AWSClusterStatus{
+						DedicatedHosts: tc.hosts,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(ec2Mock.EXPECT())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.ReconcileDedicatedHosts()
+			if tc.expectError {
+				g.Expect(err).NotTo(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.AWSCluster.Status.DedicatedHosts).To(Equal(tc.expectedHosts))
+		})
+	}
+}
+
+func TestDeleteDedicatedHosts(t *testing.T) {
+	statusHosts := []infrav1.DedicatedHost{
+		{ID: "h-1", AvailabilityZone: "us-east-1a"},
+		{ID: "h-2", AvailabilityZone: "us-east-1b"},
+	}
+
+	testCases := []struct {
+		name        string
+		status      []infrav1.DedicatedHost
+		hosts       []*ec2.Host
+		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectError bool
+	}{
+		{
+			name:   "releases all hosts",
+			status: statusHosts,
+			hosts: []*ec2.Host{
+				{HostId: aws.String("h-1"), AvailabilityZone: aws.String("us-east-1a")},
+				{HostId: aws.String("h-2"), AvailabilityZone: aws.String("us-east-1b")},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ReleaseHosts(gomock.Eq(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice([]string{"h-1", "h-2"})})).
+					Return(&ec2.ReleaseHostsOutput{}, nil)
+			},
+		},
+		{
+			name:   "fails while hosts run instances",
+			status: statusHosts,
+			hosts: []*ec2.Host{
+				{HostId: aws.String("h-1"), AvailabilityZone: aws.String("us-east-1a")},
+				{
+					HostId:           aws.String("h-2"),
+					AvailabilityZone: aws.String("us-east-1b"),
+					Instances:        []*ec2.HostInstance{{InstanceId: aws.String("i-1")}},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ReleaseHosts(gomock.Eq(&ec2.ReleaseHostsInput{HostIds: aws.StringSlice([]string{"h-1"})})).
+					Return(&ec2.ReleaseHostsOutput{}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name:   "does nothing without hosts",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+			if len(tc.status) > 0 {
+				ec2Mock.EXPECT().DescribeHostsPages(gomock.Any(), gomock.Any()).
+					Do(func(_ *ec2.DescribeHostsInput, fn func(*ec2.DescribeHostsOutput, bool) bool) {
+						fn(&ec2.DescribeHostsOutput{Hosts: tc.hosts}, true)
+					}).
+					Return(nil)
+			}
+			tc.expect(ec2Mock.EXPECT())
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{
+					Status: infrav1.AWSClusterStatus{DedicatedHosts: tc.status},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.DeleteDedicatedHosts()
+			if tc.expectError {
+				g.Expect(err).NotTo(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
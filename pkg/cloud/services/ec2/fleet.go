@@ -0,0 +1,278 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// runFleetInstance launches the spot instance through an EC2 Fleet in instant mode, which picks the spot capacity
+// pool among the instance types and subnets of the fleet options of the machine.
+func (s *Service) runFleetInstance(scope *scope.MachineScope, i *infrav1.Instance) (*infrav1.Instance, error) {
+	input, err := s.runInstancesInput(scope.Role(), i)
+	if err != nil {
+		return nil, err
+	}
+
+	// An EC2 Fleet only launches instances from launch templates, so a launch template is created for the
+	// duration of the launch.
+	templateName := fmt.Sprintf("%s-fleet", scope.AWSMachine.UID)
+	templateID, err := s.createOrReplaceLaunchTemplate(templateName, scope.Name(), getLaunchTemplateDataFromRunInstancesInput(input))
+	if err != nil {
+		return nil, err
+	}
+	defer s.deleteFleetLaunchTemplate(templateID)
+
+	instanceTypes, err := s.fleetInstanceTypes(scope, i)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := getFleetOverrides(instanceTypes, s.fleetSubnetIDs(scope, i), scope.AWSMachine.Spec)
+
+	allocationStrategy := scope.AWSMachine.Spec.Fleet.AllocationStrategy
+	if allocationStrategy == "" {
+		allocationStrategy = infrav1.FleetAllocationStrategyCapacityOptimized
+	}
+
+	out, err := s.EC2Client.CreateFleet(&ec2.CreateFleetInput{
+		Type: aws.String(ec2.FleetTypeInstant),
+		TargetCapacitySpecification: &ec2.TargetCapacitySpecificationRequest{
+			TotalTargetCapacity:       aws.Int64(1),
+			DefaultTargetCapacityType: aws.String(ec2.DefaultTargetCapacityTypeSpot),
+		},
+		SpotOptions: &ec2.SpotOptionsRequest{
+			AllocationStrategy: aws.String(string(allocationStrategy)),
+		},
+		LaunchTemplateConfigs: []*ec2.FleetLaunchTemplateConfigRequest{
+			{
+				LaunchTemplateSpecification: &ec2.FleetLaunchTemplateSpecificationRequest{
+					LaunchTemplateId: aws.String(templateID),
+					Version:          aws.String(launchTemplateDefaultVersion),
+				},
+				Overrides: overrides,
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create fleet")
+	}
+
+	var instanceID *string
+	for _, fleetInstance := range out.Instances {
+		if len(fleetInstance.InstanceIds) > 0 {
+			instanceID = fleetInstance.InstanceIds[0]
+			break
+		}
+	}
+
+	if instanceID == nil {
+		failures := make([]string, 0, len(out.Errors))
+		for _, fleetError := range out.Errors {
+			failures = append(failures, fmt.Sprintf("%s: %s", aws.StringValue(fleetError.ErrorCode), aws.StringValue(fleetError.ErrorMessage)))
+		}
+		record.Warnf(scope.AWSMachine, "FailedCreateFleet", "Fleet %q launched no instance: %s", aws.StringValue(out.FleetId), strings.Join(failures, "; "))
+		return nil, errors.Errorf("fleet %q launched no instance: %s", aws.StringValue(out.FleetId), strings.Join(failures, "; "))
+	}
+
+	s.waitUntilInstanceRunning(instanceID)
+
+	instance, err := s.InstanceIfExists(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %q launched by fleet %q not found", aws.StringValue(instanceID), aws.StringValue(out.FleetId))
+	}
+
+	return instance, nil
+}
+
+// createOrReplaceLaunchTemplate creates a launch template owned by the cluster, and returns its ID. A launch
+// template left over with the same name is replaced.
+func (s *Service) createOrReplaceLaunchTemplate(name, resourceName string, data *ec2.RequestLaunchTemplateData) (string, error) {
+	input := &ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(name),
+		LaunchTemplateData: data,
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeLaunchTemplate, infrav1.BuildParams{
+				ClusterName: s.scope.Name(),
+				Lifecycle:   infrav1.ResourceLifecycleOwned,
+				Name:        aws.String(resourceName),
+				Additional:  s.scope.AdditionalTags(),
+			}),
+		},
+	}
+
+	out, err := s.EC2Client.CreateLaunchTemplate(input)
+	if awserrors.IsLaunchTemplateNameAlreadyExists(err) {
+		s.scope.V(2).Info("Replacing left over launch template", "name", name)
+		if _, err := s.EC2Client.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{LaunchTemplateName: aws.String(name)}); err != nil {
+			return "", errors.Wrapf(err, "failed to delete launch template %q", name)
+		}
+		out, err = s.EC2Client.CreateLaunchTemplate(input)
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create launch template %q", name)
+	}
+
+	return aws.StringValue(out.LaunchTemplate.LaunchTemplateId), nil
+}
+
+// deleteFleetLaunchTemplate deletes the launch template of a fleet once it launched the instance. Failures are
+// only logged, as the launch template is replaced during the next launch.
+func (s *Service) deleteFleetLaunchTemplate(id string) {
+	if _, err := s.EC2Client.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{LaunchTemplateId: aws.String(id)}); err != nil {
+		s.scope.Error(err, "failed to delete fleet launch template", "id", id)
+	}
+}
+
+// fleetInstanceTypes returns the instance types a fleet may launch the instance with. As the fleet launches all
+// of them with the same AMI, the instance types must share the same architecture.
+func (s *Service) fleetInstanceTypes(scope *scope.MachineScope, i *infrav1.Instance) ([]string, error) {
+	seen := map[string]bool{}
+	instanceTypes := []string{}
+	for _, instanceType := range append([]string{i.Type}, scope.AWSMachine.Spec.Fleet.InstanceTypes...) {
+		if instanceType == "" || seen[instanceType] {
+			continue
+		}
+		seen[instanceType] = true
+		instanceTypes = append(instanceTypes, instanceType)
+	}
+
+	if len(instanceTypes) > 1 {
+		architecture, err := s.instanceTypeArchitecture(instanceTypes[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, instanceType := range instanceTypes[1:] {
+			instanceTypeArchitecture, err := s.instanceTypeArchitecture(instanceType)
+			if err != nil {
+				return nil, err
+			}
+			if instanceTypeArchitecture != architecture {
+				return nil, errors.Errorf("fleet instance type %q has the %s architecture while %q has %s, all instance types of a fleet must share the same architecture",
+					instanceType, instanceTypeArchitecture, instanceTypes[0], architecture)
+			}
+		}
+	}
+
+	return instanceTypes, nil
+}
+
+// fleetSubnetIDs returns the IDs of the subnets a fleet may launch the instance in.
+func (s *Service) fleetSubnetIDs(scope *scope.MachineScope, i *infrav1.Instance) []string {
+	if len(scope.AWSMachine.Spec.Fleet.SubnetIDs) > 0 {
+		return scope.AWSMachine.Spec.Fleet.SubnetIDs
+	}
+
+	subnetIDs := []string{i.SubnetID}
+	if scope.AWSMachine.Spec.Subnet == nil && scope.Machine.Spec.FailureDomain == nil && scope.AWSMachine.Spec.FailureDomain == nil {
+		subnetIDs = append(subnetIDs, s.fallbackSubnetIDs(i.SubnetID, aws.BoolValue(scope.AWSMachine.Spec.PublicIP))...)
+	}
+	return subnetIDs
+}
+
+// getFleetOverrides returns the launch template overrides of a fleet, one for each instance type and subnet.
+func getFleetOverrides(instanceTypes, subnetIDs []string, spec infrav1.AWSMachineSpec) []*ec2.FleetLaunchTemplateOverridesRequest {
+	overrides := []*ec2.FleetLaunchTemplateOverridesRequest{}
+	for _, subnetID := range subnetIDs {
+		for priority, instanceType := range instanceTypes {
+			override := &ec2.FleetLaunchTemplateOverridesRequest{
+				InstanceType: aws.String(instanceType),
+				SubnetId:     aws.String(subnetID),
+			}
+			if spec.SpotMarketOptions != nil && aws.StringValue(spec.SpotMarketOptions.MaxPrice) != "" {
+				override.MaxPrice = spec.SpotMarketOptions.MaxPrice
+			}
+			if spec.Fleet.AllocationStrategy == infrav1.FleetAllocationStrategyCapacityOptimizedPrioritized {
+				override.Priority = aws.Float64(float64(priority))
+			}
+			overrides = append(overrides, override)
+		}
+	}
+	return overrides
+}
+
+// getLaunchTemplateDataFromRunInstancesInput returns the launch template data equivalent to the input to run an
+// instance. The instance type, subnet and market options are left out, as fleets and Auto Scaling groups set them.
+func getLaunchTemplateDataFromRunInstancesInput(input *ec2.RunInstancesInput) *ec2.RequestLaunchTemplateData {
+	data := &ec2.RequestLaunchTemplateData{
+		ImageId:          input.ImageId,
+		KeyName:          input.KeyName,
+		EbsOptimized:     input.EbsOptimized,
+		UserData:         input.UserData,
+		SecurityGroupIds: input.SecurityGroupIds,
+	}
+
+	if input.IamInstanceProfile != nil {
+		data.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+			Name: input.IamInstanceProfile.Name,
+		}
+	}
+
+	for _, mapping := range input.BlockDeviceMappings {
+		dataMapping := &ec2.LaunchTemplateBlockDeviceMappingRequest{DeviceName: mapping.DeviceName}
+		if mapping.Ebs != nil {
+			dataMapping.Ebs = &ec2.LaunchTemplateEbsBlockDeviceRequest{
+				DeleteOnTermination: mapping.Ebs.DeleteOnTermination,
+				Encrypted:           mapping.Ebs.Encrypted,
+				Iops:                mapping.Ebs.Iops,
+				KmsKeyId:            mapping.Ebs.KmsKeyId,
+				Throughput:          mapping.Ebs.Throughput,
+				VolumeSize:          mapping.Ebs.VolumeSize,
+				VolumeType:          mapping.Ebs.VolumeType,
+			}
+		}
+		data.BlockDeviceMappings = append(data.BlockDeviceMappings, dataMapping)
+	}
+
+	for _, spec := range input.TagSpecifications {
+		data.TagSpecifications = append(data.TagSpecifications, &ec2.LaunchTemplateTagSpecificationRequest{
+			ResourceType: spec.ResourceType,
+			Tags:         spec.Tags,
+		})
+	}
+
+	if input.CapacityReservationSpecification != nil {
+		data.CapacityReservationSpecification = &ec2.LaunchTemplateCapacityReservationSpecificationRequest{
+			CapacityReservationPreference: input.CapacityReservationSpecification.CapacityReservationPreference,
+			CapacityReservationTarget:     input.CapacityReservationSpecification.CapacityReservationTarget,
+		}
+	}
+
+	if input.Placement != nil {
+		data.Placement = &ec2.LaunchTemplatePlacementRequest{
+			Tenancy:              input.Placement.Tenancy,
+			HostId:               input.Placement.HostId,
+			HostResourceGroupArn: input.Placement.HostResourceGroupArn,
+			Affinity:             input.Placement.Affinity,
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestFleetInstanceTypes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	architectures := map[string]string{
+		"m5.large":  "x86_64",
+		"m5a.large": "x86_64",
+		"m6g.large": "arm64",
+	}
+	describeInstanceTypes := func(input *ec2.DescribeInstanceTypesInput) (*ec2.DescribeInstanceTypesOutput, error) {
+		return &ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{
+				{ProcessorInfo: &ec2.ProcessorInfo{SupportedArchitectures: aws.StringSlice([]string{architectures[aws.StringValue(input.InstanceTypes[0])]})}},
+			},
+		}, nil
+	}
+
+	testCases := []struct {
+		name          string
+		instanceType  string
+		fleetTypes    []string
+		expect        func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectedTypes []string
+		expectedErr   string
+	}{
+		{
+			name:          "a single instance type is not described",
+			instanceType:  "m5.large",
+			fleetTypes:    []string{"m5.large"},
+			expect:        func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+			expectedTypes: []string{"m5.large"},
+		},
+		{
+			name:         "instance types of the same architecture are deduplicated",
+			instanceType: "m5.large",
+			fleetTypes:   []string{"m5a.large", "m5.large"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).DoAndReturn(describeInstanceTypes).Times(2)
+			},
+			expectedTypes: []string{"m5.large", "m5a.large"},
+		},
+		{
+			name:         "the instance types of the fleet are used when the machine has none",
+			instanceType: "",
+			fleetTypes:   []string{"m5a.large", "m5.large"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).DoAndReturn(describeInstanceTypes).Times(2)
+			},
+			expectedTypes: []string{"m5a.large", "m5.large"},
+		},
+		{
+			name:         "instance types of different architectures are rejected",
+			instanceType: "m5.large",
+			fleetTypes:   []string{"m6g.large"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Any()).DoAndReturn(describeInstanceTypes).Times(2)
+			},
+			expectedErr: `fleet instance type "m6g.large" has the arm64 architecture while "m5.large" has x86_64`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			machineScope := &scope.MachineScope{
+				AWSMachine: &infrav1.AWSMachine{
+					Spec: infrav1.AWSMachineSpec{
+						InstanceType: tc.instanceType,
+						Fleet:        &infrav1.FleetOptions{InstanceTypes: tc.fleetTypes},
+					},
+				},
+			}
+
+			instanceTypes, err := s.fleetInstanceTypes(machineScope, &infrav1.Instance{Type: tc.instanceType})
+			if tc.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+					t.Fatalf("expected error %q, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("did not expect error: %v", err)
+			}
+			if !reflect.DeepEqual(instanceTypes, tc.expectedTypes) {
+				t.Fatalf("expected instance types %v, got %v", tc.expectedTypes, instanceTypes)
+			}
+		})
+	}
+}
+
+func TestGetFleetOverrides(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     infrav1.AWSMachineSpec
+		expected []*ec2.FleetLaunchTemplateOverridesRequest
+	}{
+		{
+			name: "one override for each subnet and instance type",
+			spec: infrav1.AWSMachineSpec{
+				Fleet: &infrav1.FleetOptions{},
+			},
+			expected: []*ec2.FleetLaunchTemplateOverridesRequest{
+				{InstanceType: aws.String("m5.large"), SubnetId: aws.String("subnet-1")},
+				{InstanceType: aws.String("m5a.large"), SubnetId: aws.String("subnet-1")},
+				{InstanceType: aws.String("m5.large"), SubnetId: aws.String("subnet-2")},
+				{InstanceType: aws.String("m5a.large"), SubnetId: aws.String("subnet-2")},
+			},
+		},
+		{
+			name: "prioritized instance types with a maximum price",
+			spec: infrav1.AWSMachineSpec{
+				SpotMarketOptions: &infrav1.SpotMarketOptions{MaxPrice: aws.String("0.05")},
+				Fleet: &infrav1.FleetOptions{
+					AllocationStrategy: infrav1.FleetAllocationStrategyCapacityOptimizedPrioritized,
+				},
+			},
+			expected: []*ec2.FleetLaunchTemplateOverridesRequest{
+				{InstanceType: aws.String("m5.large"), SubnetId: aws.String("subnet-1"), MaxPrice: aws.String("0.05"), Priority: aws.Float64(0)},
+				{InstanceType: aws.String("m5a.large"), SubnetId: aws.String("subnet-1"), MaxPrice: aws.String("0.05"), Priority: aws.Float64(1)},
+				{InstanceType: aws.String("m5.large"), SubnetId: aws.String("subnet-2"), MaxPrice: aws.String("0.05"), Priority: aws.Float64(0)},
+				{InstanceType: aws.String("m5a.large"), SubnetId: aws.String("subnet-2"), MaxPrice: aws.String("0.05"), Priority: aws.Float64(1)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			overrides := getFleetOverrides([]string{"m5.large", "m5a.large"}, []string{"subnet-1", "subnet-2"}, tc.spec)
+			if !reflect.DeepEqual(overrides, tc.expected) {
+				t.Fatalf("expected overrides %v, got %v", tc.expected, overrides)
+			}
+		})
+	}
+}
+
+func TestGetLaunchTemplateDataFromRunInstancesInput(t *testing.T) {
+	input := &ec2.RunInstancesInput{
+		ImageId:          aws.String("ami-1"),
+		InstanceType:     aws.String("m5.large"),
+		KeyName:          aws.String("default"),
+		SubnetId:         aws.String("subnet-1"),
+		SecurityGroupIds: aws.StringSlice([]string{"sg-1"}),
+		UserData:         aws.String("userdata"),
+		IamInstanceProfile: &ec2.IamInstanceProfileSpecification{
+			Name: aws.String("nodes"),
+		},
+		BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+			{
+				DeviceName: aws.String("/dev/sda1"),
+				Ebs:        &ec2.EbsBlockDevice{VolumeSize: aws.Int64(16), VolumeType: aws.String("gp3"), Throughput: aws.Int64(250)},
+			},
+		},
+		TagSpecifications: []*ec2.TagSpecification{
+			{ResourceType: aws.String(ec2.ResourceTypeInstance), Tags: []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("machine")}}},
+		},
+		InstanceMarketOptions: &ec2.InstanceMarketOptionsRequest{MarketType: aws.String(ec2.MarketTypeSpot)},
+		Placement:             &ec2.Placement{Tenancy: aws.String("dedicated")},
+		CapacityReservationSpecification: &ec2.CapacityReservationSpecification{
+			CapacityReservationTarget: &ec2.CapacityReservationTarget{CapacityReservationId: aws.String("cr-1")},
+		},
+	}
+
+	expected := &ec2.RequestLaunchTemplateData{
+		ImageId:          aws.String("ami-1"),
+		KeyName:          aws.String("default"),
+		SecurityGroupIds: aws.StringSlice([]string{"sg-1"}),
+		UserData:         aws.String("userdata"),
+		IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+			Name: aws.String("nodes"),
+		},
+		BlockDeviceMappings: []*ec2.LaunchTemplateBlockDeviceMappingRequest{
+			{
+				DeviceName: aws.String("/dev/sda1"),
+				Ebs:        &ec2.LaunchTemplateEbsBlockDeviceRequest{VolumeSize: aws.Int64(16), VolumeType: aws.String("gp3"), Throughput: aws.Int64(250)},
+			},
+		},
+		TagSpecifications: []*ec2.LaunchTemplateTagSpecificationRequest{
+			{ResourceType: aws.String(ec2.ResourceTypeInstance), Tags: []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("machine")}}},
+		},
+		Placement: &ec2.LaunchTemplatePlacementRequest{Tenancy: aws.String("dedicated")},
+		CapacityReservationSpecification: &ec2.LaunchTemplateCapacityReservationSpecificationRequest{
+			CapacityReservationTarget: &ec2.CapacityReservationTarget{CapacityReservationId: aws.String("cr-1")},
+		},
+	}
+
+	if data := getLaunchTemplateDataFromRunInstancesInput(input); !reflect.DeepEqual(data, expected) {
+		t.Fatalf("expected launch template data %v, got %v", expected, data)
+	}
+}
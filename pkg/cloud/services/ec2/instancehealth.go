@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+// describeInstanceStatusBatchSize is the number of instances whose status is described per request.
+const describeInstanceStatusBatchSize = 100
+
+// DescribeInstanceHealth returns the status checks and scheduled events of the given instances, keyed by
+// instance ID. Instances that are unknown to EC2 are not part of the result.
+func (s *Service) DescribeInstanceHealth(instanceIDs []string) (map[string]*infrav1.InstanceHealth, error) {
+	health := map[string]*infrav1.InstanceHealth{}
+
+	for start := 0; start < len(instanceIDs); start += describeInstanceStatusBatchSize {
+		end := start + describeInstanceStatusBatchSize
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+
+		input := &ec2.DescribeInstanceStatusInput{
+			InstanceIds:         aws.StringSlice(instanceIDs[start:end]),
+			IncludeAllInstances: aws.Bool(true),
+		}
+
+		if err := s.EC2Client.DescribeInstanceStatusPages(input, func(out *ec2.DescribeInstanceStatusOutput, _ bool) bool {
+			for _, status := range out.InstanceStatuses {
+				health[aws.StringValue(status.InstanceId)] = sdkToInstanceHealth(status)
+			}
+			return true
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to describe status of instances %v", instanceIDs[start:end])
+		}
+	}
+
+	return health, nil
+}
+
+func sdkToInstanceHealth(v *ec2.InstanceStatus) *infrav1.InstanceHealth {
+	health := &infrav1.InstanceHealth{}
+
+	if v.SystemStatus != nil {
+		health.SystemStatus = infrav1.InstanceStatusCheck(aws.StringValue(v.SystemStatus.Status))
+	}
+
+	if v.InstanceStatus != nil {
+		health.InstanceStatus = infrav1.InstanceStatusCheck(aws.StringValue(v.InstanceStatus.Status))
+	}
+
+	for _, event := range v.Events {
+		// Events that already took place or were canceled remain visible for some time, marked by their description.
+		description := aws.StringValue(event.Description)
+		if strings.HasPrefix(description, "[Completed]") || strings.HasPrefix(description, "[Canceled]") {
+			continue
+		}
+
+		scheduledEvent := infrav1.InstanceScheduledEvent{
+			Code:        aws.StringValue(event.Code),
+			Description: description,
+		}
+		if event.NotBefore != nil {
+			notBefore := metav1.NewTime(*event.NotBefore)
+			scheduledEvent.NotBefore = &notBefore
+		}
+		health.ScheduledEvents = append(health.ScheduledEvents, scheduledEvent)
+	}
+
+	return health
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestDescribeInstanceHealth(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	notBefore := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().
+		DescribeInstanceStatusPages(gomock.Eq(&ec2.DescribeInstanceStatusInput{
+			InstanceIds:         aws.StringSlice([]string{"i-healthy", "i-impaired", "i-retiring"}),
+			IncludeAllInstances: aws.Bool(true),
+		}), gomock.Any()).
+		Do(func(_ *ec2.DescribeInstanceStatusInput, fn func(*ec2.DescribeInstanceStatusOutput, bool) bool) {
+			fn(&ec2.DescribeInstanceStatusOutput{
+				InstanceStatuses: []*ec2.InstanceStatus{
+					{
+						InstanceId:     aws.String("i-healthy"),
+						SystemStatus:   &ec2.InstanceStatusSummary{Status: aws.String("ok")},
+						InstanceStatus: &ec2.InstanceStatusSummary{Status: aws.String("ok")},
+						Events: []*ec2.InstanceStatusEvent{
+							{
+								Code:        aws.String("system-reboot"),
+								Description: aws.String("[Completed] Scheduled reboot"),
+							},
+						},
+					},
+					{
+						InstanceId:     aws.String("i-impaired"),
+						SystemStatus:   &ec2.InstanceStatusSummary{Status: aws.String("impaired")},
+						InstanceStatus: &ec2.InstanceStatusSummary{Status: aws.String("ok")},
+					},
+				},
+			}, false)
+			fn(&ec2.DescribeInstanceStatusOutput{
+				InstanceStatuses: []*ec2.InstanceStatus{
+					{
+						InstanceId:     aws.String("i-retiring"),
+						SystemStatus:   &ec2.InstanceStatusSummary{Status: aws.String("ok")},
+						InstanceStatus: &ec2.InstanceStatusSummary{Status: aws.String("ok")},
+						Events: []*ec2.InstanceStatusEvent{
+							{
+								Code:        aws.String("instance-retirement"),
+								Description: aws.String("The instance is running on degraded hardware"),
+								NotBefore:   aws.Time(notBefore),
+							},
+						},
+					},
+				},
+			}, true)
+		}).
+		Return(nil)
+
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    &clusterv1.Cluster{},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	health, err := s.DescribeInstanceHealth([]string{"i-healthy", "i-impaired", "i-retiring"})
+	if err != nil {
+		t.Fatalf("did not expect error: %v", err)
+	}
+
+	expected := map[string]*infrav1.InstanceHealth{
+		"i-healthy": {
+			SystemStatus:   infrav1.InstanceStatusCheckOK,
+			InstanceStatus: infrav1.InstanceStatusCheckOK,
+		},
+		"i-impaired": {
+			SystemStatus:   infrav1.InstanceStatusCheckImpaired,
+			InstanceStatus: infrav1.InstanceStatusCheckOK,
+		},
+		"i-retiring": {
+			SystemStatus:   infrav1.InstanceStatusCheckOK,
+			InstanceStatus: infrav1.InstanceStatusCheckOK,
+			ScheduledEvents: []infrav1.InstanceScheduledEvent{
+				{
+					Code:        "instance-retirement",
+					Description: "The instance is running on degraded hardware",
+					NotBefore:   &metav1.Time{Time: notBefore},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(health, expected) {
+		t.Fatalf("got %v, expected %v", health, expected)
+	}
+}
+
+func TestDescribeInstanceHealthBatches(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	instanceIDs := make([]string, describeInstanceStatusBatchSize+1)
+	for i := range instanceIDs {
+		instanceIDs[i] = fmt.Sprintf("i-%d", i)
+	}
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().
+		DescribeInstanceStatusPages(gomock.Eq(&ec2.DescribeInstanceStatusInput{
+			InstanceIds:         aws.StringSlice(instanceIDs[:describeInstanceStatusBatchSize]),
+			IncludeAllInstances: aws.Bool(true),
+		}), gomock.Any()).
+		Return(nil)
+	ec2Mock.EXPECT().
+		DescribeInstanceStatusPages(gomock.Eq(&ec2.DescribeInstanceStatusInput{
+			InstanceIds:         aws.StringSlice(instanceIDs[describeInstanceStatusBatchSize:]),
+			IncludeAllInstances: aws.Bool(true),
+		}), gomock.Any()).
+		Return(nil)
+
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    &clusterv1.Cluster{},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	if _, err := s.DescribeInstanceHealth(instanceIDs); err != nil {
+		t.Fatalf("did not expect error: %v", err)
+	}
+}
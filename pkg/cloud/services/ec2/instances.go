@@ -41,6 +41,16 @@ import (
 	capierrors "sigs.k8s.io/cluster-api/errors"
 )
 
+const (
+	// launchTemplateDefaultVersion refers to the default version of a launch template.
+	launchTemplateDefaultVersion = "$Default"
+
+	// launchTemplateIDTagKey and launchTemplateVersionTagKey are the tags EC2 adds to instances launched from a
+	// launch template.
+	launchTemplateIDTagKey      = "aws:ec2launchtemplate:id"
+	launchTemplateVersionTagKey = "aws:ec2launchtemplate:version"
+)
+
 // GetRunningInstanceByTags returns the existing instance or nothing if it doesn't exist.
 func (s *Service) GetRunningInstanceByTags(scope *scope.MachineScope) (*infrav1.Instance, error) {
 	s.scope.V(2).Info("Looking for existing machine instance by tags")
@@ -128,12 +138,38 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 	}.WithCloudProvider(s.scope.Name()).WithMachineName(scope.Machine))
 
 	var err error
-	// Pick image from the machine configuration, or use a default one.
+	// Values that are not set in the machine configuration are taken from the launch template.
+	var templateData *ec2.ResponseLaunchTemplateData
+	if scope.AWSMachine.Spec.LaunchTemplate != nil {
+		input.LaunchTemplate = scope.AWSMachine.Spec.LaunchTemplate
+
+		templateData, err = s.getLaunchTemplateData(input.LaunchTemplate)
+		if err != nil {
+			record.Warnf(scope.AWSMachine, "FailedCreate", "Failed to create instance: %v", err)
+			return nil, err
+		}
+
+		// The controller always places the instance in a subnet with the cluster security groups,
+		// which EC2 refuses to combine with network interfaces of the launch template.
+		if len(templateData.NetworkInterfaces) > 0 {
+			err := errors.Errorf("launch template %s defines network interfaces, which conflict with the subnet and security groups set by the controller", launchTemplateReferenceString(input.LaunchTemplate))
+			record.Warnf(scope.AWSMachine, "FailedCreate", "Failed to create instance: %v", err)
+			return nil, err
+		}
+
+		if input.Type == "" {
+			input.Type = aws.StringValue(templateData.InstanceType)
+		}
+	}
+
+	// Pick image from the machine configuration, the launch template, or use a default one.
 	if scope.AWSMachine.Spec.AMI.ID != nil { // nolint:nestif
 		input.ImageID = *scope.AWSMachine.Spec.AMI.ID
+	} else if scope.AWSMachine.Spec.AMI.SSMParameter == nil && templateData != nil && templateData.ImageId != nil {
+		input.ImageID = *templateData.ImageId
 	} else {
-		if scope.Machine.Spec.Version == nil {
-			err := errors.New("Either AWSMachine's spec.ami.id or Machine's spec.version must be defined")
+		if scope.AWSMachine.Spec.AMI.SSMParameter == nil && scope.Machine.Spec.Version == nil {
+			err := errors.New("Either AWSMachine's spec.ami.id, spec.ami.ssmParameter or Machine's spec.version must be defined")
 			scope.SetFailureReason(capierrors.CreateMachineError)
 			scope.SetFailureMessage(err)
 			return nil, err
@@ -154,16 +190,9 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 			imageLookupBaseOS = scope.InfraCluster.ImageLookupBaseOS()
 		}
 
-		if scope.IsEKSManaged() && imageLookupFormat == "" && imageLookupOrg == "" && imageLookupBaseOS == "" {
-			input.ImageID, err = s.eksAMILookup(*scope.Machine.Spec.Version)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			input.ImageID, err = s.defaultAMIIDLookup(imageLookupFormat, imageLookupOrg, imageLookupBaseOS, *scope.Machine.Spec.Version)
-			if err != nil {
-				return nil, err
-			}
+		input.ImageID, err = s.lookupAMI(scope.AWSMachine.Spec.AMI, input.Type, imageLookupFormat, imageLookupOrg, imageLookupBaseOS, scope.Machine.Spec.Version, scope.IsEKSManaged())
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -194,6 +223,11 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 	}
 	input.SecurityGroupIDs = append(input.SecurityGroupIDs, ids...)
 
+	input.AdditionalNetworkInterfaces, err = s.resolveNetworkInterfaceSpecs(scope.AWSMachine.Spec.AdditionalNetworkInterfaces)
+	if err != nil {
+		return nil, err
+	}
+
 	// If SSHKeyName WAS NOT provided in the AWSMachine Spec, fallback to the value provided in the AWSCluster Spec.
 	// If a value was not provided in the AWSCluster Spec, then use the defaultSSHKeyName
 	// Note that:
@@ -201,14 +235,21 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 	// - nil values for both AWSCluster.Spec.SSHKeyName and AWSMachine.Spec.SSHKeyName means use the default SSH key name value
 	// - an empty string means do not set an SSH key name at all
 	// - otherwise use the value specified in either AWSMachine or AWSCluster
+	// - with EC2 Instance Connect SSH access, only an SSH key name of the AWSMachine is used
 	var prioritizedSSHKeyName string
 	switch {
 	case scope.AWSMachine.Spec.SSHKeyName != nil:
 		// prefer AWSMachine.Spec.SSHKeyName if it is defined
 		prioritizedSSHKeyName = *scope.AWSMachine.Spec.SSHKeyName
+	case scope.InfraCluster.SSHAccess() == infrav1.SSHAccessModeInstanceConnect:
+		// keys are pushed through EC2 Instance Connect instead
+		prioritizedSSHKeyName = ""
 	case scope.InfraCluster.SSHKeyName() != nil:
 		// fallback to AWSCluster.Spec.SSHKeyName if it is defined
 		prioritizedSSHKeyName = *scope.InfraCluster.SSHKeyName()
+	case input.LaunchTemplate != nil:
+		// keep the SSH key name of the launch template, if any
+		prioritizedSSHKeyName = ""
 	default:
 		prioritizedSSHKeyName = defaultSSHKeyName
 	}
@@ -222,12 +263,25 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 
 	input.Tenancy = scope.AWSMachine.Spec.Tenancy
 
+	input.HostPlacement = scope.AWSMachine.Spec.HostPlacement
+
+	input.CapacityReservationTarget = scope.AWSMachine.Spec.CapacityReservationTarget
+
 	s.scope.V(2).Info("Running instance", "machine-role", scope.Role())
-	out, err := s.runInstance(scope.Role(), input)
+	var out *infrav1.Instance
+	if scope.AWSMachine.Spec.Fleet != nil {
+		out, err = s.runFleetInstance(scope, input)
+	} else {
+		out, err = s.runInstanceWithFallback(scope, input)
+	}
 	if err != nil {
 		// Only record the failure event if the error is not related to failed dependencies.
 		// This is to avoid spamming failure events since the machine will be requeued by the actuator.
-		if !awserrors.IsFailedDependency(errors.Cause(err)) {
+		// An exhausted capacity reservation gets its own event so that it can be told apart from other failures.
+		switch {
+		case awserrors.IsReservationCapacityExceeded(errors.Cause(err)):
+			record.Warnf(scope.AWSMachine, "FailedCreateCapacityReservationExhausted", "Failed to create instance: capacity reservation %s has no available capacity: %v", capacityReservationTargetString(input.CapacityReservationTarget), err)
+		case !awserrors.IsFailedDependency(errors.Cause(err)):
 			record.Warnf(scope.AWSMachine, "FailedCreate", "Failed to create instance: %v", err)
 		}
 		return nil, err
@@ -242,10 +296,79 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 		}
 	}
 
+	// A failure is not fatal as the instance is already running, the source/destination check is
+	// reconciled again on the next pass.
+	if err := s.ReconcileSourceDestCheck(input.AdditionalNetworkInterfaces, out.AttachedNetworkInterfaces); err != nil {
+		record.Warnf(scope.AWSMachine, "FailedModifyNetworkInterface", "Failed to configure network interfaces of instance %q: %v", out.ID, err)
+	}
+
 	record.Eventf(scope.AWSMachine, "SuccessfulCreate", "Created new %s instance with id %q", scope.Role(), out.ID)
 	return out, nil
 }
 
+// runInstanceWithFallback runs the instance, walking through the fallback instance types and,
+// if allowed, the subnets of other failure domains whenever EC2 reports that there is
+// not enough capacity for the requested instance type. Machines pinned to a failure domain,
+// such as the ones spread by KubeadmControlPlane or MachineDeployments, never leave it.
+func (s *Service) runInstanceWithFallback(scope *scope.MachineScope, i *infrav1.Instance) (*infrav1.Instance, error) {
+	instanceTypes := append([]string{i.Type}, scope.AWSMachine.Spec.FallbackInstanceTypes...)
+
+	pinned := scope.Machine.Spec.FailureDomain != nil || scope.AWSMachine.Spec.FailureDomain != nil
+	subnetIDs := []string{i.SubnetID}
+	if scope.AWSMachine.Spec.FallbackToOtherFailureDomains && !pinned && scope.AWSMachine.Spec.Subnet == nil && len(i.NetworkInterfaces) == 0 && !hasExplicitSubnet(i.AdditionalNetworkInterfaces) {
+		subnetIDs = append(subnetIDs, s.fallbackSubnetIDs(i.SubnetID, aws.BoolValue(scope.AWSMachine.Spec.PublicIP))...)
+	}
+
+	var err error
+	for _, subnetID := range subnetIDs {
+		for _, instanceType := range instanceTypes {
+			i.Type = instanceType
+			i.SubnetID = subnetID
+
+			var out *infrav1.Instance
+			out, err = s.runInstance(scope.Role(), i)
+			if err == nil {
+				return out, nil
+			}
+
+			if !awserrors.IsInsufficientInstanceCapacity(errors.Cause(err)) {
+				return nil, err
+			}
+
+			record.Warnf(scope.AWSMachine, "InsufficientInstanceCapacity", "Insufficient capacity for instance type %q in subnet %q", instanceType, subnetID)
+		}
+	}
+
+	return nil, err
+}
+
+// fallbackSubnetIDs returns one subnet for each availability zone other than the one of the given subnet. The
+// subnets are public if a public IP is requested or the given subnet is public, and private otherwise.
+func (s *Service) fallbackSubnetIDs(subnetID string, publicIP bool) []string {
+	var zone string
+	if subnet := s.scope.Subnets().FindByID(subnetID); subnet != nil {
+		zone = subnet.AvailabilityZone
+		publicIP = publicIP || subnet.IsPublic
+	}
+
+	subnets := s.scope.Subnets().FilterPrivate()
+	if publicIP {
+		subnets = s.scope.Subnets().FilterPublic()
+	}
+
+	seen := map[string]bool{zone: true}
+	ids := []string{}
+	for _, subnet := range subnets {
+		if seen[subnet.AvailabilityZone] {
+			continue
+		}
+		seen[subnet.AvailabilityZone] = true
+		ids = append(ids, subnet.ID)
+	}
+
+	return ids
+}
+
 // findSubnet attempts to retrieve a subnet ID in the following order:
 // - subnetID specified in machine configuration,
 // - subnet based on filters in machine configuration
@@ -428,6 +551,138 @@ func (s *Service) TerminateInstance(instanceID string) error {
 	return nil
 }
 
+// StopInstance stops an EC2 instance.
+// Returns nil on success, error in all other cases.
+func (s *Service) StopInstance(instanceID string) error {
+	s.scope.V(2).Info("Attempting to stop instance", "instance-id", instanceID)
+
+	input := &ec2.StopInstancesInput{
+		InstanceIds: aws.StringSlice([]string{instanceID}),
+	}
+
+	if _, err := s.EC2Client.StopInstances(input); err != nil {
+		return errors.Wrapf(err, "failed to stop instance with id %q", instanceID)
+	}
+
+	s.scope.V(2).Info("Stopped instance", "instance-id", instanceID)
+	return nil
+}
+
+// StartInstance starts a stopped EC2 instance.
+// Returns nil on success, error in all other cases.
+func (s *Service) StartInstance(instanceID string) error {
+	s.scope.V(2).Info("Attempting to start instance", "instance-id", instanceID)
+
+	input := &ec2.StartInstancesInput{
+		InstanceIds: aws.StringSlice([]string{instanceID}),
+	}
+
+	if _, err := s.EC2Client.StartInstances(input); err != nil {
+		return errors.Wrapf(err, "failed to start instance with id %q", instanceID)
+	}
+
+	s.scope.V(2).Info("Started instance", "instance-id", instanceID)
+	return nil
+}
+
+// GetTerminationProtection returns whether the termination protection of an EC2 instance is enabled, which
+// DescribeInstances does not report.
+func (s *Service) GetTerminationProtection(instanceID string) (bool, error) {
+	input := &ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Attribute:  aws.String(ec2.InstanceAttributeNameDisableApiTermination),
+	}
+
+	out, err := s.EC2Client.DescribeInstanceAttribute(input)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get termination protection of instance with id %q", instanceID)
+	}
+	if out.DisableApiTermination == nil {
+		return false, nil
+	}
+	return aws.BoolValue(out.DisableApiTermination.Value), nil
+}
+
+// SetTerminationProtection enables or disables the termination protection of an EC2 instance.
+// Returns nil on success, error in all other cases.
+func (s *Service) SetTerminationProtection(instanceID string, enabled bool) error {
+	s.scope.V(2).Info("Attempting to set termination protection of instance", "instance-id", instanceID, "enabled", enabled)
+
+	input := &ec2.ModifyInstanceAttributeInput{
+		InstanceId:            aws.String(instanceID),
+		DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(enabled)},
+	}
+
+	if _, err := s.EC2Client.ModifyInstanceAttribute(input); err != nil {
+		return errors.Wrapf(err, "failed to set termination protection of instance with id %q", instanceID)
+	}
+
+	s.scope.V(2).Info("Set termination protection of instance", "instance-id", instanceID, "enabled", enabled)
+	return nil
+}
+
+// OrphanInstance replaces the cluster tags of an EC2 instance, of its volumes and of the Elastic IPs the
+// cluster allocated for it with an orphaned tag, so that they are no longer considered part of the cluster
+// and are left in place when it is deleted. The instance keeps its subnet and security groups.
+// Returns nil on success, error in all other cases.
+func (s *Service) OrphanInstance(instanceID string) error {
+	s.scope.V(2).Info("Attempting to orphan instance", "instance-id", instanceID)
+
+	out, err := s.EC2Client.DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: aws.StringSlice([]string{instanceID}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe instance %q", instanceID)
+	}
+
+	if len(out.Reservations) == 0 || len(out.Reservations[0].Instances) == 0 {
+		return errors.Errorf("instance %q not found", instanceID)
+	}
+
+	resources := []*string{aws.String(instanceID)}
+	for _, mapping := range out.Reservations[0].Instances[0].BlockDeviceMappings {
+		if mapping.Ebs != nil && mapping.Ebs.VolumeId != nil {
+			resources = append(resources, mapping.Ebs.VolumeId)
+		}
+	}
+
+	addresses, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.InstanceID(instanceID),
+			filter.EC2.Cluster(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe Elastic IPs of instance %q", instanceID)
+	}
+	for _, address := range addresses.Addresses {
+		resources = append(resources, address.AllocationId)
+	}
+
+	if _, err := s.EC2Client.CreateTags(&ec2.CreateTagsInput{
+		Resources: resources,
+		Tags: []*ec2.Tag{{
+			Key:   aws.String(infrav1.NameAWSProviderOrphaned),
+			Value: aws.String(s.scope.Name()),
+		}},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to tag instance %q as orphaned", instanceID)
+	}
+
+	if _, err := s.EC2Client.DeleteTags(&ec2.DeleteTagsInput{
+		Resources: resources,
+		Tags: []*ec2.Tag{
+			{Key: aws.String(infrav1.ClusterTagKey(s.scope.Name()))},
+			{Key: aws.String(infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name()))},
+		},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to remove cluster tags of instance %q", instanceID)
+	}
+
+	s.scope.V(2).Info("Orphaned instance", "instance-id", instanceID, "resources", aws.StringValueSlice(resources))
+	return nil
+}
+
 // TerminateInstanceAndWait terminates and waits
 // for an EC2 instance to terminate.
 func (s *Service) TerminateInstanceAndWait(instanceID string) error {
@@ -449,8 +704,28 @@ func (s *Service) TerminateInstanceAndWait(instanceID string) error {
 }
 
 func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instance, error) {
+	input, err := s.runInstancesInput(role, i)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.EC2Client.RunInstances(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to run instance")
+	}
+
+	if len(out.Instances) == 0 {
+		return nil, errors.Errorf("no instance returned for reservation %v", out.GoString())
+	}
+
+	s.waitUntilInstanceRunning(out.Instances[0].InstanceId)
+
+	return s.SDKToInstance(out.Instances[0])
+}
+
+// runInstancesInput returns the input to run the given instance.
+func (s *Service) runInstancesInput(role string, i *infrav1.Instance) (*ec2.RunInstancesInput, error) {
 	input := &ec2.RunInstancesInput{
-		InstanceType: aws.String(i.Type),
 		ImageId:      aws.String(i.ImageID),
 		KeyName:      i.SSHKeyName,
 		EbsOptimized: i.EBSOptimized,
@@ -459,9 +734,23 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		UserData:     i.UserData,
 	}
 
+	// The instance type may be left to the launch template.
+	if i.Type != "" {
+		input.InstanceType = aws.String(i.Type)
+	}
+
+	if i.LaunchTemplate != nil {
+		input.LaunchTemplate = &ec2.LaunchTemplateSpecification{
+			LaunchTemplateId:   i.LaunchTemplate.ID,
+			LaunchTemplateName: i.LaunchTemplate.Name,
+			Version:            i.LaunchTemplate.Version,
+		}
+	}
+
 	s.scope.V(2).Info("userData size", "bytes", len(*i.UserData), "role", role)
 
-	if len(i.NetworkInterfaces) > 0 {
+	switch {
+	case len(i.NetworkInterfaces) > 0:
 		netInterfaces := make([]*ec2.InstanceNetworkInterfaceSpecification, 0, len(i.NetworkInterfaces))
 
 		for index, id := range i.NetworkInterfaces {
@@ -472,7 +761,10 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 
 		input.NetworkInterfaces = netInterfaces
-	} else {
+	case len(i.AdditionalNetworkInterfaces) > 0:
+		// Subnet and security groups must be set on the network interfaces when any are specified.
+		input.NetworkInterfaces = getInstanceNetworkInterfaceSpecifications(i)
+	default:
 		input.SubnetId = aws.String(i.SubnetID)
 
 		if len(i.SecurityGroupIDs) > 0 {
@@ -494,28 +786,9 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 			return nil, err
 		}
 
-		ebsRootDevice := &ec2.EbsBlockDevice{
-			DeleteOnTermination: aws.Bool(true),
-			VolumeSize:          aws.Int64(i.RootVolume.Size),
-			Encrypted:           aws.Bool(i.RootVolume.Encrypted),
-		}
-
-		if i.RootVolume.IOPS != 0 {
-			ebsRootDevice.Iops = aws.Int64(i.RootVolume.IOPS)
-		}
-
-		if i.RootVolume.EncryptionKey != "" {
-			ebsRootDevice.Encrypted = aws.Bool(true)
-			ebsRootDevice.KmsKeyId = aws.String(i.RootVolume.EncryptionKey)
-		}
-
-		if i.RootVolume.Type != "" {
-			ebsRootDevice.VolumeType = aws.String(i.RootVolume.Type)
-		}
-
 		blockdeviceMappings = append(blockdeviceMappings, &ec2.BlockDeviceMapping{
 			DeviceName: rootDeviceName,
-			Ebs:        ebsRootDevice,
+			Ebs:        getEBSBlockDevice(i.RootVolume),
 		})
 	}
 
@@ -525,28 +798,9 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 				return nil, errors.Errorf("non root volume should have device name specified")
 			}
 
-			ebsDevice := &ec2.EbsBlockDevice{
-				DeleteOnTermination: aws.Bool(true),
-				VolumeSize:          aws.Int64(nonRootVolume.Size),
-				Encrypted:           aws.Bool(nonRootVolume.Encrypted),
-			}
-
-			if nonRootVolume.IOPS != 0 {
-				ebsDevice.Iops = aws.Int64(nonRootVolume.IOPS)
-			}
-
-			if nonRootVolume.EncryptionKey != "" {
-				ebsDevice.Encrypted = aws.Bool(true)
-				ebsDevice.KmsKeyId = aws.String(nonRootVolume.EncryptionKey)
-			}
-
-			if nonRootVolume.Type != "" {
-				ebsDevice.VolumeType = aws.String(nonRootVolume.Type)
-			}
-
 			blockdeviceMappings = append(blockdeviceMappings, &ec2.BlockDeviceMapping{
-				DeviceName: &nonRootVolume.DeviceName,
-				Ebs:        ebsDevice,
+				DeviceName: aws.String(nonRootVolume.DeviceName),
+				Ebs:        getEBSBlockDevice(nonRootVolume),
 			})
 		}
 	}
@@ -571,39 +825,262 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 
 		input.TagSpecifications = append(input.TagSpecifications, spec)
-	}
 
-	input.InstanceMarketOptions = getInstanceMarketOptionsRequest(i.SpotMarketOptions)
+		// Volumes created together with the instance get the same tags, the tags of individual
+		// volumes are added once they are attached.
+		if len(blockdeviceMappings) > 0 {
+			input.TagSpecifications = append(input.TagSpecifications, &ec2.TagSpecification{
+				ResourceType: aws.String(ec2.ResourceTypeVolume),
+				Tags:         spec.Tags,
+			})
+		}
 
-	if i.Tenancy != "" {
-		input.Placement = &ec2.Placement{
-			Tenancy: &i.Tenancy,
+		// Network interfaces created together with the instance get the same tags.
+		if len(i.AdditionalNetworkInterfaces) > 0 {
+			input.TagSpecifications = append(input.TagSpecifications, &ec2.TagSpecification{
+				ResourceType: aws.String(ec2.ResourceTypeNetworkInterface),
+				Tags:         spec.Tags,
+			})
 		}
 	}
 
-	out, err := s.EC2Client.RunInstances(input)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to run instance")
-	}
+	input.InstanceMarketOptions = getInstanceMarketOptionsRequest(i.SpotMarketOptions)
 
-	if len(out.Instances) == 0 {
-		return nil, errors.Errorf("no instance returned for reservation %v", out.GoString())
-	}
+	input.Placement = getPlacement(i.Tenancy, i.HostPlacement)
 
+	input.CapacityReservationSpecification = getCapacityReservationSpecification(i.CapacityReservationTarget)
+
+	return input, nil
+}
+
+// waitUntilInstanceRunning waits for a short while for the instance to be running.
+func (s *Service) waitUntilInstanceRunning(instanceID *string) {
 	waitTimeout := 1 * time.Minute
-	s.scope.V(2).Info("Waiting for instance to be in running state", "instance-id", *out.Instances[0].InstanceId, "timeout", waitTimeout.String())
+	s.scope.V(2).Info("Waiting for instance to be in running state", "instance-id", aws.StringValue(instanceID), "timeout", waitTimeout.String())
 	ctx, cancel := context.WithTimeout(aws.BackgroundContext(), waitTimeout)
 	defer cancel()
 
 	if err := s.EC2Client.WaitUntilInstanceRunningWithContext(
 		ctx,
-		&ec2.DescribeInstancesInput{InstanceIds: []*string{out.Instances[0].InstanceId}},
+		&ec2.DescribeInstancesInput{InstanceIds: []*string{instanceID}},
 		request.WithWaiterLogger(awslogs.NewWrapLogr(s.scope)),
 	); err != nil {
 		s.scope.V(2).Info("Could not determine if Machine is running. Machine state might be unavailable until next renconciliation.")
 	}
+}
 
-	return s.SDKToInstance(out.Instances[0])
+// getLaunchTemplateData returns the data of the referenced launch template version.
+func (s *Service) getLaunchTemplateData(ref *infrav1.LaunchTemplateReference) (*ec2.ResponseLaunchTemplateData, error) {
+	version := aws.StringValue(ref.Version)
+	if version == "" {
+		version = launchTemplateDefaultVersion
+	}
+
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId:   ref.ID,
+		LaunchTemplateName: ref.Name,
+		Versions:           aws.StringSlice([]string{version}),
+	}
+
+	out, err := s.EC2Client.DescribeLaunchTemplateVersions(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe launch template %s", launchTemplateReferenceString(ref))
+	}
+
+	if len(out.LaunchTemplateVersions) == 0 || out.LaunchTemplateVersions[0].LaunchTemplateData == nil {
+		return nil, errors.Errorf("launch template %s not found", launchTemplateReferenceString(ref))
+	}
+
+	return out.LaunchTemplateVersions[0].LaunchTemplateData, nil
+}
+
+// sdkToLaunchTemplateReference returns the launch template an instance was launched from, based on the tags
+// EC2 adds to such instances.
+func sdkToLaunchTemplateReference(tags infrav1.Tags) *infrav1.LaunchTemplateReference {
+	id, ok := tags[launchTemplateIDTagKey]
+	if !ok {
+		return nil
+	}
+
+	ref := &infrav1.LaunchTemplateReference{ID: aws.String(id)}
+	if version, ok := tags[launchTemplateVersionTagKey]; ok {
+		ref.Version = aws.String(version)
+	}
+	return ref
+}
+
+func launchTemplateReferenceString(ref *infrav1.LaunchTemplateReference) string {
+	name := aws.StringValue(ref.ID)
+	if ref.Name != nil {
+		name = aws.StringValue(ref.Name)
+	}
+	if ref.Version != nil {
+		return fmt.Sprintf("%q (version %s)", name, aws.StringValue(ref.Version))
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+// getEBSBlockDevice returns the EBS block device to create for the given volume.
+func getEBSBlockDevice(volume *infrav1.Volume) *ec2.EbsBlockDevice {
+	ebsDevice := &ec2.EbsBlockDevice{
+		DeleteOnTermination: aws.Bool(true),
+		VolumeSize:          aws.Int64(volume.Size),
+		Encrypted:           aws.Bool(volume.Encrypted),
+	}
+
+	if volume.DeleteOnTermination != nil {
+		ebsDevice.DeleteOnTermination = volume.DeleteOnTermination
+	}
+
+	if volume.IOPS != 0 {
+		ebsDevice.Iops = aws.Int64(volume.IOPS)
+	}
+
+	if volume.Throughput != 0 {
+		ebsDevice.Throughput = aws.Int64(volume.Throughput)
+	}
+
+	if volume.EncryptionKey != "" {
+		ebsDevice.Encrypted = aws.Bool(true)
+		ebsDevice.KmsKeyId = aws.String(volume.EncryptionKey)
+	}
+
+	if volume.Type != "" {
+		ebsDevice.VolumeType = aws.String(volume.Type)
+	}
+
+	return ebsDevice
+}
+
+// resolveNetworkInterfaceSpecs returns a copy of the given network interface specs where subnet and
+// security group references given by filters are replaced by references by ID.
+func (s *Service) resolveNetworkInterfaceSpecs(specs []infrav1.NetworkInterfaceSpec) ([]infrav1.NetworkInterfaceSpec, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	resolved := make([]infrav1.NetworkInterfaceSpec, 0, len(specs))
+	for _, spec := range specs {
+		spec := *spec.DeepCopy()
+
+		if spec.Subnet != nil && spec.Subnet.ID == nil {
+			criteria := []*ec2.Filter{
+				filter.EC2.SubnetStates(ec2.SubnetStatePending, ec2.SubnetStateAvailable),
+				filter.EC2.VPC(s.scope.VPC().ID),
+			}
+			for _, f := range spec.Subnet.Filters {
+				criteria = append(criteria, &ec2.Filter{Name: aws.String(f.Name), Values: aws.StringSlice(f.Values)})
+			}
+			subnets, err := s.getFilteredSubnets(criteria...)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to filter subnets for criteria %q", criteria)
+			}
+			if len(subnets) == 0 {
+				return nil, awserrors.NewFailedDependency(
+					fmt.Sprintf("no subnets available for network interface matching filters %q", spec.Subnet.Filters),
+				)
+			}
+			spec.Subnet = &infrav1.AWSResourceReference{ID: subnets[0].SubnetId}
+		}
+
+		for j, sg := range spec.SecurityGroups {
+			if sg.ID != nil {
+				continue
+			}
+			id, err := s.GetFilteredSecurityGroupID(sg)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get security group for network interface")
+			}
+			spec.SecurityGroups[j] = infrav1.AWSResourceReference{ID: aws.String(id)}
+		}
+
+		resolved = append(resolved, spec)
+	}
+
+	return resolved, nil
+}
+
+// getInstanceNetworkInterfaceSpecifications returns the network interfaces to create with the instance:
+// the primary one in the instance subnet, followed by the additional network interfaces.
+func getInstanceNetworkInterfaceSpecifications(i *infrav1.Instance) []*ec2.InstanceNetworkInterfaceSpecification {
+	netInterfaces := []*ec2.InstanceNetworkInterfaceSpecification{
+		{
+			DeviceIndex:         aws.Int64(0),
+			SubnetId:            aws.String(i.SubnetID),
+			Groups:              aws.StringSlice(i.SecurityGroupIDs),
+			DeleteOnTermination: aws.Bool(true),
+		},
+	}
+
+	for index, spec := range i.AdditionalNetworkInterfaces {
+		netInterface := &ec2.InstanceNetworkInterfaceSpecification{
+			DeviceIndex:         aws.Int64(int64(index + 1)),
+			SubnetId:            aws.String(i.SubnetID),
+			Groups:              aws.StringSlice(i.SecurityGroupIDs),
+			DeleteOnTermination: aws.Bool(true),
+		}
+
+		if spec.Description != "" {
+			netInterface.Description = aws.String(spec.Description)
+		}
+
+		if spec.Subnet != nil && spec.Subnet.ID != nil {
+			netInterface.SubnetId = spec.Subnet.ID
+		}
+
+		if len(spec.SecurityGroups) > 0 {
+			netInterface.Groups = make([]*string, 0, len(spec.SecurityGroups))
+			for _, sg := range spec.SecurityGroups {
+				netInterface.Groups = append(netInterface.Groups, sg.ID)
+			}
+		}
+
+		if spec.SecondaryPrivateIPAddressCount > 0 {
+			netInterface.SecondaryPrivateIpAddressCount = aws.Int64(spec.SecondaryPrivateIPAddressCount)
+		}
+
+		netInterfaces = append(netInterfaces, netInterface)
+	}
+
+	return netInterfaces
+}
+
+// ReconcileSourceDestCheck sets source/destination checking on the attached network interfaces
+// whose spec requests it, as this cannot be set when running the instance.
+func (s *Service) ReconcileSourceDestCheck(specs []infrav1.NetworkInterfaceSpec, attached []infrav1.NetworkInterfaceStatus) error {
+	for index, spec := range specs {
+		if spec.SourceDestCheck == nil {
+			continue
+		}
+
+		for j := range attached {
+			eni := &attached[j]
+			if eni.DeviceIndex != int64(index+1) || eni.SourceDestCheck == *spec.SourceDestCheck {
+				continue
+			}
+
+			input := &ec2.ModifyNetworkInterfaceAttributeInput{
+				NetworkInterfaceId: aws.String(eni.ID),
+				SourceDestCheck:    &ec2.AttributeBooleanValue{Value: spec.SourceDestCheck},
+			}
+			if _, err := s.EC2Client.ModifyNetworkInterfaceAttribute(input); err != nil {
+				return errors.Wrapf(err, "failed to set source/destination check on network interface %q", eni.ID)
+			}
+			eni.SourceDestCheck = *spec.SourceDestCheck
+		}
+	}
+
+	return nil
+}
+
+// hasExplicitSubnet returns true if any of the network interface specs references a subnet.
+func hasExplicitSubnet(specs []infrav1.NetworkInterfaceSpec) bool {
+	for _, spec := range specs {
+		if spec.Subnet != nil {
+			return true
+		}
+	}
+	return false
 }
 
 // GetInstanceSecurityGroups returns a map from ENI id to the security groups applied to that ENI
@@ -713,23 +1190,33 @@ func (s *Service) getInstanceENIs(instanceID string) ([]*ec2.NetworkInterface, e
 }
 
 func (s *Service) getImageRootDevice(imageID string) (*string, error) {
-	input := &ec2.DescribeImagesInput{
-		ImageIds: []*string{aws.String(imageID)},
-	}
-
-	output, err := s.EC2Client.DescribeImages(input)
+	image, err := s.describeImage(imageID)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(output.Images) == 0 {
-		return nil, errors.Errorf("no images returned when looking up ID %q", imageID)
+	return image.RootDeviceName, nil
+}
+
+func (s *Service) getImageSnapshotSize(imageID string) (*int64, error) {
+	image, err := s.describeImage(imageID)
+	if err != nil {
+		return nil, err
 	}
 
-	return output.Images[0].RootDeviceName, nil
+	return image.BlockDeviceMappings[0].Ebs.VolumeSize, nil
 }
 
-func (s *Service) getImageSnapshotSize(imageID string) (*int64, error) {
+// describeImage returns the image with the given ID, images are cached as their block device
+// mappings do not change.
+func (s *Service) describeImage(imageID string) (*ec2.Image, error) {
+	key, cacheable := s.lookupCacheKey(imageLookupCache, imageID)
+	if cacheable {
+		if image, ok := lookups.get(imageLookupCache, s.scope.Region(), key); ok {
+			return image.(*ec2.Image), nil
+		}
+	}
+
 	input := &ec2.DescribeImagesInput{
 		ImageIds: []*string{aws.String(imageID)},
 	}
@@ -743,7 +1230,10 @@ func (s *Service) getImageSnapshotSize(imageID string) (*int64, error) {
 		return nil, errors.Errorf("no images returned when looking up ID %q", imageID)
 	}
 
-	return output.Images[0].BlockDeviceMappings[0].Ebs.VolumeSize, nil
+	if cacheable {
+		lookups.set(key, output.Images[0])
+	}
+	return output.Images[0], nil
 }
 
 // SDKToInstance converts an AWS EC2 SDK instance to the CAPA instance type.
@@ -786,9 +1276,68 @@ func (s *Service) SDKToInstance(v *ec2.Instance) (*infrav1.Instance, error) {
 
 	i.AvailabilityZone = aws.StringValue(v.Placement.AvailabilityZone)
 
+	i.Tenancy, i.HostPlacement = sdkToPlacement(v.Placement)
+
+	i.CapacityReservationTarget = sdkToCapacityReservationTarget(v.CapacityReservationSpecification)
+
+	i.LaunchTemplate = sdkToLaunchTemplateReference(i.Tags)
+
+	i.AttachedNetworkInterfaces = sdkToNetworkInterfaceStatuses(v.NetworkInterfaces)
+
+	i.AttachedVolumes = sdkToVolumeAttachmentStatuses(v.BlockDeviceMappings, aws.StringValue(v.RootDeviceName))
+
 	return i, nil
 }
 
+func sdkToVolumeAttachmentStatuses(mappings []*ec2.InstanceBlockDeviceMapping, rootDeviceName string) []infrav1.VolumeAttachmentStatus {
+	var statuses []infrav1.VolumeAttachmentStatus
+	for _, mapping := range mappings {
+		if mapping.Ebs == nil || mapping.Ebs.VolumeId == nil {
+			continue
+		}
+
+		deviceName := aws.StringValue(mapping.DeviceName)
+		statuses = append(statuses, infrav1.VolumeAttachmentStatus{
+			ID:         aws.StringValue(mapping.Ebs.VolumeId),
+			DeviceName: deviceName,
+			Root:       deviceName == rootDeviceName,
+		})
+	}
+
+	return statuses
+}
+
+func sdkToNetworkInterfaceStatuses(enis []*ec2.InstanceNetworkInterface) []infrav1.NetworkInterfaceStatus {
+	statuses := []infrav1.NetworkInterfaceStatus{}
+	for _, eni := range enis {
+		if eni.Attachment == nil {
+			continue
+		}
+
+		status := infrav1.NetworkInterfaceStatus{
+			ID:              aws.StringValue(eni.NetworkInterfaceId),
+			DeviceIndex:     aws.Int64Value(eni.Attachment.DeviceIndex),
+			SubnetID:        aws.StringValue(eni.SubnetId),
+			SourceDestCheck: aws.BoolValue(eni.SourceDestCheck),
+		}
+		for _, ip := range eni.PrivateIpAddresses {
+			status.PrivateIPs = append(status.PrivateIPs, aws.StringValue(ip.PrivateIpAddress))
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].DeviceIndex < statuses[j].DeviceIndex
+	})
+
+	return statuses
+}
+
 func (s *Service) getInstanceAddresses(instance *ec2.Instance) []clusterv1.MachineAddress {
 	addresses := []clusterv1.MachineAddress{}
 	for _, eni := range instance.NetworkInterfaces {
@@ -964,6 +1513,102 @@ func getInstanceMarketOptionsRequest(spotMarketOptions *infrav1.SpotMarketOption
 	return instanceMarketOptionsRequest
 }
 
+// getPlacement returns the placement of an instance with the given tenancy and host placement, or nil
+// if neither is set.
+func getPlacement(tenancy string, host *infrav1.HostPlacement) *ec2.Placement {
+	if tenancy == "" && host == nil {
+		return nil
+	}
+
+	placement := &ec2.Placement{}
+	if tenancy != "" {
+		placement.Tenancy = aws.String(tenancy)
+	}
+	if host != nil {
+		placement.HostId = host.ID
+		placement.HostResourceGroupArn = host.ResourceGroupARN
+		if host.Affinity != "" {
+			placement.Affinity = aws.String(string(host.Affinity))
+		}
+	}
+	return placement
+}
+
+// sdkToPlacement returns the tenancy and host placement of an instance.
+func sdkToPlacement(placement *ec2.Placement) (string, *infrav1.HostPlacement) {
+	if placement == nil {
+		return "", nil
+	}
+
+	tenancy := aws.StringValue(placement.Tenancy)
+	if tenancy != ec2.TenancyHost || placement.HostId == nil && placement.HostResourceGroupArn == nil && placement.Affinity == nil {
+		return tenancy, nil
+	}
+
+	return tenancy, &infrav1.HostPlacement{
+		ID:               placement.HostId,
+		ResourceGroupARN: placement.HostResourceGroupArn,
+		Affinity:         infrav1.HostAffinity(aws.StringValue(placement.Affinity)),
+	}
+}
+
+func getCapacityReservationSpecification(target *infrav1.CapacityReservationTarget) *ec2.CapacityReservationSpecification {
+	if target == nil {
+		// Use the EC2 default (open) preference
+		return nil
+	}
+
+	spec := &ec2.CapacityReservationSpecification{}
+
+	switch {
+	case target.ID != nil:
+		spec.SetCapacityReservationTarget(&ec2.CapacityReservationTarget{
+			CapacityReservationId: target.ID,
+		})
+	case target.ResourceGroupARN != nil:
+		spec.SetCapacityReservationTarget(&ec2.CapacityReservationTarget{
+			CapacityReservationResourceGroupArn: target.ResourceGroupARN,
+		})
+	case target.Preference != "":
+		spec.SetCapacityReservationPreference(string(target.Preference))
+	default:
+		return nil
+	}
+
+	return spec
+}
+
+func sdkToCapacityReservationTarget(spec *ec2.CapacityReservationSpecificationResponse) *infrav1.CapacityReservationTarget {
+	if spec == nil {
+		return nil
+	}
+
+	target := &infrav1.CapacityReservationTarget{}
+	if spec.CapacityReservationTarget != nil {
+		target.ID = spec.CapacityReservationTarget.CapacityReservationId
+		target.ResourceGroupARN = spec.CapacityReservationTarget.CapacityReservationResourceGroupArn
+	}
+	if target.ID == nil && target.ResourceGroupARN == nil {
+		target.Preference = infrav1.CapacityReservationPreference(aws.StringValue(spec.CapacityReservationPreference))
+	}
+
+	return target
+}
+
+// capacityReservationTargetString returns a human readable description of the targeted capacity reservation.
+func capacityReservationTargetString(target *infrav1.CapacityReservationTarget) string {
+	switch {
+	case target == nil:
+		return "(open)"
+	case target.ID != nil:
+		return fmt.Sprintf("%q", *target.ID)
+	case target.ResourceGroupARN != nil:
+		return fmt.Sprintf("group %q", *target.ResourceGroupARN)
+	default:
+		return fmt.Sprintf("(%s)", target.Preference)
+	}
+}
+
 // GetFilteredSecurityGroupID get security group ID using filters
 func (s *Service) GetFilteredSecurityGroupID(securityGroup infrav1.AWSResourceReference) (string, error) {
 	if securityGroup.Filters == nil {
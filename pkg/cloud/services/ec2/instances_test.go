@@ -260,6 +260,170 @@ func TestTerminateInstance(t *testing.T) {
 	}
 }
 
+func TestStopAndStartInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		call    func(s *Service) error
+		wantErr bool
+	}{
+		{
+			name: "stop instance",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.StopInstances(gomock.Eq(&ec2.StopInstancesInput{
+					InstanceIds: []*string{aws.String("i-exist")},
+				})).
+					Return(&ec2.StopInstancesOutput{}, nil)
+			},
+			call: func(s *Service) error {
+				return s.StopInstance("i-exist")
+			},
+		},
+		{
+			name: "start instance",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.StartInstances(gomock.Eq(&ec2.StartInstancesInput{
+					InstanceIds: []*string{aws.String("i-exist")},
+				})).
+					Return(&ec2.StartInstancesOutput{}, nil)
+			},
+			call: func(s *Service) error {
+				return s.StartInstance("i-exist")
+			},
+		},
+		{
+			name: "start instance fails",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.StartInstances(gomock.Any()).
+					Return(nil, errors.New("IncorrectInstanceState"))
+			},
+			call: func(s *Service) error {
+				return s.StartInstance("i-exist")
+			},
+			wantErr: true,
+		},
+		{
+			name: "enable termination protection",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ModifyInstanceAttribute(gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:            aws.String("i-exist"),
+					DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				})).
+					Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+			},
+			call: func(s *Service) error {
+				return s.SetTerminationProtection("i-exist", true)
+			},
+		},
+		{
+			name: "get termination protection",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceAttribute(gomock.Eq(&ec2.DescribeInstanceAttributeInput{
+					InstanceId: aws.String("i-exist"),
+					Attribute:  aws.String(ec2.InstanceAttributeNameDisableApiTermination),
+				})).
+					Return(&ec2.DescribeInstanceAttributeOutput{
+						DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+					}, nil)
+			},
+			call: func(s *Service) error {
+				protected, err := s.GetTerminationProtection("i-exist")
+				if err == nil && !protected {
+					return errors.New("expected termination protection to be enabled")
+				}
+				return err
+			},
+		},
+		{
+			name: "orphan instance",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstances(gomock.Eq(&ec2.DescribeInstancesInput{
+					InstanceIds: []*string{aws.String("i-exist")},
+				})).
+					Return(&ec2.DescribeInstancesOutput{
+						Reservations: []*ec2.Reservation{{
+							Instances: []*ec2.Instance{{
+								InstanceId: aws.String("i-exist"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{DeviceName: aws.String("/dev/sda1"), Ebs: &ec2.EbsInstanceBlockDevice{VolumeId: aws.String("vol-root")}},
+									{DeviceName: aws.String("/dev/sdb"), Ebs: &ec2.EbsInstanceBlockDevice{VolumeId: aws.String("vol-data")}},
+								},
+							}},
+						}},
+					}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+					Filters: []*ec2.Filter{
+						{Name: aws.String("instance-id"), Values: aws.StringSlice([]string{"i-exist"})},
+						{Name: aws.String("tag-key"), Values: aws.StringSlice([]string{"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"})},
+					},
+				})).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{{AllocationId: aws.String("eipalloc-machine"), InstanceId: aws.String("i-exist")}},
+					}, nil)
+				m.CreateTags(gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"i-exist", "vol-root", "vol-data", "eipalloc-machine"}),
+					Tags: []*ec2.Tag{{
+						Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/orphaned"),
+						Value: aws.String("test-cluster"),
+					}},
+				})).
+					Return(&ec2.CreateTagsOutput{}, nil)
+				m.DeleteTags(gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{"i-exist", "vol-root", "vol-data", "eipalloc-machine"}),
+					Tags: []*ec2.Tag{
+						{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster")},
+						{Key: aws.String("kubernetes.io/cluster/test-cluster")},
+					},
+				})).
+					Return(&ec2.DeleteTagsOutput{}, nil)
+			},
+			call: func(s *Service) error {
+				return s.OrphanInstance("i-exist")
+			},
+		},
+		{
+			name: "orphan instance fails if the instance does not exist",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstances(gomock.Any()).
+					Return(&ec2.DescribeInstancesOutput{}, nil)
+			},
+			call: func(s *Service) error {
+				return s.OrphanInstance("i-exist")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = tc.call(s)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got error: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestCreateInstance(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -301,8 +465,10 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType: "m5.large",
 			},
@@ -401,8 +567,10 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType:  "m5.2xlarge",
 				FailureDomain: aws.String("us-east-1c"),
@@ -559,7 +727,20 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
-				amiName, err := amiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1")
+				m.
+					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: aws.StringSlice([]string{"m5.large"}),
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: aws.StringSlice([]string{"x86_64"}),
+								},
+							},
+						},
+					}, nil)
+				amiName, err := amiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1", ArchitectureX86_64)
 				if err != nil {
 					t.Fatalf("Failed to process ami format: %v", err)
 				}
@@ -688,7 +869,20 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
-				amiName, err := amiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1")
+				m.
+					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: aws.StringSlice([]string{"m5.large"}),
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: aws.StringSlice([]string{"x86_64"}),
+								},
+							},
+						},
+					}, nil)
+				amiName, err := amiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1", ArchitectureX86_64)
 				if err != nil {
 					t.Fatalf("Failed to process ami format: %v", err)
 				}
@@ -818,7 +1012,20 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
-				amiName, err := amiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1")
+				m.
+					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: aws.StringSlice([]string{"m5.large"}),
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: aws.StringSlice([]string{"x86_64"}),
+								},
+							},
+						},
+					}, nil)
+				amiName, err := amiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1", ArchitectureX86_64)
 				if err != nil {
 					t.Fatalf("Failed to process ami format: %v", err)
 				}
@@ -909,8 +1116,10 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType: "m5.large",
 				Subnet: &infrav1.AWSResourceReference{
@@ -1024,8 +1233,10 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType: "m5.large",
 				Subnet: &infrav1.AWSResourceReference{
@@ -1090,8 +1301,10 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType: "m5.large",
 				NonRootVolumes: []*infrav1.Volume{{
@@ -1202,8 +1415,10 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType: "m5.large",
 				Tenancy:      "dedicated",
@@ -1331,10 +1546,12 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "expect the default SSH key when none is provided",
+			name: "with a launch template",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"set": "node"},
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
 				},
 				Spec: clusterv1.MachineSpec{
 					Bootstrap: clusterv1.Bootstrap{
@@ -1343,10 +1560,10 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				LaunchTemplate: &infrav1.LaunchTemplateReference{
+					Name:    aws.String("node-template"),
+					Version: aws.String("3"),
 				},
-				InstanceType: "m5.large",
 			},
 			awsCluster: &infrav1.AWSCluster{
 				Spec: infrav1.AWSClusterSpec{
@@ -1356,9 +1573,6 @@ func TestCreateInstance(t *testing.T) {
 								ID:       "subnet-1",
 								IsPublic: false,
 							},
-							&infrav1.SubnetSpec{
-								IsPublic: false,
-							},
 						},
 					},
 				},
@@ -1383,67 +1597,108 @@ func TestCreateInstance(t *testing.T) {
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
 				m.
-					DescribeImages(gomock.Any()).
-					Return(&ec2.DescribeImagesOutput{
-						Images: []*ec2.Image{
+					DescribeLaunchTemplateVersions(gomock.Eq(&ec2.DescribeLaunchTemplateVersionsInput{
+						LaunchTemplateName: aws.String("node-template"),
+						Versions:           aws.StringSlice([]string{"3"}),
+					})).
+					Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+						LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
 							{
-								Name: aws.String("ami-1"),
+								LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+									ImageId:      aws.String("ami-template"),
+									InstanceType: aws.String("m5.xlarge"),
+								},
 							},
 						},
 					}, nil)
-				m. // TODO: Restore these parameters, but with the tags as well
-					RunInstances(gomock.Any()).
-					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
-						if input.KeyName == nil {
-							t.Fatal("Expected key name not to be nil")
-						}
-						if *input.KeyName != defaultSSHKeyName {
-							t.Fatalf("Expected SSH key name to be '%s', not '%s'", defaultSSHKeyName, *input.KeyName)
-						}
-						return &ec2.Reservation{
-							Instances: []*ec2.Instance{
-								{
-									State: &ec2.InstanceState{
-										Name: aws.String(ec2.InstanceStateNamePending),
+				m.
+					RunInstances(gomock.Eq(&ec2.RunInstancesInput{
+						ImageId:      aws.String("ami-template"),
+						InstanceType: aws.String("m5.xlarge"),
+						LaunchTemplate: &ec2.LaunchTemplateSpecification{
+							LaunchTemplateName: aws.String("node-template"),
+							Version:            aws.String("3"),
+						},
+						MaxCount:         aws.Int64(1),
+						MinCount:         aws.Int64(1),
+						SecurityGroupIds: []*string{aws.String("2"), aws.String("3")},
+						SubnetId:         aws.String("subnet-1"),
+						TagSpecifications: []*ec2.TagSpecification{
+							{
+								ResourceType: aws.String("instance"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
 									},
-									IamInstanceProfile: &ec2.IamInstanceProfile{
-										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
 									},
-									InstanceId:     aws.String("two"),
-									InstanceType:   aws.String("m5.large"),
-									SubnetId:       aws.String("subnet-1"),
-									ImageId:        aws.String("ami-1"),
-									RootDeviceName: aws.String("device-1"),
-									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
-										{
-											DeviceName: aws.String("device-1"),
-											Ebs: &ec2.EbsInstanceBlockDevice{
-												VolumeId: aws.String("volume-1"),
-											},
-										},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
 									},
-									Placement: &ec2.Placement{
-										AvailabilityZone: &az,
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
 									},
 								},
 							},
-						}, nil
-					})
+						},
+						UserData: aws.String(base64.StdEncoding.EncodeToString(userData)),
+					})).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								InstanceId:   aws.String("two"),
+								InstanceType: aws.String("m5.xlarge"),
+								SubnetId:     aws.String("subnet-1"),
+								ImageId:      aws.String("ami-template"),
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("aws:ec2launchtemplate:id"),
+										Value: aws.String("lt-1"),
+									},
+									{
+										Key:   aws.String("aws:ec2launchtemplate:version"),
+										Value: aws.String("3"),
+									},
+								},
+							},
+						},
+					}, nil)
 				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
-
 			},
 			check: func(instance *infrav1.Instance, err error) {
 				if err != nil {
 					t.Fatalf("did not expect error: %v", err)
 				}
+
+				expected := &infrav1.LaunchTemplateReference{ID: aws.String("lt-1"), Version: aws.String("3")}
+				if !reflect.DeepEqual(instance.LaunchTemplate, expected) {
+					t.Fatalf("expected launch template %v, got %v", expected, instance.LaunchTemplate)
+				}
 			},
 		},
 		{
-			name: "expect to use the cluster level ssh key name when no machine key name is provided",
+			name: "with a launch template that sets no instance type",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"set": "node"},
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
 				},
 				Spec: clusterv1.MachineSpec{
 					Bootstrap: clusterv1.Bootstrap{
@@ -1452,10 +1707,10 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				LaunchTemplate: &infrav1.LaunchTemplateReference{
+					Name:    aws.String("node-template"),
+					Version: aws.String("3"),
 				},
-				InstanceType: "m5.large",
 			},
 			awsCluster: &infrav1.AWSCluster{
 				Spec: infrav1.AWSClusterSpec{
@@ -1465,19 +1720,12 @@ func TestCreateInstance(t *testing.T) {
 								ID:       "subnet-1",
 								IsPublic: false,
 							},
-							&infrav1.SubnetSpec{
-								IsPublic: false,
-							},
 						},
 					},
-					SSHKeyName: aws.String("specific-cluster-key-name"),
 				},
 				Status: infrav1.AWSClusterStatus{
 					Network: infrav1.Network{
 						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
-							infrav1.SecurityGroupControlPlane: {
-								ID: "1",
-							},
 							infrav1.SecurityGroupNode: {
 								ID: "2",
 							},
@@ -1493,64 +1741,259 @@ func TestCreateInstance(t *testing.T) {
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
 				m.
-					DescribeImages(gomock.Any()).
-					Return(&ec2.DescribeImagesOutput{
-						Images: []*ec2.Image{
+					DescribeLaunchTemplateVersions(gomock.Any()).
+					Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+						LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
 							{
-								Name: aws.String("ami-1"),
+								LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+									ImageId: aws.String("ami-template"),
+								},
 							},
 						},
 					}, nil)
-				m. // TODO: Restore these parameters, but with the tags as well
-					RunInstances(gomock.Any()).
-					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
-						if input.KeyName == nil {
-							t.Fatal("Expected key name not to be nil")
-						}
-						if *input.KeyName != "specific-cluster-key-name" {
-							t.Fatalf("Expected SSH key name to be '%s', not '%s'", "specific-cluster-key-name", *input.KeyName)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.InstanceType != nil {
+							t.Fatalf("expected the instance type to be left to the launch template, got %q", aws.StringValue(input.InstanceType))
 						}
-						return &ec2.Reservation{
-							Instances: []*ec2.Instance{
-								{
-									State: &ec2.InstanceState{
-										Name: aws.String(ec2.InstanceStateNamePending),
-									},
-									IamInstanceProfile: &ec2.IamInstanceProfile{
-										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
-									},
-									InstanceId:     aws.String("two"),
-									InstanceType:   aws.String("m5.large"),
-									SubnetId:       aws.String("subnet-1"),
-									ImageId:        aws.String("ami-1"),
-									RootDeviceName: aws.String("device-1"),
-									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
-										{
-											DeviceName: aws.String("device-1"),
-											Ebs: &ec2.EbsInstanceBlockDevice{
-												VolumeId: aws.String("volume-1"),
-											},
-										},
-									},
-									Placement: &ec2.Placement{
-										AvailabilityZone: &az,
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								InstanceId:   aws.String("two"),
+								InstanceType: aws.String("m5.large"),
+								SubnetId:     aws.String("subnet-1"),
+								ImageId:      aws.String("ami-template"),
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with a launch template that defines network interfaces",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				LaunchTemplate: &infrav1.LaunchTemplateReference{
+					Name:    aws.String("node-template"),
+					Version: aws.String("3"),
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					DescribeLaunchTemplateVersions(gomock.Any()).
+					Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+						LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+							{
+								LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+									ImageId:      aws.String("ami-template"),
+									InstanceType: aws.String("m5.xlarge"),
+									NetworkInterfaces: []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecification{
+										{DeviceIndex: aws.Int64(0), SubnetId: aws.String("subnet-template")},
 									},
 								},
 							},
-						}, nil
-					})
+						},
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil || !strings.Contains(err.Error(), "defines network interfaces") {
+					t.Fatalf("expected an error about the network interfaces of the launch template, got %v", err)
+				}
+			},
+		},
+		{
+			name: "with a fleet",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
+				},
+				InstanceType:      "m5.large",
+				SpotMarketOptions: &infrav1.SpotMarketOptions{},
+				Fleet: &infrav1.FleetOptions{
+					InstanceTypes: []string{"m5a.large", "m5.large"},
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:               "subnet-1",
+								AvailabilityZone: "us-east-1a",
+								IsPublic:         false,
+							},
+							&infrav1.SubnetSpec{
+								ID:               "subnet-2",
+								AvailabilityZone: "us-east-1b",
+								IsPublic:         false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypes(gomock.Any()).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{ProcessorInfo: &ec2.ProcessorInfo{SupportedArchitectures: aws.StringSlice([]string{"x86_64"})}},
+						},
+					}, nil).
+					Times(2)
+				m.
+					CreateLaunchTemplate(gomock.Any()).
+					Do(func(input *ec2.CreateLaunchTemplateInput) {
+						data := input.LaunchTemplateData
+						if aws.StringValue(data.ImageId) != "abc" ||
+							!reflect.DeepEqual(aws.StringValueSlice(data.SecurityGroupIds), []string{"2", "3"}) ||
+							data.UserData == nil {
+							t.Fatalf("unexpected launch template data: %v", data)
+						}
+					}).
+					Return(&ec2.CreateLaunchTemplateOutput{
+						LaunchTemplate: &ec2.LaunchTemplate{LaunchTemplateId: aws.String("lt-fleet")},
+					}, nil)
+				m.
+					CreateFleet(gomock.Any()).
+					Do(func(input *ec2.CreateFleetInput) {
+						overrides := []string{}
+						for _, override := range input.LaunchTemplateConfigs[0].Overrides {
+							overrides = append(overrides, aws.StringValue(override.SubnetId)+"/"+aws.StringValue(override.InstanceType))
+						}
+						expected := []string{"subnet-1/m5.large", "subnet-1/m5a.large", "subnet-2/m5.large", "subnet-2/m5a.large"}
+						if aws.StringValue(input.Type) != "instant" ||
+							aws.StringValue(input.SpotOptions.AllocationStrategy) != "capacity-optimized" ||
+							aws.StringValue(input.LaunchTemplateConfigs[0].LaunchTemplateSpecification.LaunchTemplateId) != "lt-fleet" ||
+							!reflect.DeepEqual(overrides, expected) {
+							t.Fatalf("unexpected fleet input: %v", input)
+						}
+					}).
+					Return(&ec2.CreateFleetOutput{
+						FleetId: aws.String("fleet-1"),
+						Instances: []*ec2.CreateFleetInstance{
+							{InstanceIds: aws.StringSlice([]string{"i-fleet"})},
+						},
+					}, nil)
 				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
-
+				m.
+					DescribeInstances(gomock.Any()).
+					Return(&ec2.DescribeInstancesOutput{
+						Reservations: []*ec2.Reservation{{
+							Instances: []*ec2.Instance{{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								InstanceId:   aws.String("i-fleet"),
+								InstanceType: aws.String("m5a.large"),
+								SubnetId:     aws.String("subnet-2"),
+								ImageId:      aws.String("abc"),
+								Placement: &ec2.Placement{
+									AvailabilityZone: aws.String("us-east-1b"),
+								},
+							}},
+						}},
+					}, nil)
+				m.
+					DeleteLaunchTemplate(gomock.Eq(&ec2.DeleteLaunchTemplateInput{LaunchTemplateId: aws.String("lt-fleet")})).
+					Return(&ec2.DeleteLaunchTemplateOutput{}, nil)
 			},
 			check: func(instance *infrav1.Instance, err error) {
 				if err != nil {
 					t.Fatalf("did not expect error: %v", err)
 				}
+
+				if instance.Type != "m5a.large" || instance.AvailabilityZone != "us-east-1b" {
+					t.Fatalf("expected a m5a.large instance in us-east-1b, got a %s instance in %s", instance.Type, instance.AvailabilityZone)
+				}
 			},
 		},
 		{
-			name: "expect to use the machine level ssh key name when both cluster and machine key names are provided",
+			name: "expect the default SSH key when none is provided",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{"set": "node"},
@@ -1562,11 +2005,12 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType: "m5.large",
-				SSHKeyName:   aws.String("specific-machine-ssh-key-name"),
 			},
 			awsCluster: &infrav1.AWSCluster{
 				Spec: infrav1.AWSClusterSpec{
@@ -1581,7 +2025,6 @@ func TestCreateInstance(t *testing.T) {
 							},
 						},
 					},
-					SSHKeyName: aws.String("specific-cluster-key-name"),
 				},
 				Status: infrav1.AWSClusterStatus{
 					Network: infrav1.Network{
@@ -1618,8 +2061,8 @@ func TestCreateInstance(t *testing.T) {
 						if input.KeyName == nil {
 							t.Fatal("Expected key name not to be nil")
 						}
-						if *input.KeyName != "specific-machine-ssh-key-name" {
-							t.Fatalf("Expected SSH key name to be '%s', not '%s'", "specific-machine-ssh-key-name", *input.KeyName)
+						if *input.KeyName != defaultSSHKeyName {
+							t.Fatalf("Expected SSH key name to be '%s', not '%s'", defaultSSHKeyName, *input.KeyName)
 						}
 						return &ec2.Reservation{
 							Instances: []*ec2.Instance{
@@ -1661,7 +2104,7 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "expect ssh key to be unset when cluster key name is empty string and machine key name is nil",
+			name: "expect to use the cluster level ssh key name when no machine key name is provided",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{"set": "node"},
@@ -1673,11 +2116,12 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType: "m5.large",
-				SSHKeyName:   nil,
 			},
 			awsCluster: &infrav1.AWSCluster{
 				Spec: infrav1.AWSClusterSpec{
@@ -1692,7 +2136,7 @@ func TestCreateInstance(t *testing.T) {
 							},
 						},
 					},
-					SSHKeyName: aws.String(""),
+					SSHKeyName: aws.String("specific-cluster-key-name"),
 				},
 				Status: infrav1.AWSClusterStatus{
 					Network: infrav1.Network{
@@ -1726,8 +2170,11 @@ func TestCreateInstance(t *testing.T) {
 				m. // TODO: Restore these parameters, but with the tags as well
 					RunInstances(gomock.Any()).
 					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
-						if input.KeyName != nil {
-							t.Fatalf("Expected key name to be nil/unspecified, not '%s'", *input.KeyName)
+						if input.KeyName == nil {
+							t.Fatal("Expected key name not to be nil")
+						}
+						if *input.KeyName != "specific-cluster-key-name" {
+							t.Fatalf("Expected SSH key name to be '%s', not '%s'", "specific-cluster-key-name", *input.KeyName)
 						}
 						return &ec2.Reservation{
 							Instances: []*ec2.Instance{
@@ -1769,7 +2216,7 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "expect ssh key to be unset when cluster key name is empty string and machine key name is empty string",
+			name: "expect to use the machine level ssh key name when both cluster and machine key names are provided",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{"set": "node"},
@@ -1781,11 +2228,13 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType: "m5.large",
-				SSHKeyName:   aws.String(""),
+				SSHKeyName:   aws.String("specific-machine-ssh-key-name"),
 			},
 			awsCluster: &infrav1.AWSCluster{
 				Spec: infrav1.AWSClusterSpec{
@@ -1800,7 +2249,7 @@ func TestCreateInstance(t *testing.T) {
 							},
 						},
 					},
-					SSHKeyName: aws.String(""),
+					SSHKeyName: aws.String("specific-cluster-key-name"),
 				},
 				Status: infrav1.AWSClusterStatus{
 					Network: infrav1.Network{
@@ -1834,8 +2283,11 @@ func TestCreateInstance(t *testing.T) {
 				m. // TODO: Restore these parameters, but with the tags as well
 					RunInstances(gomock.Any()).
 					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
-						if input.KeyName != nil {
-							t.Fatalf("Expected key name to be nil/unspecified, not '%s'", *input.KeyName)
+						if input.KeyName == nil {
+							t.Fatal("Expected key name not to be nil")
+						}
+						if *input.KeyName != "specific-machine-ssh-key-name" {
+							t.Fatalf("Expected SSH key name to be '%s', not '%s'", "specific-machine-ssh-key-name", *input.KeyName)
 						}
 						return &ec2.Reservation{
 							Instances: []*ec2.Instance{
@@ -1877,7 +2329,7 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "expect ssh key to be unset when cluster key name is nil and machine key name is empty string",
+			name: "expect ssh key to be unset when cluster key name is empty string and machine key name is nil",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{"set": "node"},
@@ -1889,11 +2341,13 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("abc"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
 				},
 				InstanceType: "m5.large",
-				SSHKeyName:   aws.String(""),
+				SSHKeyName:   nil,
 			},
 			awsCluster: &infrav1.AWSCluster{
 				Spec: infrav1.AWSClusterSpec{
@@ -1908,7 +2362,7 @@ func TestCreateInstance(t *testing.T) {
 							},
 						},
 					},
-					SSHKeyName: nil,
+					SSHKeyName: aws.String(""),
 				},
 				Status: infrav1.AWSClusterStatus{
 					Network: infrav1.Network{
@@ -1984,55 +2438,810 @@ func TestCreateInstance(t *testing.T) {
 				}
 			},
 		},
-	}
-
-	for _, tc := range testcases {
-		t.Run(tc.name, func(t *testing.T) {
-			mockCtrl := gomock.NewController(t)
-			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
-
-			scheme, err := setupScheme()
-			if err != nil {
-				t.Fatalf("failed to create scheme: %v", err)
-			}
-
-			cluster := &clusterv1.Cluster{
+		{
+			name: "expect ssh key to be unset with EC2 Instance Connect ssh access when machine key name is nil",
+			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "test1",
+					Labels: map[string]string{"set": "node"},
 				},
-				Spec: clusterv1.ClusterSpec{
-					ClusterNetwork: &clusterv1.ClusterNetwork{
-						ServiceDomain: "cluster.local",
-						Services: &clusterv1.NetworkRanges{
-							CIDRBlocks: []string{"192.168.0.0/16"},
-						},
-						Pods: &clusterv1.NetworkRanges{
-							CIDRBlocks: []string{"192.168.0.0/16"},
-						},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
 					},
 				},
-			}
-
-			machine := &tc.machine
-
-			awsMachine := &infrav1.AWSMachine{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "aws-test1",
-					OwnerReferences: []metav1.OwnerReference{
-						{
-							APIVersion: clusterv1.GroupVersion.String(),
-							Kind:       "Machine",
-							Name:       "test1",
-						},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
 					},
 				},
-			}
-
-			client := fake.NewFakeClientWithScheme(scheme, secret, cluster, machine)
-
-			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
-				Client:     client,
-				Cluster:    cluster,
+				InstanceType: "m5.large",
+				SSHKeyName:   nil,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+					SSHAccess: infrav1.SSHAccessModeInstanceConnect,
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstances(gomock.Any()).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						if input.KeyName != nil {
+							t.Fatalf("Expected key name to be nil/unspecified, not '%s'", *input.KeyName)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:     aws.String("two"),
+									InstanceType:   aws.String("m5.large"),
+									SubnetId:       aws.String("subnet-1"),
+									ImageId:        aws.String("ami-1"),
+									RootDeviceName: aws.String("device-1"),
+									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+										{
+											DeviceName: aws.String("device-1"),
+											Ebs: &ec2.EbsInstanceBlockDevice{
+												VolumeId: aws.String("volume-1"),
+											},
+										},
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "expect ssh key to be unset when cluster key name is empty string and machine key name is empty string",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
+				},
+				InstanceType: "m5.large",
+				SSHKeyName:   aws.String(""),
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+					SSHKeyName: aws.String(""),
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstances(gomock.Any()).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						if input.KeyName != nil {
+							t.Fatalf("Expected key name to be nil/unspecified, not '%s'", *input.KeyName)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:     aws.String("two"),
+									InstanceType:   aws.String("m5.large"),
+									SubnetId:       aws.String("subnet-1"),
+									ImageId:        aws.String("ami-1"),
+									RootDeviceName: aws.String("device-1"),
+									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+										{
+											DeviceName: aws.String("device-1"),
+											Ebs: &ec2.EbsInstanceBlockDevice{
+												VolumeId: aws.String("volume-1"),
+											},
+										},
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "expect ssh key to be unset when cluster key name is nil and machine key name is empty string",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
+				},
+				InstanceType: "m5.large",
+				SSHKeyName:   aws.String(""),
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+					SSHKeyName: nil,
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstances(gomock.Any()).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						if input.KeyName != nil {
+							t.Fatalf("Expected key name to be nil/unspecified, not '%s'", *input.KeyName)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:     aws.String("two"),
+									InstanceType:   aws.String("m5.large"),
+									SubnetId:       aws.String("subnet-1"),
+									ImageId:        aws.String("ami-1"),
+									RootDeviceName: aws.String("device-1"),
+									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+										{
+											DeviceName: aws.String("device-1"),
+											Ebs: &ec2.EbsInstanceBlockDevice{
+												VolumeId: aws.String("volume-1"),
+											},
+										},
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with fallback instance types and failure domains on insufficient capacity",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
+				},
+				InstanceType:                  "m5.large",
+				FallbackInstanceTypes:         []string{"m5a.large"},
+				FallbackToOtherFailureDomains: true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:               "subnet-1",
+								AvailabilityZone: "us-east-1a",
+								IsPublic:         false,
+							},
+							&infrav1.SubnetSpec{
+								ID:               "subnet-2",
+								AvailabilityZone: "us-east-1a",
+								IsPublic:         false,
+							},
+							&infrav1.SubnetSpec{
+								ID:               "subnet-3",
+								AvailabilityZone: "us-east-1b",
+								IsPublic:         false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				attempts := []string{}
+				m.
+					RunInstances(gomock.Any()).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						attempts = append(attempts, aws.StringValue(input.InstanceType)+"/"+aws.StringValue(input.SubnetId))
+						if aws.StringValue(input.SubnetId) != "subnet-3" || aws.StringValue(input.InstanceType) != "m5a.large" {
+							return nil, awserr.New(awserrors.InsufficientInstanceCapacity, "no capacity", nil)
+						}
+
+						expected := []string{"m5.large/subnet-1", "m5a.large/subnet-1", "m5.large/subnet-3", "m5a.large/subnet-3"}
+						if !reflect.DeepEqual(attempts, expected) {
+							t.Fatalf("unexpected attempts, got %v, expected %v", attempts, expected)
+						}
+
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: input.InstanceType,
+									SubnetId:     input.SubnetId,
+									ImageId:      aws.String("abc"),
+									Placement: &ec2.Placement{
+										AvailabilityZone: aws.String("us-east-1b"),
+									},
+								},
+							},
+						}, nil
+					}).
+					Times(4)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if instance.Type != "m5a.large" {
+					t.Fatalf("expected fallback instance type m5a.large, got %q", instance.Type)
+				}
+				if instance.SubnetID != "subnet-3" {
+					t.Fatalf("expected fallback subnet subnet-3, got %q", instance.SubnetID)
+				}
+			},
+		},
+		{
+			name: "with fallback instance types exhausted",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
+				},
+				InstanceType:          "m5.large",
+				FallbackInstanceTypes: []string{"m5a.large"},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:               "subnet-1",
+								AvailabilityZone: "us-east-1a",
+								IsPublic:         false,
+							},
+							&infrav1.SubnetSpec{
+								ID:               "subnet-3",
+								AvailabilityZone: "us-east-1b",
+								IsPublic:         false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					RunInstances(gomock.Any()).
+					Return(nil, awserr.New(awserrors.InsufficientInstanceCapacity, "no capacity", nil)).
+					Times(2)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if !awserrors.IsInsufficientInstanceCapacity(errors.Cause(err)) {
+					t.Fatalf("expected insufficient capacity error, got: %v", err)
+				}
+			},
+		},
+		{
+			name: "with fallback failure domains and a failure domain set",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+					FailureDomain: aws.String("us-east-1a"),
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
+				},
+				InstanceType:                  "m5.large",
+				FallbackInstanceTypes:         []string{"m5a.large"},
+				FallbackToOtherFailureDomains: true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:               "subnet-1",
+								AvailabilityZone: "us-east-1a",
+								IsPublic:         false,
+							},
+							&infrav1.SubnetSpec{
+								ID:               "subnet-3",
+								AvailabilityZone: "us-east-1b",
+								IsPublic:         false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					RunInstances(gomock.Any()).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						if aws.StringValue(input.SubnetId) != "subnet-1" {
+							t.Fatalf("expected the instance to stay in subnet-1 of the failure domain, got %q", aws.StringValue(input.SubnetId))
+						}
+						return nil, awserr.New(awserrors.InsufficientInstanceCapacity, "no capacity", nil)
+					}).
+					Times(2)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if !awserrors.IsInsufficientInstanceCapacity(errors.Cause(err)) {
+					t.Fatalf("expected insufficient capacity error, got: %v", err)
+				}
+			},
+		},
+		{
+			name: "with additional network interfaces",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("abc"),
+					},
+				},
+				InstanceType: "m5.large",
+				AdditionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{
+					{
+						Description: "storage",
+						Subnet: &infrav1.AWSResourceReference{
+							ID: aws.String("subnet-storage"),
+						},
+						SecurityGroups: []infrav1.AWSResourceReference{
+							{ID: aws.String("sg-storage")},
+						},
+						SecondaryPrivateIPAddressCount: 2,
+						SourceDestCheck:                aws.Bool(false),
+					},
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					RunInstances(gomock.Any()).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						if input.SubnetId != nil || input.SecurityGroupIds != nil {
+							t.Fatalf("expected subnet and security groups to be set on the network interfaces only")
+						}
+
+						expected := []*ec2.InstanceNetworkInterfaceSpecification{
+							{
+								DeviceIndex:         aws.Int64(0),
+								SubnetId:            aws.String("subnet-1"),
+								Groups:              aws.StringSlice([]string{"2", "3"}),
+								DeleteOnTermination: aws.Bool(true),
+							},
+							{
+								DeviceIndex:                    aws.Int64(1),
+								Description:                    aws.String("storage"),
+								SubnetId:                       aws.String("subnet-storage"),
+								Groups:                         aws.StringSlice([]string{"sg-storage"}),
+								SecondaryPrivateIpAddressCount: aws.Int64(2),
+								DeleteOnTermination:            aws.Bool(true),
+							},
+						}
+						if !reflect.DeepEqual(input.NetworkInterfaces, expected) {
+							t.Fatalf("unexpected network interfaces, got %v, expected %v", input.NetworkInterfaces, expected)
+						}
+
+						var tagged bool
+						for _, spec := range input.TagSpecifications {
+							if aws.StringValue(spec.ResourceType) == ec2.ResourceTypeNetworkInterface {
+								tagged = true
+							}
+						}
+						if !tagged {
+							t.Fatalf("expected network interfaces to be tagged")
+						}
+
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									InstanceId:   aws.String("two"),
+									InstanceType: aws.String("m5.large"),
+									SubnetId:     aws.String("subnet-1"),
+									ImageId:      aws.String("abc"),
+									NetworkInterfaces: []*ec2.InstanceNetworkInterface{
+										{
+											NetworkInterfaceId: aws.String("eni-storage"),
+											SubnetId:           aws.String("subnet-storage"),
+											SourceDestCheck:    aws.Bool(true),
+											Attachment: &ec2.InstanceNetworkInterfaceAttachment{
+												DeviceIndex: aws.Int64(1),
+											},
+										},
+										{
+											NetworkInterfaceId: aws.String("eni-primary"),
+											SubnetId:           aws.String("subnet-1"),
+											SourceDestCheck:    aws.Bool(true),
+											Attachment: &ec2.InstanceNetworkInterfaceAttachment{
+												DeviceIndex: aws.Int64(0),
+											},
+										},
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.
+					ModifyNetworkInterfaceAttribute(gomock.Eq(&ec2.ModifyNetworkInterfaceAttributeInput{
+						NetworkInterfaceId: aws.String("eni-storage"),
+						SourceDestCheck:    &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+					})).
+					Return(&ec2.ModifyNetworkInterfaceAttributeOutput{}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+
+				expected := []infrav1.NetworkInterfaceStatus{
+					{
+						ID:              "eni-primary",
+						DeviceIndex:     0,
+						SubnetID:        "subnet-1",
+						SourceDestCheck: true,
+					},
+					{
+						ID:              "eni-storage",
+						DeviceIndex:     1,
+						SubnetID:        "subnet-storage",
+						SourceDestCheck: false,
+					},
+				}
+				if !reflect.DeepEqual(instance.AttachedNetworkInterfaces, expected) {
+					t.Fatalf("unexpected attached network interfaces, got %v, expected %v", instance.AttachedNetworkInterfaces, expected)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			if err != nil {
+				t.Fatalf("failed to create scheme: %v", err)
+			}
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test1",
+				},
+				Spec: clusterv1.ClusterSpec{
+					ClusterNetwork: &clusterv1.ClusterNetwork{
+						ServiceDomain: "cluster.local",
+						Services: &clusterv1.NetworkRanges{
+							CIDRBlocks: []string{"192.168.0.0/16"},
+						},
+						Pods: &clusterv1.NetworkRanges{
+							CIDRBlocks: []string{"192.168.0.0/16"},
+						},
+					},
+				},
+			}
+
+			machine := &tc.machine
+
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "aws-test1",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "Machine",
+							Name:       "test1",
+						},
+					},
+				},
+			}
+
+			client := fake.NewFakeClientWithScheme(scheme, secret, cluster, machine)
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    cluster,
 				AWSCluster: tc.awsCluster,
 			})
 			if err != nil {
@@ -2269,3 +3478,264 @@ func setupScheme() (*runtime.Scheme, error) {
 	}
 	return scheme, nil
 }
+
+func TestGetCapacityReservationSpecification(t *testing.T) {
+	testCases := []struct {
+		name            string
+		target          *infrav1.CapacityReservationTarget
+		expectedRequest *ec2.CapacityReservationSpecification
+	}{
+		{
+			name:            "with no capacity reservation target specified",
+			target:          nil,
+			expectedRequest: nil,
+		},
+		{
+			name:            "with an empty capacity reservation target specified",
+			target:          &infrav1.CapacityReservationTarget{},
+			expectedRequest: nil,
+		},
+		{
+			name: "with a capacity reservation ID specified",
+			target: &infrav1.CapacityReservationTarget{
+				ID: aws.String("cr-0123456789abcdef0"),
+			},
+			expectedRequest: &ec2.CapacityReservationSpecification{
+				CapacityReservationTarget: &ec2.CapacityReservationTarget{
+					CapacityReservationId: aws.String("cr-0123456789abcdef0"),
+				},
+			},
+		},
+		{
+			name: "with a capacity reservation resource group specified",
+			target: &infrav1.CapacityReservationTarget{
+				ResourceGroupARN: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/my-group"),
+			},
+			expectedRequest: &ec2.CapacityReservationSpecification{
+				CapacityReservationTarget: &ec2.CapacityReservationTarget{
+					CapacityReservationResourceGroupArn: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/my-group"),
+				},
+			},
+		},
+		{
+			name: "with a none preference specified",
+			target: &infrav1.CapacityReservationTarget{
+				Preference: infrav1.CapacityReservationPreferenceNone,
+			},
+			expectedRequest: &ec2.CapacityReservationSpecification{
+				CapacityReservationPreference: aws.String("none"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := getCapacityReservationSpecification(tc.target)
+			if !reflect.DeepEqual(request, tc.expectedRequest) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, request, tc.expectedRequest)
+			}
+		})
+	}
+}
+
+func TestGetPlacement(t *testing.T) {
+	testCases := []struct {
+		name              string
+		tenancy           string
+		hostPlacement     *infrav1.HostPlacement
+		expectedPlacement *ec2.Placement
+	}{
+		{
+			name:              "with no tenancy or host placement specified",
+			expectedPlacement: nil,
+		},
+		{
+			name:    "with dedicated tenancy specified",
+			tenancy: "dedicated",
+			expectedPlacement: &ec2.Placement{
+				Tenancy: aws.String("dedicated"),
+			},
+		},
+		{
+			name:    "with a host ID and host affinity specified",
+			tenancy: "host",
+			hostPlacement: &infrav1.HostPlacement{
+				ID:       aws.String("h-0123456789abcdef0"),
+				Affinity: infrav1.HostAffinityHost,
+			},
+			expectedPlacement: &ec2.Placement{
+				Tenancy:  aws.String("host"),
+				HostId:   aws.String("h-0123456789abcdef0"),
+				Affinity: aws.String("host"),
+			},
+		},
+		{
+			name:    "with a host resource group specified",
+			tenancy: "host",
+			hostPlacement: &infrav1.HostPlacement{
+				ResourceGroupARN: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/my-hosts"),
+			},
+			expectedPlacement: &ec2.Placement{
+				Tenancy:              aws.String("host"),
+				HostResourceGroupArn: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/my-hosts"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			placement := getPlacement(tc.tenancy, tc.hostPlacement)
+			if !reflect.DeepEqual(placement, tc.expectedPlacement) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, placement, tc.expectedPlacement)
+			}
+
+			tenancy, hostPlacement := sdkToPlacement(placement)
+			if tenancy != tc.tenancy || !reflect.DeepEqual(hostPlacement, tc.hostPlacement) {
+				t.Errorf("Case: %s. Got: %q %v, expected: %q %v", tc.name, tenancy, hostPlacement, tc.tenancy, tc.hostPlacement)
+			}
+		})
+	}
+}
+
+func TestFallbackSubnetIDs(t *testing.T) {
+	subnets := infrav1.Subnets{
+		&infrav1.SubnetSpec{ID: "private-a", AvailabilityZone: "us-east-1a"},
+		&infrav1.SubnetSpec{ID: "private-b", AvailabilityZone: "us-east-1b"},
+		&infrav1.SubnetSpec{ID: "public-a", AvailabilityZone: "us-east-1a", IsPublic: true},
+		&infrav1.SubnetSpec{ID: "public-b", AvailabilityZone: "us-east-1b", IsPublic: true},
+	}
+
+	testCases := []struct {
+		name     string
+		subnetID string
+		publicIP bool
+		expected []string
+	}{
+		{
+			name:     "private subnet",
+			subnetID: "private-a",
+			expected: []string{"private-b"},
+		},
+		{
+			name:     "private subnet with a public IP",
+			subnetID: "private-a",
+			publicIP: true,
+			expected: []string{"public-b"},
+		},
+		{
+			name:     "public subnet",
+			subnetID: "public-a",
+			expected: []string{"public-b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Service{
+				scope: &scope.ClusterScope{
+					AWSCluster: &infrav1.AWSCluster{
+						Spec: infrav1.AWSClusterSpec{
+							NetworkSpec: infrav1.NetworkSpec{Subnets: subnets},
+						},
+					},
+				},
+			}
+
+			ids := s.fallbackSubnetIDs(tc.subnetID, tc.publicIP)
+			if !reflect.DeepEqual(ids, tc.expected) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, ids, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReconcileSourceDestCheck(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	specs := []infrav1.NetworkInterfaceSpec{
+		{Description: "storage", SourceDestCheck: aws.Bool(false)},
+		{Description: "routing", SourceDestCheck: aws.Bool(true)},
+		{Description: "default"},
+	}
+
+	testCases := []struct {
+		name     string
+		attached []infrav1.NetworkInterfaceStatus
+		expect   func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantErr  bool
+		expected []infrav1.NetworkInterfaceStatus
+	}{
+		{
+			name: "source/destination check already as requested",
+			attached: []infrav1.NetworkInterfaceStatus{
+				{ID: "eni-primary", DeviceIndex: 0, SourceDestCheck: true},
+				{ID: "eni-storage", DeviceIndex: 1, SourceDestCheck: false},
+				{ID: "eni-routing", DeviceIndex: 2, SourceDestCheck: true},
+				{ID: "eni-default", DeviceIndex: 3, SourceDestCheck: false},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+			expected: []infrav1.NetworkInterfaceStatus{
+				{ID: "eni-primary", DeviceIndex: 0, SourceDestCheck: true},
+				{ID: "eni-storage", DeviceIndex: 1, SourceDestCheck: false},
+				{ID: "eni-routing", DeviceIndex: 2, SourceDestCheck: true},
+				{ID: "eni-default", DeviceIndex: 3, SourceDestCheck: false},
+			},
+		},
+		{
+			name: "source/destination check drifted",
+			attached: []infrav1.NetworkInterfaceStatus{
+				{ID: "eni-primary", DeviceIndex: 0, SourceDestCheck: true},
+				{ID: "eni-storage", DeviceIndex: 1, SourceDestCheck: true},
+				{ID: "eni-routing", DeviceIndex: 2, SourceDestCheck: false},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ModifyNetworkInterfaceAttribute(gomock.Eq(&ec2.ModifyNetworkInterfaceAttributeInput{
+					NetworkInterfaceId: aws.String("eni-storage"),
+					SourceDestCheck:    &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				})).Return(&ec2.ModifyNetworkInterfaceAttributeOutput{}, nil)
+				m.ModifyNetworkInterfaceAttribute(gomock.Eq(&ec2.ModifyNetworkInterfaceAttributeInput{
+					NetworkInterfaceId: aws.String("eni-routing"),
+					SourceDestCheck:    &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				})).Return(&ec2.ModifyNetworkInterfaceAttributeOutput{}, nil)
+			},
+			expected: []infrav1.NetworkInterfaceStatus{
+				{ID: "eni-primary", DeviceIndex: 0, SourceDestCheck: true},
+				{ID: "eni-storage", DeviceIndex: 1, SourceDestCheck: false},
+				{ID: "eni-routing", DeviceIndex: 2, SourceDestCheck: true},
+			},
+		},
+		{
+			name: "error modifying network interface",
+			attached: []infrav1.NetworkInterfaceStatus{
+				{ID: "eni-storage", DeviceIndex: 1, SourceDestCheck: true},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ModifyNetworkInterfaceAttribute(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+			expected: []infrav1.NetworkInterfaceStatus{
+				{ID: "eni-storage", DeviceIndex: 1, SourceDestCheck: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			s := Service{
+				EC2Client: ec2Mock,
+			}
+
+			err := s.ReconcileSourceDestCheck(specs, tc.attached)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Case: %s. Got error: %v, wantErr: %v", tc.name, err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(tc.attached, tc.expected) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, tc.attached, tc.expected)
+			}
+		})
+	}
+}
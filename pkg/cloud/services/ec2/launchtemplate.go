@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -126,6 +127,24 @@ func (s *Service) CreateLaunchTemplateVersion(scope *scope.MachinePoolScope, ima
 	return nil
 }
 
+// RollbackLaunchTemplateVersion creates a new version of the launch template of the machine pool that copies the
+// given version, so that the group launches instances from it again.
+func (s *Service) RollbackLaunchTemplateVersion(scope *scope.MachinePoolScope, version int64) error {
+	s.scope.V(2).Info("rolling back launch template version", "machine-pool", scope.Name(), "version", version)
+
+	input := &ec2.CreateLaunchTemplateVersionInput{
+		LaunchTemplateData: &ec2.RequestLaunchTemplateData{},
+		LaunchTemplateId:   aws.String(scope.AWSMachinePool.Status.LaunchTemplateID),
+		SourceVersion:      aws.String(strconv.FormatInt(version, 10)),
+	}
+
+	if _, err := s.EC2Client.CreateLaunchTemplateVersion(input); err != nil {
+		return errors.Wrapf(err, "unable to roll back launch template to version %d", version)
+	}
+
+	return nil
+}
+
 func (s *Service) createLaunchTemplateData(scope *scope.MachinePoolScope, imageID *string, userData []byte) (*ec2.RequestLaunchTemplateData, error) {
 	lt := scope.AWSMachinePool.Spec.AWSLaunchTemplate
 
@@ -168,10 +187,18 @@ func (s *Service) createLaunchTemplateData(scope *scope.MachinePoolScope, imageI
 			Encrypted:           aws.Bool(lt.RootVolume.Encrypted),
 		}
 
+		if lt.RootVolume.DeleteOnTermination != nil {
+			ebsRootDevice.DeleteOnTermination = lt.RootVolume.DeleteOnTermination
+		}
+
 		if lt.RootVolume.IOPS != 0 {
 			ebsRootDevice.Iops = aws.Int64(lt.RootVolume.IOPS)
 		}
 
+		if lt.RootVolume.Throughput != 0 {
+			ebsRootDevice.Throughput = aws.Int64(lt.RootVolume.Throughput)
+		}
+
 		if lt.RootVolume.EncryptionKey != "" {
 			ebsRootDevice.Encrypted = aws.Bool(true)
 			ebsRootDevice.KmsKeyId = aws.String(lt.RootVolume.EncryptionKey)
@@ -189,6 +216,21 @@ func (s *Service) createLaunchTemplateData(scope *scope.MachinePoolScope, imageI
 		}
 	}
 
+	if spec := getCapacityReservationSpecification(lt.CapacityReservationTarget); spec != nil {
+		data.CapacityReservationSpecification = &ec2.LaunchTemplateCapacityReservationSpecificationRequest{
+			CapacityReservationPreference: spec.CapacityReservationPreference,
+			CapacityReservationTarget:     spec.CapacityReservationTarget,
+		}
+	}
+
+	if placement := getPlacement(lt.Tenancy, lt.HostPlacement); placement != nil {
+		data.Placement = &ec2.LaunchTemplatePlacementRequest{
+			Tenancy:              placement.Tenancy,
+			HostResourceGroupArn: placement.HostResourceGroupArn,
+			Affinity:             placement.Affinity,
+		}
+	}
+
 	data.TagSpecifications = s.buildLaunchTemplateTagSpecificationRequest(scope)
 
 	return data, nil
@@ -215,8 +257,10 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 	v := d.LaunchTemplateData
 	i := &expinfrav1.AWSLaunchTemplate{
 		Name: aws.StringValue(d.LaunchTemplateName),
-		AMI: infrav1.AWSResourceReference{
-			ID: v.ImageId,
+		AMI: infrav1.AMIReference{
+			AWSResourceReference: infrav1.AWSResourceReference{
+				ID: v.ImageId,
+			},
 		},
 		IamInstanceProfile: aws.StringValue(v.IamInstanceProfile.Name),
 		InstanceType:       aws.StringValue(v.InstanceType),
@@ -232,6 +276,53 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 		}
 	}
 
+	if v.CapacityReservationSpecification != nil {
+		i.CapacityReservationTarget = sdkToCapacityReservationTarget(&ec2.CapacityReservationSpecificationResponse{
+			CapacityReservationPreference: v.CapacityReservationSpecification.CapacityReservationPreference,
+			CapacityReservationTarget:     v.CapacityReservationSpecification.CapacityReservationTarget,
+		})
+	}
+
+	if v.Placement != nil {
+		i.Tenancy, i.HostPlacement = sdkToPlacement(&ec2.Placement{
+			Tenancy:              v.Placement.Tenancy,
+			HostResourceGroupArn: v.Placement.HostResourceGroupArn,
+			Affinity:             v.Placement.Affinity,
+		})
+	}
+
+	for _, mapping := range v.BlockDeviceMappings {
+		// The root volume is the only block device of the launch templates created by CAPA.
+		if mapping.Ebs == nil {
+			continue
+		}
+		i.RootVolume = &infrav1.Volume{
+			Size:                aws.Int64Value(mapping.Ebs.VolumeSize),
+			Type:                aws.StringValue(mapping.Ebs.VolumeType),
+			IOPS:                aws.Int64Value(mapping.Ebs.Iops),
+			Throughput:          aws.Int64Value(mapping.Ebs.Throughput),
+			Encrypted:           aws.BoolValue(mapping.Ebs.Encrypted),
+			EncryptionKey:       aws.StringValue(mapping.Ebs.KmsKeyId),
+			DeleteOnTermination: mapping.Ebs.DeleteOnTermination,
+		}
+		break
+	}
+
+	for _, spec := range v.TagSpecifications {
+		if aws.StringValue(spec.ResourceType) != ec2.ResourceTypeVolume {
+			continue
+		}
+		// These are all the tags of the volumes, including the ones they share with the
+		// instance, so that they can be compared with the output of launchTemplateVolumeTags.
+		if i.RootVolume == nil {
+			i.RootVolume = &infrav1.Volume{}
+		}
+		i.RootVolume.Tags = infrav1.Tags{}
+		for _, tag := range spec.Tags {
+			i.RootVolume.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+
 	for _, id := range v.SecurityGroupIds {
 		// This will include the core security groups as well, making the "Additional" a bit
 		// dishonest. However, including the core groups drastically simplifies comparison with
@@ -252,6 +343,26 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope *scope.MachinePoolScope, incom
 		return true, nil
 	}
 
+	if !reflect.DeepEqual(normalizeCapacityReservationTarget(incoming.CapacityReservationTarget), normalizeCapacityReservationTarget(existing.CapacityReservationTarget)) {
+		return true, nil
+	}
+
+	if incoming.Tenancy != existing.Tenancy || !reflect.DeepEqual(incoming.HostPlacement, existing.HostPlacement) {
+		return true, nil
+	}
+
+	if rootVolumeNeedsUpdate(incoming.RootVolume, existing.RootVolume) {
+		return true, nil
+	}
+
+	var existingVolumeTags infrav1.Tags
+	if existing.RootVolume != nil {
+		existingVolumeTags = existing.RootVolume.Tags
+	}
+	if !reflect.DeepEqual(launchTemplateVolumeTags(s.launchTemplateInstanceTags(scope), incoming.RootVolume), existingVolumeTags) {
+		return true, nil
+	}
+
 	incomingIDs := make([]string, len(incoming.AdditionalSecurityGroups))
 	for i, ref := range incoming.AdditionalSecurityGroups {
 		incomingIDs[i] = aws.StringValue(ref.ID)
@@ -279,6 +390,45 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope *scope.MachinePoolScope, incom
 	return false, nil
 }
 
+// rootVolumeNeedsUpdate checks if the throughput or deletion on termination of the root volume changed.
+func rootVolumeNeedsUpdate(incoming, existing *infrav1.Volume) bool {
+	var incomingThroughput, existingThroughput int64
+	incomingDeleteOnTermination, existingDeleteOnTermination := true, true
+
+	if incoming != nil {
+		incomingThroughput = incoming.Throughput
+		if incoming.DeleteOnTermination != nil {
+			incomingDeleteOnTermination = *incoming.DeleteOnTermination
+		}
+	}
+
+	if existing != nil {
+		existingThroughput = existing.Throughput
+		if existing.DeleteOnTermination != nil {
+			existingDeleteOnTermination = *existing.DeleteOnTermination
+		}
+	}
+
+	return incomingThroughput != existingThroughput || incomingDeleteOnTermination != existingDeleteOnTermination
+}
+
+// normalizeCapacityReservationTarget returns the capacity reservation target as applied by EC2, so that an unset
+// target, an empty one and the default open preference compare equal.
+func normalizeCapacityReservationTarget(target *infrav1.CapacityReservationTarget) *infrav1.CapacityReservationTarget {
+	switch {
+	case target == nil:
+		return nil
+	case target.ID != nil:
+		return &infrav1.CapacityReservationTarget{ID: target.ID}
+	case target.ResourceGroupARN != nil:
+		return &infrav1.CapacityReservationTarget{ResourceGroupARN: target.ResourceGroupARN}
+	case target.Preference == "" || target.Preference == infrav1.CapacityReservationPreferenceOpen:
+		return nil
+	default:
+		return &infrav1.CapacityReservationTarget{Preference: target.Preference}
+	}
+}
+
 func (s *Service) DiscoverLaunchTemplateAMI(scope *scope.MachinePoolScope) (*string, error) {
 	lt := scope.AWSMachinePool.Spec.AWSLaunchTemplate
 
@@ -286,15 +436,12 @@ func (s *Service) DiscoverLaunchTemplateAMI(scope *scope.MachinePoolScope) (*str
 		return lt.AMI.ID, nil
 	}
 
-	if scope.MachinePool.Spec.Template.Spec.Version == nil {
-		err := errors.New("Either AWSMachinePool's spec.awslaunchtemplate.ami.id or MachinePool's spec.template.spec.version must be defined")
+	if lt.AMI.SSMParameter == nil && scope.MachinePool.Spec.Template.Spec.Version == nil {
+		err := errors.New("Either AWSMachinePool's spec.awslaunchtemplate.ami.id, spec.awslaunchtemplate.ami.ssmParameter or MachinePool's spec.template.spec.version must be defined")
 		s.scope.Error(err, "")
 		return nil, err
 	}
 
-	var lookupAMI string
-	var err error
-
 	imageLookupFormat := lt.ImageLookupFormat
 	if imageLookupFormat == "" {
 		imageLookupFormat = scope.InfraCluster.ImageLookupFormat()
@@ -310,16 +457,9 @@ func (s *Service) DiscoverLaunchTemplateAMI(scope *scope.MachinePoolScope) (*str
 		imageLookupBaseOS = scope.InfraCluster.ImageLookupBaseOS()
 	}
 
-	if scope.IsEKSManaged() && imageLookupFormat == "" && imageLookupOrg == "" && imageLookupBaseOS == "" {
-		lookupAMI, err = s.eksAMILookup(*scope.MachinePool.Spec.Template.Spec.Version)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		lookupAMI, err = s.defaultAMIIDLookup(imageLookupFormat, imageLookupOrg, imageLookupBaseOS, *scope.MachinePool.Spec.Template.Spec.Version)
-		if err != nil {
-			return nil, err
-		}
+	lookupAMI, err := s.lookupAMI(lt.AMI, lt.InstanceType, imageLookupFormat, imageLookupOrg, imageLookupBaseOS, scope.MachinePool.Spec.Template.Spec.Version, scope.IsEKSManaged())
+	if err != nil {
+		return nil, err
 	}
 
 	return aws.String(lookupAMI), nil
@@ -327,17 +467,7 @@ func (s *Service) DiscoverLaunchTemplateAMI(scope *scope.MachinePoolScope) (*str
 
 func (s *Service) buildLaunchTemplateTagSpecificationRequest(scope *scope.MachinePoolScope) []*ec2.LaunchTemplateTagSpecificationRequest {
 	tagSpecifications := make([]*ec2.LaunchTemplateTagSpecificationRequest, 0)
-	additionalTags := scope.AdditionalTags()
-	// Set the cloud provider tag
-	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())] = string(infrav1.ResourceLifecycleOwned)
-
-	tags := infrav1.Build(infrav1.BuildParams{
-		ClusterName: s.scope.Name(),
-		Lifecycle:   infrav1.ResourceLifecycleOwned,
-		Name:        aws.String(scope.Name()),
-		Role:        aws.String("node"),
-		Additional:  additionalTags,
-	})
+	tags := s.launchTemplateInstanceTags(scope)
 
 	if len(tags) > 0 {
 		// tag instances
@@ -352,7 +482,7 @@ func (s *Service) buildLaunchTemplateTagSpecificationRequest(scope *scope.Machin
 
 		// tag EBS volumes
 		spec = &ec2.LaunchTemplateTagSpecificationRequest{ResourceType: aws.String(ec2.ResourceTypeVolume)}
-		for key, value := range tags {
+		for key, value := range launchTemplateVolumeTags(tags, scope.AWSMachinePool.Spec.AWSLaunchTemplate.RootVolume) {
 			spec.Tags = append(spec.Tags, &ec2.Tag{
 				Key:   aws.String(key),
 				Value: aws.String(value),
@@ -363,3 +493,29 @@ func (s *Service) buildLaunchTemplateTagSpecificationRequest(scope *scope.Machin
 	}
 	return tagSpecifications
 }
+
+// launchTemplateInstanceTags returns the tags of the instances of the launch template.
+func (s *Service) launchTemplateInstanceTags(scope *scope.MachinePoolScope) infrav1.Tags {
+	additionalTags := scope.AdditionalTags()
+	// Set the cloud provider tag
+	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())] = string(infrav1.ResourceLifecycleOwned)
+
+	return infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(scope.Name()),
+		Role:        aws.String("node"),
+		Additional:  additionalTags,
+	})
+}
+
+// launchTemplateVolumeTags returns the tags of the volumes of the launch template: the tags of the
+// instance and the tags of the root volume, which is the only volume of the launch template.
+func launchTemplateVolumeTags(instanceTags infrav1.Tags, rootVolume *infrav1.Volume) infrav1.Tags {
+	tags := infrav1.Tags{}
+	tags.Merge(instanceTags)
+	if rootVolume != nil {
+		tags.Merge(rootVolume.Tags)
+	}
+	return tags
+}
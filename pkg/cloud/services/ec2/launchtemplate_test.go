@@ -23,6 +23,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
@@ -120,17 +121,41 @@ func TestService_SDKToLaunchTemplate(t *testing.T) {
 							Groups:      []*string{aws.String("foo-group")},
 						},
 					},
+					TagSpecifications: []*ec2.LaunchTemplateTagSpecification{
+						{
+							ResourceType: aws.String(ec2.ResourceTypeInstance),
+							Tags:         []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("foo")}},
+						},
+						{
+							ResourceType: aws.String(ec2.ResourceTypeVolume),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("Name"), Value: aws.String("foo")},
+								{Key: aws.String("backup"), Value: aws.String("daily")},
+							},
+						},
+					},
 				},
 				VersionNumber: aws.Int64(1),
 			},
 			want: &expinfrav1.AWSLaunchTemplate{
 				Name: "foo",
-				AMI: infrav1.AWSResourceReference{
-					ID: aws.String("foo-image"),
+				AMI: infrav1.AMIReference{
+					AWSResourceReference: infrav1.AWSResourceReference{
+						ID: aws.String("foo-image"),
+					},
 				},
 				IamInstanceProfile: "foo-profile",
 				SSHKeyName:         aws.String("foo-keyname"),
 				VersionNumber:      aws.Int64(1),
+				RootVolume: &infrav1.Volume{
+					Size:      16,
+					Type:      "cool",
+					Encrypted: true,
+					Tags: infrav1.Tags{
+						"Name":   "foo",
+						"backup": "daily",
+					},
+				},
 			},
 		},
 	}
@@ -149,6 +174,18 @@ func TestService_SDKToLaunchTemplate(t *testing.T) {
 }
 
 func TestService_LaunchTemplateNeedsUpdate(t *testing.T) {
+	// volumeTags are the tags of the volumes of the launch templates of the test machine pool.
+	volumeTags := func(rootVolumeTags infrav1.Tags) infrav1.Tags {
+		tags := infrav1.Tags{
+			"Name":                               "test-mp",
+			"kubernetes.io/cluster/test-cluster": "owned",
+			"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+			"sigs.k8s.io/cluster-api-provider-aws/role":                 "node",
+		}
+		tags.Merge(rootVolumeTags)
+		return tags
+	}
+
 	tests := []struct {
 		name     string
 		incoming *expinfrav1.AWSLaunchTemplate
@@ -164,6 +201,7 @@ func TestService_LaunchTemplateNeedsUpdate(t *testing.T) {
 				},
 			},
 			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Tags: volumeTags(nil)},
 				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
 					{ID: aws.String("sg-111")},
 					{ID: aws.String("sg-222")},
@@ -181,6 +219,7 @@ func TestService_LaunchTemplateNeedsUpdate(t *testing.T) {
 				},
 			},
 			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Tags: volumeTags(nil)},
 				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
 					{ID: aws.String("sg-222")},
 					{ID: aws.String("sg-999")},
@@ -198,6 +237,7 @@ func TestService_LaunchTemplateNeedsUpdate(t *testing.T) {
 				},
 			},
 			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Tags: volumeTags(nil)},
 				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
 					{ID: aws.String("sg-111")},
 					{ID: aws.String("sg-222")},
@@ -207,6 +247,149 @@ func TestService_LaunchTemplateNeedsUpdate(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name:     "default open capacity reservation preference",
+			incoming: &expinfrav1.AWSLaunchTemplate{},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Tags: volumeTags(nil)},
+				CapacityReservationTarget: &infrav1.CapacityReservationTarget{
+					Preference: infrav1.CapacityReservationPreferenceOpen,
+				},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "empty capacity reservation target",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservationTarget: &infrav1.CapacityReservationTarget{},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Tags: volumeTags(nil)},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "new capacity reservation",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservationTarget: &infrav1.CapacityReservationTarget{
+					ID: aws.String("cr-123"),
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Tags: volumeTags(nil)},
+				CapacityReservationTarget: &infrav1.CapacityReservationTarget{
+					Preference: infrav1.CapacityReservationPreferenceOpen,
+				},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "same root volume",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{
+					Throughput:          250,
+					DeleteOnTermination: aws.Bool(true),
+					Tags:                infrav1.Tags{"backup": "daily"},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{
+					Throughput: 250,
+					Tags:       volumeTags(infrav1.Tags{"backup": "daily"}),
+				},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "new root volume throughput",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{
+					Throughput: 500,
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{
+					Throughput: 250,
+					Tags:       volumeTags(nil),
+				},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "root volume kept on termination",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{
+					DeleteOnTermination: aws.Bool(false),
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{
+					DeleteOnTermination: aws.Bool(true),
+					Tags:                volumeTags(nil),
+				},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "new root volume tag",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{
+					Tags: infrav1.Tags{"backup": "daily"},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Tags: volumeTags(nil)},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:     "root volume tag removed",
+			incoming: &expinfrav1.AWSLaunchTemplate{},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Tags: volumeTags(infrav1.Tags{"backup": "daily"})},
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -224,14 +407,19 @@ func TestService_LaunchTemplateNeedsUpdate(t *testing.T) {
 					},
 				},
 			}
-			s := &Service{
-				scope: &scope.ClusterScope{
-					AWSCluster: ac,
+			clusterScope := &scope.ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
 				},
+				AWSCluster: ac,
+			}
+			s := &Service{
+				scope: clusterScope,
 			}
 			machinePoolScope := &scope.MachinePoolScope{
-				InfraCluster: &scope.ClusterScope{
-					AWSCluster: ac,
+				InfraCluster: clusterScope,
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-mp"},
 				},
 			}
 			got, err := s.LaunchTemplateNeedsUpdate(machinePoolScope, tt.incoming, tt.existing)
@@ -17,8 +17,10 @@ limitations under the License.
 package ec2
 
 import (
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 )
@@ -32,6 +34,12 @@ type Service struct {
 
 	// SSMClient is used to look up the official EKS AMI ID
 	SSMClient ssmiface.SSMAPI
+
+	// ASGClient is used to manage the Auto Scaling group of a highly available bastion
+	ASGClient autoscalingiface.AutoScalingAPI
+
+	// STSClient is used to get the account that AMI, image and instance type lookups are cached for
+	STSClient stsiface.STSAPI
 }
 
 // NewService returns a new service given the ec2 api client.
@@ -40,5 +48,7 @@ func NewService(clusterScope scope.EC2Scope) *Service {
 		scope:     clusterScope,
 		EC2Client: scope.NewEC2Client(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
 		SSMClient: scope.NewSSMClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+		ASGClient: scope.NewASGClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+		STSClient: scope.NewSTSClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
 	}
 }
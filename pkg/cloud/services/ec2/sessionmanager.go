@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	// vpcEndpointResourceType is the resource type of VPC endpoints in tag specifications.
+	vpcEndpointResourceType = "vpc-endpoint"
+)
+
+var (
+	// sessionManagerServices are the services the SSM agent of instances in private subnets reaches through VPC
+	// endpoints.
+	sessionManagerServices = []string{"ssm", "ssmmessages", "ec2messages"}
+
+	// vpcEndpointActiveStates are the states of VPC endpoints that are, or are about to be, usable.
+	vpcEndpointActiveStates = []string{"pendingAcceptance", "pending", "available"}
+)
+
+// reconcileSessionManagerEndpoints ensures the interface VPC endpoints of the AWS Systems Manager Session Manager
+// exist in the private subnets of the cluster. Endpoints of a service that already exist in the VPC, whoever
+// created them, are left as they are.
+func (s *Service) reconcileSessionManagerEndpoints() error {
+	subnetIDs := s.sessionManagerEndpointSubnetIDs()
+	if len(subnetIDs) == 0 {
+		s.scope.V(2).Info("No private subnets available, skipping session manager endpoints")
+		return nil
+	}
+
+	for _, serviceName := range s.sessionManagerServiceNames() {
+		out, err := s.EC2Client.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+			Filters: []*ec2.Filter{
+				filter.EC2.VPC(s.scope.VPC().ID),
+				filter.EC2.ServiceNames(serviceName),
+				filter.EC2.VPCEndpointStates(vpcEndpointActiveStates...),
+			},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe VPC endpoints for service %q", serviceName)
+		}
+		if len(out.VpcEndpoints) > 0 {
+			continue
+		}
+
+		created, err := s.EC2Client.CreateVpcEndpoint(&ec2.CreateVpcEndpointInput{
+			VpcId:             aws.String(s.scope.VPC().ID),
+			ServiceName:       aws.String(serviceName),
+			VpcEndpointType:   aws.String(ec2.VpcEndpointTypeInterface),
+			SubnetIds:         aws.StringSlice(subnetIDs),
+			SecurityGroupIds:  aws.StringSlice([]string{s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID}),
+			PrivateDnsEnabled: aws.Bool(true),
+			TagSpecifications: []*ec2.TagSpecification{
+				tags.BuildParamsToTagSpecification(vpcEndpointResourceType, infrav1.BuildParams{
+					ClusterName: s.scope.Name(),
+					Lifecycle:   infrav1.ResourceLifecycleOwned,
+					Name:        aws.String(fmt.Sprintf("%s-%s", s.scope.Name(), serviceName)),
+					Role:        aws.String(infrav1.BastionRoleTagValue),
+					Additional:  s.scope.AdditionalTags(),
+				}),
+			},
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateVPCEndpoint", "Failed to create VPC endpoint for service %q: %v", serviceName, err)
+			return errors.Wrapf(err, "failed to create VPC endpoint for service %q", serviceName)
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateVPCEndpoint", "Created VPC endpoint %q for service %q",
+			aws.StringValue(created.VpcEndpoint.VpcEndpointId), serviceName)
+	}
+
+	return nil
+}
+
+// deleteSessionManagerEndpoints deletes the Session Manager VPC endpoints owned by the cluster, if any.
+func (s *Service) deleteSessionManagerEndpoints() error {
+	if s.scope.VPC().ID == "" {
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.ServiceNames(s.sessionManagerServiceNames()...),
+			filter.EC2.VPCEndpointStates(vpcEndpointActiveStates...),
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe session manager VPC endpoints")
+	}
+	if len(out.VpcEndpoints) == 0 {
+		return nil
+	}
+
+	ids := make([]*string, 0, len(out.VpcEndpoints))
+	for _, endpoint := range out.VpcEndpoints {
+		ids = append(ids, endpoint.VpcEndpointId)
+	}
+
+	if _, err := s.EC2Client.DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{VpcEndpointIds: ids}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteVPCEndpoints", "Failed to delete session manager VPC endpoints: %v", err)
+		return errors.Wrap(err, "failed to delete session manager VPC endpoints")
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteVPCEndpoints", "Deleted session manager VPC endpoints %v", aws.StringValueSlice(ids))
+	return nil
+}
+
+// sessionManagerServiceNames returns the names of the Session Manager services in the region of the cluster.
+func (s *Service) sessionManagerServiceNames() []string {
+	names := make([]string, 0, len(sessionManagerServices))
+	for _, service := range sessionManagerServices {
+		names = append(names, fmt.Sprintf("com.amazonaws.%s.%s", s.scope.Region(), service))
+	}
+	return names
+}
+
+// sessionManagerEndpointSubnetIDs returns a private subnet for each availability zone of the cluster, as an
+// interface VPC endpoint has at most one network interface per availability zone.
+func (s *Service) sessionManagerEndpointSubnetIDs() []string {
+	subnetIDs := []string{}
+	zones := map[string]bool{}
+	for _, subnet := range s.scope.Subnets().FilterPrivate() {
+		if zones[subnet.AvailabilityZone] {
+			continue
+		}
+		zones[subnet.AvailabilityZone] = true
+		subnetIDs = append(subnetIDs, subnet.ID)
+	}
+	return subnetIDs
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileBastionSessionManager(t *testing.T) {
+	clusterName := "cluster"
+
+	describeBastionInput := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ProviderRole(infrav1.BastionRoleTagValue),
+			filter.EC2.Cluster(clusterName),
+			filter.EC2.InstanceStates(
+				ec2.InstanceStateNamePending,
+				ec2.InstanceStateNameRunning,
+				ec2.InstanceStateNameStopping,
+				ec2.InstanceStateNameStopped,
+			),
+		},
+	}
+
+	describeEndpointsInput := func(serviceName string) *ec2.DescribeVpcEndpointsInput {
+		return &ec2.DescribeVpcEndpointsInput{
+			Filters: []*ec2.Filter{
+				filter.EC2.VPC("vpc-1"),
+				filter.EC2.ServiceNames(serviceName),
+				filter.EC2.VPCEndpointStates("pendingAcceptance", "pending", "available"),
+			},
+		}
+	}
+
+	createEndpointInput := func(serviceName string) *ec2.CreateVpcEndpointInput {
+		return &ec2.CreateVpcEndpointInput{
+			VpcId:             aws.String("vpc-1"),
+			ServiceName:       aws.String(serviceName),
+			VpcEndpointType:   aws.String("Interface"),
+			SubnetIds:         aws.StringSlice([]string{"subnet-private-1a", "subnet-private-1b"}),
+			SecurityGroupIds:  aws.StringSlice([]string{"sg-bastion"}),
+			PrivateDnsEnabled: aws.Bool(true),
+			TagSpecifications: []*ec2.TagSpecification{
+				{
+					ResourceType: aws.String("vpc-endpoint"),
+					Tags: []*ec2.Tag{
+						{
+							Key:   aws.String("Name"),
+							Value: aws.String("cluster-" + serviceName),
+						},
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/cluster"),
+							Value: aws.String("owned"),
+						},
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+							Value: aws.String("bastion"),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectError bool
+	}{
+		{
+			name: "creates missing endpoints",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstances(gomock.Eq(describeBastionInput)).
+					Return(&ec2.DescribeInstancesOutput{}, nil)
+				m.DescribeVpcEndpoints(gomock.Eq(describeEndpointsInput("com.amazonaws.us-east-1.ssm"))).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []*ec2.VpcEndpoint{{VpcEndpointId: aws.String("vpce-existing")}},
+					}, nil)
+				m.DescribeVpcEndpoints(gomock.Eq(describeEndpointsInput("com.amazonaws.us-east-1.ssmmessages"))).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.CreateVpcEndpoint(gomock.Eq(createEndpointInput("com.amazonaws.us-east-1.ssmmessages"))).
+					Return(&ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2.VpcEndpoint{VpcEndpointId: aws.String("vpce-1")}}, nil)
+				m.DescribeVpcEndpoints(gomock.Eq(describeEndpointsInput("com.amazonaws.us-east-1.ec2messages"))).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.CreateVpcEndpoint(gomock.Eq(createEndpointInput("com.amazonaws.us-east-1.ec2messages"))).
+					Return(&ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2.VpcEndpoint{VpcEndpointId: aws.String("vpce-2")}}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "endpoint creation fails",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstances(gomock.Eq(describeBastionInput)).
+					Return(&ec2.DescribeInstancesOutput{}, nil)
+				m.DescribeVpcEndpoints(gomock.Eq(describeEndpointsInput("com.amazonaws.us-east-1.ssm"))).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.CreateVpcEndpoint(gomock.Eq(createEndpointInput("com.amazonaws.us-east-1.ssm"))).
+					Return(nil, errors.New("some error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockControl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).To(BeNil())
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							ID: "vpc-1",
+						},
+						Subnets: infrav1.Subnets{
+							{ID: "subnet-private-1a", AvailabilityZone: "us-east-1a"},
+							{ID: "subnet-private-1a-2", AvailabilityZone: "us-east-1a"},
+							{ID: "subnet-private-1b", AvailabilityZone: "us-east-1b"},
+							{ID: "subnet-public-1a", AvailabilityZone: "us-east-1a", IsPublic: true},
+						},
+					},
+					Bastion: infrav1.Bastion{
+						Enabled: true,
+						Mode:    infrav1.BastionModeSessionManager,
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupBastion: {ID: "sg-bastion"},
+						},
+					},
+				},
+			}
+
+			client := fake.NewFakeClientWithScheme(scheme)
+			ctx := context.TODO()
+			client.Create(ctx, awsCluster)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+
+			asgMock.EXPECT().
+				DescribeAutoScalingGroups(gomock.Eq(&autoscaling.DescribeAutoScalingGroupsInput{
+					AutoScalingGroupNames: aws.StringSlice([]string{"cluster-bastion"}),
+				})).
+				Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil).
+				AnyTimes()
+
+			tc.expect(ec2Mock.EXPECT())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			s.ASGClient = asgMock
+
+			err = s.ReconcileBastion()
+			if tc.expectError {
+				g.Expect(err).NotTo(BeNil())
+				g.Expect(conditions.IsFalse(awsCluster, infrav1.BastionHostReadyCondition)).To(BeTrue())
+				return
+			}
+
+			g.Expect(err).To(BeNil())
+			g.Expect(conditions.IsTrue(awsCluster, infrav1.BastionHostReadyCondition)).To(BeTrue())
+			g.Expect(awsCluster.Status.Bastion).To(BeNil())
+			g.Expect(awsCluster.Status.BastionMode).To(Equal(infrav1.BastionModeSessionManager))
+		})
+	}
+}
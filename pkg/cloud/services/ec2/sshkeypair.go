@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// ReconcileSSHKeyPair creates the managed EC2 key pair of the cluster if it does not exist yet, either by
+// importing the public key of the cluster, or by generating a key pair whose private key is stored in a Secret.
+func (s *Service) ReconcileSSHKeyPair() error {
+	if s.scope.ManagedSSHKeyPair() == nil {
+		s.scope.V(4).Info("Skipping SSH key pair reconcile")
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling SSH key pair")
+
+	name := aws.StringValue(s.scope.SSHKeyName())
+	keyPair, err := s.describeSSHKeyPair(name)
+	if err != nil {
+		return err
+	}
+
+	if keyPair != nil {
+		if !converters.TagsToMap(keyPair.Tags).HasOwned(s.scope.Name()) {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateSSHKeyPair", "SSH key pair %q already exists and is not owned by the cluster", name)
+			return errors.Errorf("SSH key pair %q already exists and is not owned by cluster %q", name, s.scope.Name())
+		}
+		s.scope.V(4).Info("SSH key pair already exists", "key-name", name)
+		return nil
+	}
+
+	publicKey, err := s.scope.GetManagedSSHPublicKey()
+	if err != nil {
+		return err
+	}
+
+	if publicKey != nil {
+		if err := s.importSSHKeyPair(name, publicKey); err != nil {
+			return err
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulImportSSHKeyPair", "Imported SSH key pair %q", name)
+		return nil
+	}
+
+	privateKey, err := s.createSSHKeyPair(name)
+	if err != nil {
+		return err
+	}
+
+	// The private key cannot be retrieved again, so the key pair is only kept once it is stored.
+	if err := s.scope.StoreManagedSSHPrivateKey(privateKey); err != nil {
+		if deleteErr := s.deleteSSHKeyPair(name); deleteErr != nil {
+			s.scope.Error(deleteErr, "failed to delete SSH key pair whose private key could not be stored", "key-name", name)
+		}
+		return err
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateSSHKeyPair", "Created SSH key pair %q", name)
+
+	return nil
+}
+
+// DeleteSSHKeyPair deletes the managed EC2 key pair of the cluster and the Secret holding its private key.
+func (s *Service) DeleteSSHKeyPair() error {
+	if s.scope.ManagedSSHKeyPair() == nil {
+		s.scope.V(4).Info("Skipping SSH key pair deletion")
+		return nil
+	}
+
+	name := aws.StringValue(s.scope.SSHKeyName())
+	keyPair, err := s.describeSSHKeyPair(name)
+	if err != nil {
+		return err
+	}
+
+	if keyPair != nil && converters.TagsToMap(keyPair.Tags).HasOwned(s.scope.Name()) {
+		if err := s.deleteSSHKeyPair(name); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteSSHKeyPair", "Failed to delete SSH key pair %q: %v", name, err)
+			return err
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteSSHKeyPair", "Deleted SSH key pair %q", name)
+	}
+
+	return s.scope.DeleteManagedSSHPrivateKey()
+}
+
+func (s *Service) describeSSHKeyPair(name string) (*ec2.KeyPairInfo, error) {
+	out, err := s.EC2Client.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("key-name"),
+				Values: aws.StringSlice([]string{name}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe SSH key pair %q", name)
+	}
+
+	if len(out.KeyPairs) == 0 {
+		return nil, nil
+	}
+
+	return out.KeyPairs[0], nil
+}
+
+func (s *Service) importSSHKeyPair(name string, publicKey []byte) error {
+	s.scope.V(2).Info("Importing SSH key pair", "key-name", name)
+
+	_, err := s.EC2Client.ImportKeyPair(&ec2.ImportKeyPairInput{
+		KeyName:           aws.String(name),
+		PublicKeyMaterial: publicKey,
+		TagSpecifications: []*ec2.TagSpecification{s.sshKeyPairTagSpecification(name)},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedImportSSHKeyPair", "Failed to import SSH key pair %q: %v", name, err)
+		return errors.Wrapf(err, "failed to import SSH key pair %q", name)
+	}
+
+	return nil
+}
+
+// createSSHKeyPair generates a key pair and returns its PEM encoded private key.
+func (s *Service) createSSHKeyPair(name string) ([]byte, error) {
+	s.scope.V(2).Info("Creating SSH key pair", "key-name", name)
+
+	out, err := s.EC2Client.CreateKeyPair(&ec2.CreateKeyPairInput{
+		KeyName:           aws.String(name),
+		TagSpecifications: []*ec2.TagSpecification{s.sshKeyPairTagSpecification(name)},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateSSHKeyPair", "Failed to create SSH key pair %q: %v", name, err)
+		return nil, errors.Wrapf(err, "failed to create SSH key pair %q", name)
+	}
+
+	return []byte(aws.StringValue(out.KeyMaterial)), nil
+}
+
+func (s *Service) deleteSSHKeyPair(name string) error {
+	s.scope.V(2).Info("Deleting SSH key pair", "key-name", name)
+
+	if _, err := s.EC2Client.DeleteKeyPair(&ec2.DeleteKeyPairInput{KeyName: aws.String(name)}); err != nil {
+		return errors.Wrapf(err, "failed to delete SSH key pair %q", name)
+	}
+
+	return nil
+}
+
+func (s *Service) sshKeyPairTagSpecification(name string) *ec2.TagSpecification {
+	return tags.BuildParamsToTagSpecification(ec2.ResourceTypeKeyPair, infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.SSHKeyPairRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	})
+}
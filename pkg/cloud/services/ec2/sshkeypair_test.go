@@ -0,0 +1,297 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileSSHKeyPair(t *testing.T) {
+	describeKeyPairsInput := &ec2.DescribeKeyPairsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("key-name"),
+				Values: aws.StringSlice([]string{"cluster-ssh-key"}),
+			},
+		},
+	}
+
+	keyPairTagSpecifications := []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String("key-pair"),
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String("Name"),
+					Value: aws.String("cluster-ssh-key"),
+				},
+				{
+					Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/cluster"),
+					Value: aws.String("owned"),
+				},
+				{
+					Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+					Value: aws.String("ssh-key-pair"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		keyPair          *infrav1.ManagedSSHKeyPair
+		expect           func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectError      bool
+		expectPrivateKey []byte
+	}{
+		{
+			name:    "generates a key pair and stores its private key",
+			keyPair: &infrav1.ManagedSSHKeyPair{},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeKeyPairs(gomock.Eq(describeKeyPairsInput)).
+					Return(&ec2.DescribeKeyPairsOutput{}, nil)
+				m.CreateKeyPair(gomock.Eq(&ec2.CreateKeyPairInput{
+					KeyName:           aws.String("cluster-ssh-key"),
+					TagSpecifications: keyPairTagSpecifications,
+				})).
+					Return(&ec2.CreateKeyPairOutput{
+						KeyName:     aws.String("cluster-ssh-key"),
+						KeyMaterial: aws.String("private-key"),
+					}, nil)
+			},
+			expectError:      false,
+			expectPrivateKey: []byte("private-key"),
+		},
+		{
+			name: "imports the public key from its secret",
+			keyPair: &infrav1.ManagedSSHKeyPair{
+				PublicKeySecretName: aws.String("ssh-public-key"),
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeKeyPairs(gomock.Eq(describeKeyPairsInput)).
+					Return(&ec2.DescribeKeyPairsOutput{}, nil)
+				m.ImportKeyPair(gomock.Eq(&ec2.ImportKeyPairInput{
+					KeyName:           aws.String("cluster-ssh-key"),
+					PublicKeyMaterial: []byte("ssh-rsa public-key"),
+					TagSpecifications: keyPairTagSpecifications,
+				})).
+					Return(&ec2.ImportKeyPairOutput{KeyName: aws.String("cluster-ssh-key")}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "keeps the existing key pair of the cluster",
+			keyPair: &infrav1.ManagedSSHKeyPair{},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeKeyPairs(gomock.Eq(describeKeyPairsInput)).
+					Return(&ec2.DescribeKeyPairsOutput{
+						KeyPairs: []*ec2.KeyPairInfo{
+							{
+								KeyName: aws.String("cluster-ssh-key"),
+								Tags:    keyPairTagSpecifications[0].Tags,
+							},
+						},
+					}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "fails if a key pair with the same name is not owned by the cluster",
+			keyPair: &infrav1.ManagedSSHKeyPair{},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeKeyPairs(gomock.Eq(describeKeyPairsInput)).
+					Return(&ec2.DescribeKeyPairsOutput{
+						KeyPairs: []*ec2.KeyPairInfo{{KeyName: aws.String("cluster-ssh-key")}},
+					}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name:    "key pair creation fails",
+			keyPair: &infrav1.ManagedSSHKeyPair{},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeKeyPairs(gomock.Eq(describeKeyPairsInput)).
+					Return(&ec2.DescribeKeyPairsOutput{}, nil)
+				m.CreateKeyPair(gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).To(BeNil())
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "cluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					ManagedSSHKeyPair: tc.keyPair,
+				},
+			}
+
+			client := fake.NewFakeClientWithScheme(scheme)
+			ctx := context.TODO()
+			client.Create(ctx, awsCluster)
+			client.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "ssh-public-key",
+				},
+				Data: map[string][]byte{
+					"value": []byte("ssh-rsa public-key"),
+				},
+			})
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "cluster",
+					},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.SSHKeyName()).To(Equal(aws.String("cluster-ssh-key")))
+
+			tc.expect(ec2Mock.EXPECT())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.ReconcileSSHKeyPair()
+			if tc.expectError {
+				g.Expect(err).NotTo(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+
+			secret := &corev1.Secret{}
+			err = client.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "cluster-ssh-key"}, secret)
+			if tc.expectPrivateKey == nil {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(secret.Data["value"]).To(Equal(tc.expectPrivateKey))
+			g.Expect(secret.Labels).To(HaveKeyWithValue(clusterv1.ClusterLabelName, "cluster"))
+			g.Expect(secret.OwnerReferences).To(HaveLen(1))
+			g.Expect(secret.OwnerReferences[0].Kind).To(Equal("AWSCluster"))
+		})
+	}
+}
+
+func TestDeleteSSHKeyPair(t *testing.T) {
+	g := NewWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+
+	scheme, err := setupScheme()
+	g.Expect(err).To(BeNil())
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "cluster",
+		},
+		Spec: infrav1.AWSClusterSpec{
+			ManagedSSHKeyPair: &infrav1.ManagedSSHKeyPair{},
+		},
+	}
+
+	client := fake.NewFakeClientWithScheme(scheme)
+	ctx := context.TODO()
+	client.Create(ctx, awsCluster)
+	client.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "cluster-ssh-key",
+		},
+		Data: map[string][]byte{
+			"value": []byte("private-key"),
+		},
+	})
+
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "cluster",
+			},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+	g.Expect(err).To(BeNil())
+
+	ec2Mock.EXPECT().
+		DescribeKeyPairs(gomock.Any()).
+		Return(&ec2.DescribeKeyPairsOutput{
+			KeyPairs: []*ec2.KeyPairInfo{
+				{
+					KeyName: aws.String("cluster-ssh-key"),
+					Tags: []*ec2.Tag{
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/cluster"),
+							Value: aws.String("owned"),
+						},
+					},
+				},
+			},
+		}, nil)
+	ec2Mock.EXPECT().
+		DeleteKeyPair(gomock.Eq(&ec2.DeleteKeyPairInput{KeyName: aws.String("cluster-ssh-key")})).
+		Return(&ec2.DeleteKeyPairOutput{}, nil)
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.DeleteSSHKeyPair()).To(Succeed())
+
+	err = client.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "cluster-ssh-key"}, &corev1.Secret{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// volumeModificationCooldown is the time EC2 requires between two modifications of a volume.
+const volumeModificationCooldown = 6 * time.Hour
+
+// ReconcileVolumes makes sure the volumes attached to an instance carry the tags of their spec
+// and, if allowExpansion is set, grows volumes whose spec size is larger than their current size.
+// Volumes still being modified, or modified less than six hours ago, are left for a later reconcile.
+// It returns the IDs of the volumes a modification was requested for.
+func (s *Service) ReconcileVolumes(instance *infrav1.Instance, rootVolume *infrav1.Volume, nonRootVolumes []*infrav1.Volume, allowExpansion bool) ([]string, error) {
+	if !allowExpansion && !hasVolumeTags(rootVolume, nonRootVolumes) {
+		return nil, nil
+	}
+
+	volumes := map[string]*infrav1.Volume{}
+	for _, volume := range nonRootVolumes {
+		volumes[volume.DeviceName] = volume
+	}
+
+	// Map the volumes attached to the instance to their spec.
+	volumeSpecs := map[string]*infrav1.Volume{}
+	for _, attached := range instance.AttachedVolumes {
+		volume, ok := volumes[attached.DeviceName]
+		if attached.Root {
+			volume, ok = rootVolume, rootVolume != nil
+		}
+		if ok {
+			volumeSpecs[attached.ID] = volume
+		}
+	}
+
+	if len(volumeSpecs) == 0 {
+		return nil, nil
+	}
+
+	input := &ec2.DescribeVolumesInput{}
+	for id := range volumeSpecs {
+		input.VolumeIds = append(input.VolumeIds, aws.String(id))
+	}
+
+	volumesOut, err := s.EC2Client.DescribeVolumes(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe volumes of instance %q", instance.ID)
+	}
+
+	expand := []*ec2.Volume{}
+	for _, v := range volumesOut.Volumes {
+		volumeID := aws.StringValue(v.VolumeId)
+		spec := volumeSpecs[volumeID]
+
+		existing := converters.TagsToMap(v.Tags)
+		if diff := spec.Tags.Difference(existing); len(diff) > 0 {
+			if err := s.UpdateResourceTags(v.VolumeId, diff, nil); err != nil {
+				return nil, errors.Wrapf(err, "failed to tag volume %q", volumeID)
+			}
+		}
+
+		if allowExpansion && spec.Size > aws.Int64Value(v.Size) {
+			expand = append(expand, v)
+		}
+	}
+
+	modified := []string{}
+	if len(expand) == 0 {
+		return modified, nil
+	}
+
+	pending, err := s.pendingVolumeModifications(expand)
+	if err != nil {
+		return modified, errors.Wrapf(err, "failed to describe volume modifications of instance %q", instance.ID)
+	}
+
+	for _, v := range expand {
+		volumeID := aws.StringValue(v.VolumeId)
+		size := volumeSpecs[volumeID].Size
+		if pending[volumeID] {
+			s.scope.V(2).Info("Volume was modified recently, waiting to expand it", "volume-id", volumeID, "to", size)
+			continue
+		}
+
+		s.scope.V(2).Info("Attempting to expand volume", "volume-id", volumeID, "from", aws.Int64Value(v.Size), "to", size)
+		if _, err := s.EC2Client.ModifyVolume(&ec2.ModifyVolumeInput{
+			VolumeId: v.VolumeId,
+			Size:     aws.Int64(size),
+		}); err != nil {
+			if code, _ := awserrors.Code(errors.Cause(err)); code == awserrors.VolumeModificationRateExceeded {
+				s.scope.V(2).Info("Volume was modified less than six hours ago, waiting to expand it", "volume-id", volumeID, "to", size)
+				continue
+			}
+			record.Warnf(s.scope.InfraCluster(), "FailedModifyVolume", "Failed to expand volume %q of instance %q: %v", volumeID, instance.ID, err)
+			return modified, errors.Wrapf(err, "failed to expand volume %q of instance %q", volumeID, instance.ID)
+		}
+		modified = append(modified, volumeID)
+	}
+
+	return modified, nil
+}
+
+// pendingVolumeModifications returns the IDs of the volumes that cannot be modified yet, because a modification
+// is still in progress or the last one started less than six hours ago.
+func (s *Service) pendingVolumeModifications(volumes []*ec2.Volume) (map[string]bool, error) {
+	volumeIDs := make([]string, 0, len(volumes))
+	for _, v := range volumes {
+		volumeIDs = append(volumeIDs, aws.StringValue(v.VolumeId))
+	}
+	// Filtering by volume ID, unlike listing the volume IDs, does not fail for volumes that were never modified.
+	input := &ec2.DescribeVolumesModificationsInput{
+		Filters: []*ec2.Filter{filter.EC2.VolumeIDs(volumeIDs...)},
+	}
+
+	pending := map[string]bool{}
+	err := s.EC2Client.DescribeVolumesModificationsPages(input, func(out *ec2.DescribeVolumesModificationsOutput, _ bool) bool {
+		for _, m := range out.VolumesModifications {
+			switch aws.StringValue(m.ModificationState) {
+			case ec2.VolumeModificationStateModifying, ec2.VolumeModificationStateOptimizing:
+				pending[aws.StringValue(m.VolumeId)] = true
+			case ec2.VolumeModificationStateCompleted:
+				if m.StartTime != nil && time.Since(*m.StartTime) < volumeModificationCooldown {
+					pending[aws.StringValue(m.VolumeId)] = true
+				}
+			}
+		}
+		return true
+	})
+
+	return pending, err
+}
+
+func hasVolumeTags(rootVolume *infrav1.Volume, nonRootVolumes []*infrav1.Volume) bool {
+	if rootVolume != nil && len(rootVolume.Tags) > 0 {
+		return true
+	}
+	for _, volume := range nonRootVolumes {
+		if len(volume.Tags) > 0 {
+			return true
+		}
+	}
+	return false
+}
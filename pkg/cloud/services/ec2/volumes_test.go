@@ -0,0 +1,226 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestReconcileVolumes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	instance := &infrav1.Instance{
+		ID: "i-exist",
+		AttachedVolumes: []infrav1.VolumeAttachmentStatus{
+			{ID: "vol-root", DeviceName: "/dev/sda1", Root: true},
+		},
+	}
+
+	describeModifications := func(m *mock_ec2iface.MockEC2APIMockRecorder, modifications ...*ec2.VolumeModification) {
+		m.DescribeVolumesModificationsPages(gomock.Eq(&ec2.DescribeVolumesModificationsInput{
+			Filters: []*ec2.Filter{{Name: aws.String("volume-id"), Values: aws.StringSlice([]string{"vol-root"})}},
+		}), gomock.Any()).
+			DoAndReturn(func(_ *ec2.DescribeVolumesModificationsInput, fn func(*ec2.DescribeVolumesModificationsOutput, bool) bool) error {
+				fn(&ec2.DescribeVolumesModificationsOutput{VolumesModifications: modifications}, true)
+				return nil
+			})
+	}
+
+	testCases := []struct {
+		name           string
+		rootVolume     *infrav1.Volume
+		allowExpansion bool
+		expect         func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		want           []string
+		wantErr        bool
+	}{
+		{
+			name:       "nothing to reconcile",
+			rootVolume: &infrav1.Volume{Size: 16},
+			expect:     func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:           "volume is expanded",
+			rootVolume:     &infrav1.Volume{Size: 16},
+			allowExpansion: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Eq(&ec2.DescribeVolumesInput{
+					VolumeIds: aws.StringSlice([]string{"vol-root"}),
+				})).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-root"), Size: aws.Int64(8)}},
+					}, nil)
+				describeModifications(m, &ec2.VolumeModification{
+					VolumeId:          aws.String("vol-root"),
+					ModificationState: aws.String(ec2.VolumeModificationStateCompleted),
+					StartTime:         aws.Time(time.Now().Add(-7 * time.Hour)),
+				})
+				m.ModifyVolume(gomock.Eq(&ec2.ModifyVolumeInput{
+					VolumeId: aws.String("vol-root"),
+					Size:     aws.Int64(16),
+				})).
+					Return(&ec2.ModifyVolumeOutput{}, nil)
+			},
+			want: []string{"vol-root"},
+		},
+		{
+			name:           "volume is not shrunk",
+			rootVolume:     &infrav1.Volume{Size: 8},
+			allowExpansion: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Any()).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-root"), Size: aws.Int64(16)}},
+					}, nil)
+			},
+			want: []string{},
+		},
+		{
+			name:       "missing volume tags are created",
+			rootVolume: &infrav1.Volume{Size: 16, Tags: infrav1.Tags{"team": "storage", "env": "dev"}},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Any()).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{
+							{
+								VolumeId: aws.String("vol-root"),
+								Size:     aws.Int64(8),
+								Tags:     []*ec2.Tag{{Key: aws.String("env"), Value: aws.String("dev")}},
+							},
+						},
+					}, nil)
+				m.CreateTags(gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"vol-root"}),
+					Tags:      []*ec2.Tag{{Key: aws.String("team"), Value: aws.String("storage")}},
+				})).
+					Return(&ec2.CreateTagsOutput{}, nil)
+			},
+			want: []string{},
+		},
+		{
+			name:           "volume being modified is not expanded again",
+			rootVolume:     &infrav1.Volume{Size: 16},
+			allowExpansion: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Any()).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-root"), Size: aws.Int64(8)}},
+					}, nil)
+				describeModifications(m, &ec2.VolumeModification{
+					VolumeId:          aws.String("vol-root"),
+					ModificationState: aws.String(ec2.VolumeModificationStateOptimizing),
+					StartTime:         aws.Time(time.Now().Add(-time.Minute)),
+				})
+			},
+			want: []string{},
+		},
+		{
+			name:           "volume modified less than six hours ago is not expanded",
+			rootVolume:     &infrav1.Volume{Size: 16},
+			allowExpansion: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Any()).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-root"), Size: aws.Int64(8)}},
+					}, nil)
+				describeModifications(m, &ec2.VolumeModification{
+					VolumeId:          aws.String("vol-root"),
+					ModificationState: aws.String(ec2.VolumeModificationStateCompleted),
+					StartTime:         aws.Time(time.Now().Add(-time.Hour)),
+				})
+			},
+			want: []string{},
+		},
+		{
+			name:           "expansion backs off on the modification cooldown",
+			rootVolume:     &infrav1.Volume{Size: 16},
+			allowExpansion: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Any()).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-root"), Size: aws.Int64(8)}},
+					}, nil)
+				describeModifications(m)
+				m.ModifyVolume(gomock.Any()).
+					Return(nil, awserr.New(awserrors.VolumeModificationRateExceeded, "wait at least 6 hours between modifications", nil))
+			},
+			want: []string{},
+		},
+		{
+			name:           "expansion fails",
+			rootVolume:     &infrav1.Volume{Size: 16},
+			allowExpansion: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVolumes(gomock.Any()).
+					Return(&ec2.DescribeVolumesOutput{
+						Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-root"), Size: aws.Int64(8)}},
+					}, nil)
+				describeModifications(m)
+				m.ModifyVolume(gomock.Any()).
+					Return(nil, errors.New("an error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			got, err := s.ReconcileVolumes(instance, tc.rootVolume, nil, tc.allowExpansion)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("did not expect error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %v, expected %v", got, tc.want)
+			}
+		})
+	}
+}
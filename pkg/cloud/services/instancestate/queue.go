@@ -75,7 +75,7 @@ func (s *Service) createPolicyForRule(input *createPolicyForRuleInput) error {
 				Action:    v1alpha1.Actions{"sqs:SendMessage"},
 				Resource:  v1alpha1.Resources{input.QueueArn},
 				Condition: v1alpha1.Conditions{
-					"ArnEquals": map[string]string{"aws:SourceArn": input.RuleArn},
+					"ArnEquals": map[string][]string{"aws:SourceArn": input.RuleArns},
 				},
 			},
 		},
@@ -111,5 +111,5 @@ func queueNotFoundError(err error) bool {
 type createPolicyForRuleInput struct {
 	QueueArn string
 	QueueURL string
-	RuleArn  string
+	RuleArns []string
 }
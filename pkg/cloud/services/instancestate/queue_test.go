@@ -197,11 +197,11 @@ func TestCreatePolicyForRule(t *testing.T) {
 		expectErr bool
 	}{
 		{
-			name: "creates a policy for a given rule",
+			name: "creates a policy for the given rules",
 			input: &createPolicyForRuleInput{
 				QueueArn: "test-cluster-queue-arn",
 				QueueURL: "test-cluster-queue-url",
-				RuleArn:  "test-cluster-rule-arn",
+				RuleArns: []string{"test-cluster-rule-arn", "test-cluster-spot-rule-arn"},
 			},
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				buffer := new(bytes.Buffer)
@@ -282,7 +282,10 @@ const expectedPolicyJSON = `{
       ],
       "Condition": {
         "ArnEquals": {
-          "aws:SourceArn": "test-cluster-rule-arn"
+          "aws:SourceArn": [
+            "test-cluster-rule-arn",
+            "test-cluster-spot-rule-arn"
+          ]
         }
       }
     }
@@ -19,6 +19,8 @@ package instancestate
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
@@ -27,35 +29,33 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 )
 
-const Ec2StateChangeNotification = "EC2 Instance State-change Notification"
+const (
+	Ec2StateChangeNotification = "EC2 Instance State-change Notification"
+	// Ec2SpotInterruptionWarning is sent two minutes before a spot instance is interrupted.
+	Ec2SpotInterruptionWarning = "EC2 Spot Instance Interruption Warning"
+	// Ec2RebalanceRecommendation is sent when a spot instance is at an elevated risk of interruption.
+	Ec2RebalanceRecommendation = "EC2 Instance Rebalance Recommendation"
+	// AutoScalingTerminateLifecycleAction is sent when a lifecycle hook pauses the termination of an instance of an
+	// Auto Scaling group.
+	AutoScalingTerminateLifecycleAction = "EC2 Instance-terminate Lifecycle Action"
+)
 
 // reconcileRules creates rules and attaches the queue as a target
 func (s Service) reconcileRules() error {
-	var ruleNotFound bool
-	ruleResp, err := s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
-	})
-	if err != nil {
-		if resourceNotFoundError(err) {
-			ruleNotFound = true
-		} else {
-			return errors.Wrapf(err, "unable to describe rule %s", s.getEC2RuleName())
-		}
-	}
-
-	if ruleNotFound {
-		err = s.createRule()
-		if err != nil {
-			return errors.Wrap(err, "unable to create rule")
-		}
-		// fetch newly created rule
-		ruleResp, err = s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
-			Name: aws.String(s.getEC2RuleName()),
-		})
-
+	rules := []*eventbridge.DescribeRuleOutput{}
+	for _, pattern := range []struct {
+		name    string
+		pattern eventPattern
+	}{
+		{name: s.getEC2RuleName(), pattern: s.ec2EventPattern()},
+		{name: s.getSpotRuleName(), pattern: s.spotEventPattern()},
+		{name: s.getLifecycleRuleName(), pattern: s.lifecycleEventPattern()},
+	} {
+		ruleResp, err := s.reconcileRule(pattern.name, pattern.pattern)
 		if err != nil {
-			return errors.Wrapf(err, "unable to describe new rule %s", s.getEC2RuleName())
+			return err
 		}
+		rules = append(rules, ruleResp)
 	}
 
 	queueURLResp, err := s.SQSClient.GetQueueUrl(&sqs.GetQueueUrlInput{
@@ -74,63 +74,130 @@ func (s Service) reconcileRules() error {
 		return errors.Wrap(err, "unable to get queue attributes")
 	}
 
+	ruleArns := []string{}
+	for _, ruleResp := range rules {
+		if err := s.reconcileRuleTarget(ruleResp.Name, queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn]); err != nil {
+			return err
+		}
+		ruleArns = append(ruleArns, aws.StringValue(ruleResp.Arn))
+	}
+
+	if !queuePolicyAllowsRules(queueAttrs.Attributes[sqs.QueueAttributeNamePolicy], ruleArns) {
+		// add a policy for the rules so the rules are authorized to emit messages to the queue
+		err = s.createPolicyForRule(&createPolicyForRuleInput{
+			QueueArn: *queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn],
+			QueueURL: *queueURLResp.QueueUrl,
+			RuleArns: ruleArns,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileRule creates the rule with the given name if it doesn't exist yet.
+func (s Service) reconcileRule(name string, pattern eventPattern) (*eventbridge.DescribeRuleOutput, error) {
+	ruleResp, err := s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String(name),
+	})
+	if err == nil {
+		return ruleResp, nil
+	}
+	if !resourceNotFoundError(err) {
+		return nil, errors.Wrapf(err, "unable to describe rule %s", name)
+	}
+
+	if err := s.createRule(name, pattern); err != nil {
+		return nil, errors.Wrap(err, "unable to create rule")
+	}
+
+	// fetch newly created rule
+	ruleResp, err = s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to describe new rule %s", name)
+	}
+
+	return ruleResp, nil
+}
+
+// reconcileRuleTarget adds the queue as a target of the rule, if it isn't already.
+func (s Service) reconcileRuleTarget(ruleName, queueArn *string) error {
 	targetsResp, err := s.EventBridgeClient.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{
-		Rule: aws.String(s.getEC2RuleName()),
+		Rule: ruleName,
 	})
 	if err != nil {
-		return errors.Wrapf(err, "unable to list targets for rule %s", s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to list targets for rule %s", aws.StringValue(ruleName))
 	}
 
-	targetFound := false
 	for _, target := range targetsResp.Targets {
 		// check if queue is already added as a target
-		if *target.Id == GenerateQueueName(s.scope.Name()) && *target.Arn == *queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn] {
-			targetFound = true
+		if *target.Id == GenerateQueueName(s.scope.Name()) && *target.Arn == *queueArn {
+			return nil
 		}
 	}
 
-	if !targetFound {
-		_, err = s.EventBridgeClient.PutTargets(&eventbridge.PutTargetsInput{
-			Rule: ruleResp.Name,
-			Targets: []*eventbridge.Target{{
-				Arn: queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn],
-				Id:  aws.String(GenerateQueueName(s.scope.Name())),
-			}},
-		})
-
-		if err != nil {
-			return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
-		}
+	_, err = s.EventBridgeClient.PutTargets(&eventbridge.PutTargetsInput{
+		Rule: ruleName,
+		Targets: []*eventbridge.Target{{
+			Arn: queueArn,
+			Id:  aws.String(GenerateQueueName(s.scope.Name())),
+		}},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), aws.StringValue(ruleName))
 	}
 
-	if queueAttrs.Attributes[sqs.QueueAttributeNamePolicy] == nil {
-		// add a policy for the rule so the rule is authorized to emit messages to the queue
-		err = s.createPolicyForRule(&createPolicyForRuleInput{
-			QueueArn: *queueAttrs.Attributes[sqs.QueueAttributeNameQueueArn],
-			QueueURL: *queueURLResp.QueueUrl,
-			RuleArn:  *ruleResp.Arn,
-		})
-		if err != nil {
-			return err
+	return nil
+}
+
+// queuePolicyAllowsRules returns whether the queue policy authorizes all the rules to emit messages to the queue.
+// Queues of clusters created before the spot or lifecycle rules existed only authorize some of the rules.
+func queuePolicyAllowsRules(policy *string, ruleArns []string) bool {
+	if policy == nil {
+		return false
+	}
+	for _, arn := range ruleArns {
+		if !strings.Contains(*policy, arn) {
+			return false
 		}
 	}
-
-	return nil
+	return true
 }
 
-func (s Service) createRule() error {
-	eventPattern := eventPattern{
+func (s Service) ec2EventPattern() eventPattern {
+	return eventPattern{
 		Source:     []string{"aws.ec2"},
 		DetailType: []string{Ec2StateChangeNotification},
 		EventDetail: &eventDetail{
 			States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
 		},
 	}
-	data, _ := json.Marshal(eventPattern)
+}
+
+func (s Service) spotEventPattern() eventPattern {
+	return eventPattern{
+		Source:     []string{"aws.ec2"},
+		DetailType: []string{Ec2SpotInterruptionWarning, Ec2RebalanceRecommendation},
+	}
+}
+
+func (s Service) lifecycleEventPattern() eventPattern {
+	return eventPattern{
+		Source:     []string{"aws.autoscaling"},
+		DetailType: []string{AutoScalingTerminateLifecycleAction},
+	}
+}
+
+func (s Service) createRule(name string, pattern eventPattern) error {
+	data, _ := json.Marshal(pattern)
 	// create in disabled state so the rule doesn't pick up all EC2 instances. As machines get created,
 	// the rule will get updated to track those machines
 	_, err := s.EventBridgeClient.PutRule(&eventbridge.PutRuleInput{
-		Name:         aws.String(s.getEC2RuleName()),
+		Name:         aws.String(name),
 		EventPattern: aws.String(string(data)),
 		State:        aws.String(eventbridge.RuleStateDisabled),
 	})
@@ -139,15 +206,25 @@ func (s Service) createRule() error {
 }
 
 func (s Service) deleteRules() error {
+	for _, name := range []string{s.getEC2RuleName(), s.getSpotRuleName(), s.getLifecycleRuleName()} {
+		if err := s.deleteRule(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s Service) deleteRule(name string) error {
 	_, err := s.EventBridgeClient.RemoveTargets(&eventbridge.RemoveTargetsInput{
-		Rule: aws.String(s.getEC2RuleName()),
+		Rule: aws.String(name),
 		Ids:  aws.StringSlice([]string{GenerateQueueName(s.scope.Name())}),
 	})
 	if err != nil && !resourceNotFoundError(err) {
-		return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), name)
 	}
 	_, err = s.EventBridgeClient.DeleteRule(&eventbridge.DeleteRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(name),
 	})
 
 	if err != nil && resourceNotFoundError(err) {
@@ -158,33 +235,58 @@ func (s Service) deleteRules() error {
 }
 
 func (s Service) AddInstanceToEventPattern(instanceID string) error {
+	return s.addInstanceToRule(s.getEC2RuleName(), []string{Ec2StateChangeNotification}, instanceID)
+}
+
+// AddInstanceToSpotEventPattern updates the spot event rule to deliver spot interruption warnings and rebalance
+// recommendations of the instance.
+func (s Service) AddInstanceToSpotEventPattern(instanceID string) error {
+	return s.addInstanceToRule(s.getSpotRuleName(), []string{Ec2SpotInterruptionWarning, Ec2RebalanceRecommendation}, instanceID)
+}
+
+// AddAutoScalingGroupToLifecycleEventPattern updates the lifecycle event rule to deliver the termination lifecycle
+// actions of the Auto Scaling group.
+func (s Service) AddAutoScalingGroupToLifecycleEventPattern(name string) error {
+	return s.addToRule(s.getLifecycleRuleName(), []string{AutoScalingTerminateLifecycleAction}, autoScalingGroupNames, name)
+}
+
+func (s Service) addInstanceToRule(ruleName string, detailTypes []string, instanceID string) error {
+	return s.addToRule(ruleName, detailTypes, instanceIDs, instanceID)
+}
+
+// addToRule adds the value to the field of the event detail of the rule, and enables the rule.
+func (s Service) addToRule(ruleName string, detailTypes []string, field func(*eventDetail) *[]string, value string) error {
 	ruleResp, err := s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(ruleName),
 	})
 	if err != nil {
-		return errors.Wrapf(err, "unable to describe rule %s", s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to describe rule %s", ruleName)
 	}
 	e := eventPattern{}
 	err = json.Unmarshal([]byte(*ruleResp.EventPattern), &e)
 	if err != nil {
 		return err
 	}
-	e.DetailType = []string{Ec2StateChangeNotification}
+	e.DetailType = detailTypes
+	if e.EventDetail == nil {
+		e.EventDetail = &eventDetail{}
+	}
 
-	for _, r := range e.EventDetail.InstanceIDs {
-		if r == instanceID {
-			// instance is already tracked by rule
+	values := field(e.EventDetail)
+	for _, r := range *values {
+		if r == value {
+			// value is already tracked by rule
 			return nil
 		}
 	}
 
-	e.EventDetail.InstanceIDs = append(e.EventDetail.InstanceIDs, instanceID)
+	*values = append(*values, value)
 	eventData, err := json.Marshal(e)
 	if err != nil {
 		return err
 	}
 	_, err = s.EventBridgeClient.PutRule(&eventbridge.PutRuleInput{
-		Name:         aws.String(s.getEC2RuleName()),
+		Name:         aws.String(ruleName),
 		EventPattern: aws.String(string(eventData)),
 		State:        aws.String(eventbridge.RuleStateEnabled),
 	})
@@ -194,24 +296,47 @@ func (s Service) AddInstanceToEventPattern(instanceID string) error {
 // RemoveInstanceFromEventPattern attempts a best effort update to the event rule to remove the instance.
 // Any errors encountered won't be blocking.
 func (s Service) RemoveInstanceFromEventPattern(instanceID string) {
+	s.removeInstanceFromRule(s.getEC2RuleName(), []string{Ec2StateChangeNotification}, instanceID)
+}
+
+// RemoveInstanceFromSpotEventPattern attempts a best effort update to the spot event rule to remove the instance.
+// Any errors encountered won't be blocking.
+func (s Service) RemoveInstanceFromSpotEventPattern(instanceID string) {
+	s.removeInstanceFromRule(s.getSpotRuleName(), []string{Ec2SpotInterruptionWarning, Ec2RebalanceRecommendation}, instanceID)
+}
+
+// RemoveAutoScalingGroupFromLifecycleEventPattern attempts a best effort update to the lifecycle event rule to
+// remove the Auto Scaling group. Any errors encountered won't be blocking.
+func (s Service) RemoveAutoScalingGroupFromLifecycleEventPattern(name string) {
+	s.removeFromRule(s.getLifecycleRuleName(), []string{AutoScalingTerminateLifecycleAction}, autoScalingGroupNames, name)
+}
+
+func (s Service) removeInstanceFromRule(ruleName string, detailTypes []string, instanceID string) {
+	s.removeFromRule(ruleName, detailTypes, instanceIDs, instanceID)
+}
+
+// removeFromRule removes the value from the field of the event detail of the rule, and disables the rule once
+// it no longer tracks anything.
+func (s Service) removeFromRule(ruleName string, detailTypes []string, field func(*eventDetail) *[]string, value string) {
 	ruleResp, err := s.EventBridgeClient.DescribeRule(&eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(ruleName),
 	})
 	if err != nil {
 		return
 	}
 	e := eventPattern{}
 	err = json.Unmarshal([]byte(*ruleResp.EventPattern), &e)
-	if err != nil {
+	if err != nil || e.EventDetail == nil {
 		return
 	}
-	e.DetailType = []string{Ec2StateChangeNotification}
+	e.DetailType = detailTypes
 
+	values := field(e.EventDetail)
 	found := false
-	for i, r := range e.EventDetail.InstanceIDs {
-		if r == instanceID {
+	for i, r := range *values {
+		if r == value {
 			found = true
-			e.EventDetail.InstanceIDs = append(e.EventDetail.InstanceIDs[:i], e.EventDetail.InstanceIDs[i+1:]...)
+			*values = append((*values)[:i], (*values)[i+1:]...)
 			break
 		}
 	}
@@ -222,12 +347,12 @@ func (s Service) RemoveInstanceFromEventPattern(instanceID string) {
 			return
 		}
 		input := &eventbridge.PutRuleInput{
-			Name:         aws.String(s.getEC2RuleName()),
+			Name:         aws.String(ruleName),
 			EventPattern: aws.String(string(eventData)),
 			State:        aws.String(eventbridge.RuleStateEnabled),
 		}
 
-		if len(e.EventDetail.InstanceIDs) == 0 {
+		if len(*values) == 0 {
 			input.State = aws.String(eventbridge.RuleStateDisabled)
 		}
 		_, _ = s.EventBridgeClient.PutRule(input)
@@ -238,6 +363,14 @@ func (s Service) getEC2RuleName() string {
 	return fmt.Sprintf("%s-ec2-rule", s.scope.Name())
 }
 
+func (s Service) getSpotRuleName() string {
+	return fmt.Sprintf("%s-ec2-spot-rule", s.scope.Name())
+}
+
+func (s Service) getLifecycleRuleName() string {
+	return fmt.Sprintf("%s-asg-lifecycle-rule", s.scope.Name())
+}
+
 func resourceNotFoundError(err error) bool {
 	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == eventbridge.ErrCodeResourceNotFoundException {
 		return true
@@ -252,6 +385,15 @@ type eventPattern struct {
 }
 
 type eventDetail struct {
-	InstanceIDs []string                `json:"instance-id,omitempty"`
-	States      []infrav1.InstanceState `json:"state,omitempty"`
+	InstanceIDs           []string                `json:"instance-id,omitempty"`
+	States                []infrav1.InstanceState `json:"state,omitempty"`
+	AutoScalingGroupNames []string                `json:"AutoScalingGroupName,omitempty"`
+}
+
+func instanceIDs(d *eventDetail) *[]string {
+	return &d.InstanceIDs
+}
+
+func autoScalingGroupNames(d *eventDetail) *[]string {
+	return &d.AutoScalingGroupNames
 }
@@ -35,6 +35,8 @@ func TestReconcileRules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	ruleName := "test-cluster-ec2-rule"
+	spotRuleName := "test-cluster-ec2-spot-rule"
+	lifecycleRuleName := "test-cluster-asg-lifecycle-rule"
 
 	testCases := []struct {
 		name                        string
@@ -62,6 +64,32 @@ func TestReconcileRules(t *testing.T) {
 					State:        aws.String(eventbridge.RuleStateDisabled),
 					EventPattern: aws.String(string(data)),
 				}))
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(spotRuleName),
+				})).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+				spotPattern := &eventPattern{
+					Source:     []string{"aws.ec2"},
+					DetailType: []string{Ec2SpotInterruptionWarning, Ec2RebalanceRecommendation},
+				}
+				spotData, _ := json.Marshal(spotPattern)
+				m.PutRule(gomock.Eq(&eventbridge.PutRuleInput{
+					Name:         aws.String(spotRuleName),
+					State:        aws.String(eventbridge.RuleStateDisabled),
+					EventPattern: aws.String(string(spotData)),
+				}))
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(lifecycleRuleName),
+				})).Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+				lifecyclePattern := &eventPattern{
+					Source:     []string{"aws.autoscaling"},
+					DetailType: []string{AutoScalingTerminateLifecycleAction},
+				}
+				lifecycleData, _ := json.Marshal(lifecyclePattern)
+				m.PutRule(gomock.Eq(&eventbridge.PutRuleInput{
+					Name:         aws.String(lifecycleRuleName),
+					State:        aws.String(eventbridge.RuleStateDisabled),
+					EventPattern: aws.String(string(lifecycleData)),
+				}))
 			},
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
@@ -82,6 +110,32 @@ func TestReconcileRules(t *testing.T) {
 						Id:  aws.String("test-cluster-queue"),
 					}},
 				}))
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(spotRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(spotRuleName), Arn: aws.String("spot-rule-arn")}, nil)
+				m.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{
+					Rule: aws.String(spotRuleName),
+				}).Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+				m.PutTargets(gomock.Eq(&eventbridge.PutTargetsInput{
+					Rule: aws.String(spotRuleName),
+					Targets: []*eventbridge.Target{{
+						Arn: aws.String("test-cluster-queue-arn"),
+						Id:  aws.String("test-cluster-queue"),
+					}},
+				}))
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(lifecycleRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(lifecycleRuleName), Arn: aws.String("lifecycle-rule-arn")}, nil)
+				m.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{
+					Rule: aws.String(lifecycleRuleName),
+				}).Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+				m.PutTargets(gomock.Eq(&eventbridge.PutTargetsInput{
+					Rule: aws.String(lifecycleRuleName),
+					Targets: []*eventbridge.Target{{
+						Arn: aws.String("test-cluster-queue-arn"),
+						Id:  aws.String("test-cluster-queue"),
+					}},
+				}))
 			},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(gomock.Eq(&sqs.GetQueueUrlInput{
@@ -103,22 +157,57 @@ func TestReconcileRules(t *testing.T) {
 				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
 					Name: aws.String(ruleName),
 				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(spotRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(spotRuleName), Arn: aws.String("spot-rule-arn")}, nil)
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(lifecycleRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(lifecycleRuleName), Arn: aws.String("lifecycle-rule-arn")}, nil)
 				m.ListTargetsByRule(gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
 					Targets: []*eventbridge.Target{{
 						Id:  aws.String("test-cluster-queue"),
 						Arn: aws.String("test-cluster-queue-arn"),
 					}},
-				}, nil)
+				}, nil).Times(3)
 			},
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(gomock.AssignableToTypeOf(&sqs.GetQueueUrlInput{})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
 				attrs := make(map[string]string)
 				attrs[sqs.QueueAttributeNameQueueArn] = "test-cluster-queue-arn"
-				attrs[sqs.QueueAttributeNamePolicy] = "some policy"
+				attrs[sqs.QueueAttributeNamePolicy] = `{"Condition":{"ArnEquals":{"aws:SourceArn":["rule-arn","spot-rule-arn","lifecycle-rule-arn"]}}}`
 				m.GetQueueAttributes(gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: aws.StringMap(attrs)}, nil)
 			},
 		},
+		{
+			name: "updates queue policy that doesn't authorize the spot rule",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(ruleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(spotRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(spotRuleName), Arn: aws.String("spot-rule-arn")}, nil)
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(lifecycleRuleName),
+				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(lifecycleRuleName), Arn: aws.String("lifecycle-rule-arn")}, nil)
+				m.ListTargetsByRule(gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []*eventbridge.Target{{
+						Id:  aws.String("test-cluster-queue"),
+						Arn: aws.String("test-cluster-queue-arn"),
+					}},
+				}, nil).Times(3)
+			},
+			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.AssignableToTypeOf(&sqs.GetQueueUrlInput{})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
+				attrs := make(map[string]string)
+				attrs[sqs.QueueAttributeNameQueueArn] = "test-cluster-queue-arn"
+				attrs[sqs.QueueAttributeNamePolicy] = `{"Condition":{"ArnEquals":{"aws:SourceArn":"rule-arn"}}}`
+				m.GetQueueAttributes(gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: aws.StringMap(attrs)}, nil)
+				m.SetQueueAttributes(gomock.AssignableToTypeOf(&sqs.SetQueueAttributesInput{})).Return(nil, nil)
+			},
+		},
 		{
 			name: "returns error if DescribeRule runs into unexpected error",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
@@ -167,7 +256,7 @@ func TestDeleteRules(t *testing.T) {
 		expectErr         bool
 	}{
 		{
-			name: "removes target and ec2 rule successfully when they both exist",
+			name: "removes targets and ec2 rules successfully when they both exist",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.RemoveTargets(gomock.Eq(&eventbridge.RemoveTargetsInput{
 					Rule: aws.String("test-cluster-ec2-rule"),
@@ -176,6 +265,20 @@ func TestDeleteRules(t *testing.T) {
 				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				})).Return(nil, nil)
+				m.RemoveTargets(gomock.Eq(&eventbridge.RemoveTargetsInput{
+					Rule: aws.String("test-cluster-ec2-spot-rule"),
+					Ids:  aws.StringSlice([]string{"test-cluster-queue"}),
+				})).Return(nil, nil)
+				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-ec2-spot-rule"),
+				})).Return(nil, nil)
+				m.RemoveTargets(gomock.Eq(&eventbridge.RemoveTargetsInput{
+					Rule: aws.String("test-cluster-asg-lifecycle-rule"),
+					Ids:  aws.StringSlice([]string{"test-cluster-queue"}),
+				})).Return(nil, nil)
+				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-asg-lifecycle-rule"),
+				})).Return(nil, nil)
 			},
 			expectErr: false,
 		},
@@ -183,10 +286,16 @@ func TestDeleteRules(t *testing.T) {
 			name: "continues to remove rule when target doesn't exist",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.RemoveTargets(gomock.AssignableToTypeOf(&eventbridge.RemoveTargetsInput{})).
-					Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil))
+					Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "", nil)).Times(3)
 				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
 				})).Return(nil, nil)
+				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-ec2-spot-rule"),
+				})).Return(nil, nil)
+				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-asg-lifecycle-rule"),
+				})).Return(nil, nil)
 			},
 			expectErr: false,
 		},
@@ -301,6 +410,118 @@ func TestAddInstanceToRule(t *testing.T) {
 	}
 }
 
+func TestAddInstanceToSpotRule(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// A new spot rule has no instance IDs in its pattern yet.
+	pattern := eventPattern{
+		Source:     []string{"aws.ec2"},
+		DetailType: []string{Ec2SpotInterruptionWarning, Ec2RebalanceRecommendation},
+	}
+	patternData, _ := json.Marshal(pattern)
+
+	eventbridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-ec2-spot-rule"),
+	}).Return(&eventbridge.DescribeRuleOutput{
+		EventPattern: aws.String(string(patternData)),
+	}, nil)
+	expectedPattern := pattern
+	expectedPattern.EventDetail = &eventDetail{InstanceIDs: []string{"instance-a"}}
+	expectedData, _ := json.Marshal(expectedPattern)
+	eventbridgeMock.EXPECT().PutRule(&eventbridge.PutRuleInput{
+		Name:         aws.String("test-cluster-ec2-spot-rule"),
+		EventPattern: aws.String(string(expectedData)),
+		State:        aws.String(eventbridge.RuleStateEnabled),
+	}).Return(nil, nil)
+
+	clusterScope, err := setupCluster("test-cluster")
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	s := NewService(clusterScope)
+	s.EventBridgeClient = eventbridgeMock
+
+	g.Expect(s.AddInstanceToSpotEventPattern("instance-a")).To(Succeed())
+}
+
+func TestAddAutoScalingGroupToLifecycleRule(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pattern := eventPattern{
+		Source:     []string{"aws.autoscaling"},
+		DetailType: []string{AutoScalingTerminateLifecycleAction},
+		EventDetail: &eventDetail{
+			AutoScalingGroupNames: []string{"asg-a"},
+		},
+	}
+	patternData, _ := json.Marshal(pattern)
+
+	eventbridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-asg-lifecycle-rule"),
+	}).Return(&eventbridge.DescribeRuleOutput{
+		EventPattern: aws.String(string(patternData)),
+	}, nil)
+	expectedPattern := pattern
+	expectedPattern.EventDetail = &eventDetail{AutoScalingGroupNames: []string{"asg-a", "asg-b"}}
+	expectedData, _ := json.Marshal(expectedPattern)
+	eventbridgeMock.EXPECT().PutRule(&eventbridge.PutRuleInput{
+		Name:         aws.String("test-cluster-asg-lifecycle-rule"),
+		EventPattern: aws.String(string(expectedData)),
+		State:        aws.String(eventbridge.RuleStateEnabled),
+	}).Return(nil, nil)
+
+	clusterScope, err := setupCluster("test-cluster")
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	s := NewService(clusterScope)
+	s.EventBridgeClient = eventbridgeMock
+
+	g.Expect(s.AddAutoScalingGroupToLifecycleEventPattern("asg-b")).To(Succeed())
+}
+
+func TestRemoveAutoScalingGroupFromLifecycleRule(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pattern := eventPattern{
+		Source:     []string{"aws.autoscaling"},
+		DetailType: []string{AutoScalingTerminateLifecycleAction},
+		EventDetail: &eventDetail{
+			AutoScalingGroupNames: []string{"asg-a"},
+		},
+	}
+	patternData, _ := json.Marshal(pattern)
+
+	eventbridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+	eventbridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String("test-cluster-asg-lifecycle-rule"),
+	}).Return(&eventbridge.DescribeRuleOutput{
+		EventPattern: aws.String(string(patternData)),
+	}, nil)
+	expectedPattern := pattern
+	expectedPattern.EventDetail = &eventDetail{AutoScalingGroupNames: []string{}}
+	expectedData, _ := json.Marshal(expectedPattern)
+	eventbridgeMock.EXPECT().PutRule(&eventbridge.PutRuleInput{
+		Name:         aws.String("test-cluster-asg-lifecycle-rule"),
+		EventPattern: aws.String(string(expectedData)),
+		State:        aws.String(eventbridge.RuleStateDisabled),
+	}).Return(nil, nil)
+
+	clusterScope, err := setupCluster("test-cluster")
+	g.Expect(err).To(Not(HaveOccurred()))
+
+	s := NewService(clusterScope)
+	s.EventBridgeClient = eventbridgeMock
+
+	s.RemoveAutoScalingGroupFromLifecycleEventPattern("asg-a")
+}
+
 func TestRemoveInstanceStateFromEventPattern(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -17,6 +17,8 @@ limitations under the License.
 package services
 
 import (
+	"time"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
@@ -36,8 +38,17 @@ type ASGInterface interface {
 	GetASGByName(scope *scope.MachinePoolScope) (*expinfrav1.AutoScalingGroup, error)
 	CreateASG(scope *scope.MachinePoolScope) (*expinfrav1.AutoScalingGroup, error)
 	UpdateASG(scope *scope.MachinePoolScope) error
-	StartASGInstanceRefresh(scope *scope.MachinePoolScope) error
+	UpdateWarmPool(scope *scope.MachinePoolScope) error
+	DescribeWarmPoolInstances(name string) ([]infrav1.Instance, error)
+	ReconcileLifecycleHooks(scope *scope.MachinePoolScope) error
+	ReconcileScheduledActions(scope *scope.MachinePoolScope) error
+	ReconcileScalingPolicies(scope *scope.MachinePoolScope) (map[string]string, error)
+	StartASGInstanceRefresh(scope *scope.MachinePoolScope) (string, error)
 	CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (bool, error)
+	DescribeLatestInstanceRefresh(scope *scope.MachinePoolScope) (*expinfrav1.InstanceRefreshStatus, error)
+	CancelASGInstanceRefresh(scope *scope.MachinePoolScope) error
+	HasFailedLaunchActivities(scope *scope.MachinePoolScope, since time.Time) (bool, error)
+	TerminateASGInstance(instanceID string) error
 	UpdateResourceTags(resourceID *string, create, remove map[string]string) error
 	DeleteASGAndWait(id string) error
 }
@@ -47,14 +58,23 @@ type ASGInterface interface {
 type EC2MachineInterface interface {
 	InstanceIfExists(id *string) (*infrav1.Instance, error)
 	TerminateInstance(id string) error
+	StopInstance(id string) error
+	StartInstance(id string) error
+	GetTerminationProtection(instanceID string) (bool, error)
+	SetTerminationProtection(instanceID string, enabled bool) error
+	OrphanInstance(instanceID string) error
 	CreateInstance(scope *scope.MachineScope, userData []byte) (*infrav1.Instance, error)
 	GetRunningInstanceByTags(scope *scope.MachineScope) (*infrav1.Instance, error)
+	GetConsoleOutput(instanceID string) (string, error)
+	DescribeInstanceHealth(instanceIDs []string) (map[string]*infrav1.InstanceHealth, error)
 
 	GetCoreSecurityGroups(machine *scope.MachineScope) ([]string, error)
 	GetInstanceSecurityGroups(instanceID string) (map[string][]string, error)
 	GetFilteredSecurityGroupID(securityGroup infrav1.AWSResourceReference) (string, error)
 	UpdateInstanceSecurityGroups(id string, securityGroups []string) error
 	UpdateResourceTags(resourceID *string, create, remove map[string]string) error
+	ReconcileVolumes(instance *infrav1.Instance, rootVolume *infrav1.Volume, nonRootVolumes []*infrav1.Volume, allowExpansion bool) ([]string, error)
+	ReconcileSourceDestCheck(specs []infrav1.NetworkInterfaceSpec, attached []infrav1.NetworkInterfaceStatus) error
 
 	TerminateInstanceAndWait(instanceID string) error
 	DetachSecurityGroupsFromNetworkInterface(groups []string, interfaceID string) error
@@ -64,9 +84,17 @@ type EC2MachineInterface interface {
 	CreateLaunchTemplate(scope *scope.MachinePoolScope, imageID *string, userData []byte) (string, error)
 	CreateLaunchTemplateVersion(scope *scope.MachinePoolScope, imageID *string, userData []byte) error
 	DeleteLaunchTemplate(id string) error
+	RollbackLaunchTemplateVersion(scope *scope.MachinePoolScope, version int64) error
 	LaunchTemplateNeedsUpdate(scope *scope.MachinePoolScope, incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) (bool, error)
 }
 
+// ElasticIPInterface encapsulates the methods exposed to the machine
+// actuator to manage the Elastic IP of an instance
+type ElasticIPInterface interface {
+	ReconcileElasticIP(instanceID, machineName, role string, eip *infrav1.ElasticIP) (string, error)
+	ReleaseElasticIP(instanceID, machineName string) error
+}
+
 // SecretInterface encapsulated the methods exposed to the
 // machine actuator
 type SecretInterface interface {
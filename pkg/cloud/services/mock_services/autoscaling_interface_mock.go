@@ -23,8 +23,10 @@ package mock_services
 import (
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
-	v1alpha3 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
+	v1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	v1alpha30 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1alpha3"
 	scope "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	time "time"
 )
 
 // MockASGInterface is a mock of ASGInterface interface
@@ -51,10 +53,10 @@ func (m *MockASGInterface) EXPECT() *MockASGInterfaceMockRecorder {
 }
 
 // ASGIfExists mocks base method
-func (m *MockASGInterface) ASGIfExists(arg0 *string) (*v1alpha3.AutoScalingGroup, error) {
+func (m *MockASGInterface) ASGIfExists(arg0 *string) (*v1alpha30.AutoScalingGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ASGIfExists", arg0)
-	ret0, _ := ret[0].(*v1alpha3.AutoScalingGroup)
+	ret0, _ := ret[0].(*v1alpha30.AutoScalingGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -80,11 +82,25 @@ func (mr *MockASGInterfaceMockRecorder) CanStartASGInstanceRefresh(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanStartASGInstanceRefresh", reflect.TypeOf((*MockASGInterface)(nil).CanStartASGInstanceRefresh), arg0)
 }
 
+// CancelASGInstanceRefresh mocks base method
+func (m *MockASGInterface) CancelASGInstanceRefresh(arg0 *scope.MachinePoolScope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelASGInstanceRefresh", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelASGInstanceRefresh indicates an expected call of CancelASGInstanceRefresh
+func (mr *MockASGInterfaceMockRecorder) CancelASGInstanceRefresh(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelASGInstanceRefresh", reflect.TypeOf((*MockASGInterface)(nil).CancelASGInstanceRefresh), arg0)
+}
+
 // CreateASG mocks base method
-func (m *MockASGInterface) CreateASG(arg0 *scope.MachinePoolScope) (*v1alpha3.AutoScalingGroup, error) {
+func (m *MockASGInterface) CreateASG(arg0 *scope.MachinePoolScope) (*v1alpha30.AutoScalingGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateASG", arg0)
-	ret0, _ := ret[0].(*v1alpha3.AutoScalingGroup)
+	ret0, _ := ret[0].(*v1alpha30.AutoScalingGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -109,11 +125,41 @@ func (mr *MockASGInterfaceMockRecorder) DeleteASGAndWait(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteASGAndWait", reflect.TypeOf((*MockASGInterface)(nil).DeleteASGAndWait), arg0)
 }
 
+// DescribeLatestInstanceRefresh mocks base method
+func (m *MockASGInterface) DescribeLatestInstanceRefresh(arg0 *scope.MachinePoolScope) (*v1alpha30.InstanceRefreshStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLatestInstanceRefresh", arg0)
+	ret0, _ := ret[0].(*v1alpha30.InstanceRefreshStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLatestInstanceRefresh indicates an expected call of DescribeLatestInstanceRefresh
+func (mr *MockASGInterfaceMockRecorder) DescribeLatestInstanceRefresh(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLatestInstanceRefresh", reflect.TypeOf((*MockASGInterface)(nil).DescribeLatestInstanceRefresh), arg0)
+}
+
+// DescribeWarmPoolInstances mocks base method
+func (m *MockASGInterface) DescribeWarmPoolInstances(arg0 string) ([]v1alpha3.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWarmPoolInstances", arg0)
+	ret0, _ := ret[0].([]v1alpha3.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWarmPoolInstances indicates an expected call of DescribeWarmPoolInstances
+func (mr *MockASGInterfaceMockRecorder) DescribeWarmPoolInstances(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWarmPoolInstances", reflect.TypeOf((*MockASGInterface)(nil).DescribeWarmPoolInstances), arg0)
+}
+
 // GetASGByName mocks base method
-func (m *MockASGInterface) GetASGByName(arg0 *scope.MachinePoolScope) (*v1alpha3.AutoScalingGroup, error) {
+func (m *MockASGInterface) GetASGByName(arg0 *scope.MachinePoolScope) (*v1alpha30.AutoScalingGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetASGByName", arg0)
-	ret0, _ := ret[0].(*v1alpha3.AutoScalingGroup)
+	ret0, _ := ret[0].(*v1alpha30.AutoScalingGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -124,20 +170,93 @@ func (mr *MockASGInterfaceMockRecorder) GetASGByName(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetASGByName", reflect.TypeOf((*MockASGInterface)(nil).GetASGByName), arg0)
 }
 
-// StartASGInstanceRefresh mocks base method
-func (m *MockASGInterface) StartASGInstanceRefresh(arg0 *scope.MachinePoolScope) error {
+// HasFailedLaunchActivities mocks base method
+func (m *MockASGInterface) HasFailedLaunchActivities(arg0 *scope.MachinePoolScope, arg1 time.Time) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartASGInstanceRefresh", arg0)
+	ret := m.ctrl.Call(m, "HasFailedLaunchActivities", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasFailedLaunchActivities indicates an expected call of HasFailedLaunchActivities
+func (mr *MockASGInterfaceMockRecorder) HasFailedLaunchActivities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFailedLaunchActivities", reflect.TypeOf((*MockASGInterface)(nil).HasFailedLaunchActivities), arg0, arg1)
+}
+
+// ReconcileLifecycleHooks mocks base method
+func (m *MockASGInterface) ReconcileLifecycleHooks(arg0 *scope.MachinePoolScope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileLifecycleHooks", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// ReconcileLifecycleHooks indicates an expected call of ReconcileLifecycleHooks
+func (mr *MockASGInterfaceMockRecorder) ReconcileLifecycleHooks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileLifecycleHooks", reflect.TypeOf((*MockASGInterface)(nil).ReconcileLifecycleHooks), arg0)
+}
+
+// ReconcileScalingPolicies mocks base method
+func (m *MockASGInterface) ReconcileScalingPolicies(arg0 *scope.MachinePoolScope) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileScalingPolicies", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileScalingPolicies indicates an expected call of ReconcileScalingPolicies
+func (mr *MockASGInterfaceMockRecorder) ReconcileScalingPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileScalingPolicies", reflect.TypeOf((*MockASGInterface)(nil).ReconcileScalingPolicies), arg0)
+}
+
+// ReconcileScheduledActions mocks base method
+func (m *MockASGInterface) ReconcileScheduledActions(arg0 *scope.MachinePoolScope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileScheduledActions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileScheduledActions indicates an expected call of ReconcileScheduledActions
+func (mr *MockASGInterfaceMockRecorder) ReconcileScheduledActions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileScheduledActions", reflect.TypeOf((*MockASGInterface)(nil).ReconcileScheduledActions), arg0)
+}
+
+// StartASGInstanceRefresh mocks base method
+func (m *MockASGInterface) StartASGInstanceRefresh(arg0 *scope.MachinePoolScope) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartASGInstanceRefresh", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // StartASGInstanceRefresh indicates an expected call of StartASGInstanceRefresh
 func (mr *MockASGInterfaceMockRecorder) StartASGInstanceRefresh(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartASGInstanceRefresh", reflect.TypeOf((*MockASGInterface)(nil).StartASGInstanceRefresh), arg0)
 }
 
+// TerminateASGInstance mocks base method
+func (m *MockASGInterface) TerminateASGInstance(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateASGInstance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateASGInstance indicates an expected call of TerminateASGInstance
+func (mr *MockASGInterfaceMockRecorder) TerminateASGInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateASGInstance", reflect.TypeOf((*MockASGInterface)(nil).TerminateASGInstance), arg0)
+}
+
 // UpdateASG mocks base method
 func (m *MockASGInterface) UpdateASG(arg0 *scope.MachinePoolScope) error {
 	m.ctrl.T.Helper()
@@ -165,3 +284,17 @@ func (mr *MockASGInterfaceMockRecorder) UpdateResourceTags(arg0, arg1, arg2 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceTags", reflect.TypeOf((*MockASGInterface)(nil).UpdateResourceTags), arg0, arg1, arg2)
 }
+
+// UpdateWarmPool mocks base method
+func (m *MockASGInterface) UpdateWarmPool(arg0 *scope.MachinePoolScope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWarmPool", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWarmPool indicates an expected call of UpdateWarmPool
+func (mr *MockASGInterfaceMockRecorder) UpdateWarmPool(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWarmPool", reflect.TypeOf((*MockASGInterface)(nil).UpdateWarmPool), arg0)
+}
@@ -109,6 +109,21 @@ func (mr *MockEC2MachineInterfaceMockRecorder) DeleteLaunchTemplate(arg0 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLaunchTemplate", reflect.TypeOf((*MockEC2MachineInterface)(nil).DeleteLaunchTemplate), arg0)
 }
 
+// DescribeInstanceHealth mocks base method
+func (m *MockEC2MachineInterface) DescribeInstanceHealth(arg0 []string) (map[string]*v1alpha3.InstanceHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceHealth", arg0)
+	ret0, _ := ret[0].(map[string]*v1alpha3.InstanceHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstanceHealth indicates an expected call of DescribeInstanceHealth
+func (mr *MockEC2MachineInterfaceMockRecorder) DescribeInstanceHealth(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceHealth", reflect.TypeOf((*MockEC2MachineInterface)(nil).DescribeInstanceHealth), arg0)
+}
+
 // DetachSecurityGroupsFromNetworkInterface mocks base method
 func (m *MockEC2MachineInterface) DetachSecurityGroupsFromNetworkInterface(arg0 []string, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -138,6 +153,21 @@ func (mr *MockEC2MachineInterfaceMockRecorder) DiscoverLaunchTemplateAMI(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverLaunchTemplateAMI", reflect.TypeOf((*MockEC2MachineInterface)(nil).DiscoverLaunchTemplateAMI), arg0)
 }
 
+// GetConsoleOutput mocks base method
+func (m *MockEC2MachineInterface) GetConsoleOutput(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsoleOutput", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsoleOutput indicates an expected call of GetConsoleOutput
+func (mr *MockEC2MachineInterfaceMockRecorder) GetConsoleOutput(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleOutput", reflect.TypeOf((*MockEC2MachineInterface)(nil).GetConsoleOutput), arg0)
+}
+
 // GetCoreSecurityGroups mocks base method
 func (m *MockEC2MachineInterface) GetCoreSecurityGroups(arg0 *scope.MachineScope) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +243,21 @@ func (mr *MockEC2MachineInterfaceMockRecorder) GetRunningInstanceByTags(arg0 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningInstanceByTags", reflect.TypeOf((*MockEC2MachineInterface)(nil).GetRunningInstanceByTags), arg0)
 }
 
+// GetTerminationProtection mocks base method
+func (m *MockEC2MachineInterface) GetTerminationProtection(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerminationProtection", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTerminationProtection indicates an expected call of GetTerminationProtection
+func (mr *MockEC2MachineInterfaceMockRecorder) GetTerminationProtection(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerminationProtection", reflect.TypeOf((*MockEC2MachineInterface)(nil).GetTerminationProtection), arg0)
+}
+
 // InstanceIfExists mocks base method
 func (m *MockEC2MachineInterface) InstanceIfExists(arg0 *string) (*v1alpha3.Instance, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +288,105 @@ func (mr *MockEC2MachineInterfaceMockRecorder) LaunchTemplateNeedsUpdate(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LaunchTemplateNeedsUpdate", reflect.TypeOf((*MockEC2MachineInterface)(nil).LaunchTemplateNeedsUpdate), arg0, arg1, arg2)
 }
 
+// OrphanInstance mocks base method
+func (m *MockEC2MachineInterface) OrphanInstance(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrphanInstance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrphanInstance indicates an expected call of OrphanInstance
+func (mr *MockEC2MachineInterfaceMockRecorder) OrphanInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrphanInstance", reflect.TypeOf((*MockEC2MachineInterface)(nil).OrphanInstance), arg0)
+}
+
+// ReconcileSourceDestCheck mocks base method
+func (m *MockEC2MachineInterface) ReconcileSourceDestCheck(arg0 []v1alpha3.NetworkInterfaceSpec, arg1 []v1alpha3.NetworkInterfaceStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileSourceDestCheck", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileSourceDestCheck indicates an expected call of ReconcileSourceDestCheck
+func (mr *MockEC2MachineInterfaceMockRecorder) ReconcileSourceDestCheck(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileSourceDestCheck", reflect.TypeOf((*MockEC2MachineInterface)(nil).ReconcileSourceDestCheck), arg0, arg1)
+}
+
+// ReconcileVolumes mocks base method
+func (m *MockEC2MachineInterface) ReconcileVolumes(arg0 *v1alpha3.Instance, arg1 *v1alpha3.Volume, arg2 []*v1alpha3.Volume, arg3 bool) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileVolumes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileVolumes indicates an expected call of ReconcileVolumes
+func (mr *MockEC2MachineInterfaceMockRecorder) ReconcileVolumes(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileVolumes", reflect.TypeOf((*MockEC2MachineInterface)(nil).ReconcileVolumes), arg0, arg1, arg2, arg3)
+}
+
+// RollbackLaunchTemplateVersion mocks base method
+func (m *MockEC2MachineInterface) RollbackLaunchTemplateVersion(arg0 *scope.MachinePoolScope, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackLaunchTemplateVersion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackLaunchTemplateVersion indicates an expected call of RollbackLaunchTemplateVersion
+func (mr *MockEC2MachineInterfaceMockRecorder) RollbackLaunchTemplateVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackLaunchTemplateVersion", reflect.TypeOf((*MockEC2MachineInterface)(nil).RollbackLaunchTemplateVersion), arg0, arg1)
+}
+
+// SetTerminationProtection mocks base method
+func (m *MockEC2MachineInterface) SetTerminationProtection(arg0 string, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTerminationProtection", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTerminationProtection indicates an expected call of SetTerminationProtection
+func (mr *MockEC2MachineInterfaceMockRecorder) SetTerminationProtection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTerminationProtection", reflect.TypeOf((*MockEC2MachineInterface)(nil).SetTerminationProtection), arg0, arg1)
+}
+
+// StartInstance mocks base method
+func (m *MockEC2MachineInterface) StartInstance(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartInstance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartInstance indicates an expected call of StartInstance
+func (mr *MockEC2MachineInterfaceMockRecorder) StartInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInstance", reflect.TypeOf((*MockEC2MachineInterface)(nil).StartInstance), arg0)
+}
+
+// StopInstance mocks base method
+func (m *MockEC2MachineInterface) StopInstance(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopInstance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopInstance indicates an expected call of StopInstance
+func (mr *MockEC2MachineInterfaceMockRecorder) StopInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInstance", reflect.TypeOf((*MockEC2MachineInterface)(nil).StopInstance), arg0)
+}
+
 // TerminateInstance mocks base method
 func (m *MockEC2MachineInterface) TerminateInstance(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,79 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services (interfaces: ElasticIPInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	v1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+// MockElasticIPInterface is a mock of ElasticIPInterface interface
+type MockElasticIPInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockElasticIPInterfaceMockRecorder
+}
+
+// MockElasticIPInterfaceMockRecorder is the mock recorder for MockElasticIPInterface
+type MockElasticIPInterfaceMockRecorder struct {
+	mock *MockElasticIPInterface
+}
+
+// NewMockElasticIPInterface creates a new mock instance
+func NewMockElasticIPInterface(ctrl *gomock.Controller) *MockElasticIPInterface {
+	mock := &MockElasticIPInterface{ctrl: ctrl}
+	mock.recorder = &MockElasticIPInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockElasticIPInterface) EXPECT() *MockElasticIPInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ReconcileElasticIP mocks base method
+func (m *MockElasticIPInterface) ReconcileElasticIP(arg0, arg1, arg2 string, arg3 *v1alpha3.ElasticIP) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileElasticIP", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileElasticIP indicates an expected call of ReconcileElasticIP
+func (mr *MockElasticIPInterfaceMockRecorder) ReconcileElasticIP(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileElasticIP", reflect.TypeOf((*MockElasticIPInterface)(nil).ReconcileElasticIP), arg0, arg1, arg2, arg3)
+}
+
+// ReleaseElasticIP mocks base method
+func (m *MockElasticIPInterface) ReleaseElasticIP(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseElasticIP", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseElasticIP indicates an expected call of ReleaseElasticIP
+func (mr *MockElasticIPInterfaceMockRecorder) ReleaseElasticIP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseElasticIP", reflect.TypeOf((*MockElasticIPInterface)(nil).ReleaseElasticIP), arg0, arg1)
+}
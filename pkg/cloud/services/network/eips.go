@@ -55,8 +55,13 @@ func (s *Service) getOrAllocateAddresses(num int, role string) (eips []string, e
 }
 
 func (s *Service) allocateAddress(role string) (string, error) {
+	return s.allocateNamedAddress(fmt.Sprintf("%s-eip-%s", s.scope.Name(), role), role, nil)
+}
+
+func (s *Service) allocateNamedAddress(name, role string, publicIPv4Pool *string) (string, error) {
 	out, err := s.EC2Client.AllocateAddress(&ec2.AllocateAddressInput{
-		Domain: aws.String("vpc"),
+		Domain:         aws.String("vpc"),
+		PublicIpv4Pool: publicIPv4Pool,
 	})
 	if err != nil {
 		record.Warnf(s.scope.InfraCluster(), "FailedAllocateEIP", "Failed to allocate Elastic IP for %q: %v", role, err)
@@ -64,7 +69,7 @@ func (s *Service) allocateAddress(role string) (string, error) {
 	}
 
 	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-		buildParams := s.getEIPTagParams(*out.AllocationId, role)
+		buildParams := s.getEIPTagParams(*out.AllocationId, name, role)
 		tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
 		if err := tagsBuilder.Apply(); err != nil {
 			return false, err
@@ -118,43 +123,174 @@ func (s *Service) releaseAddresses() error {
 	}
 
 	for i := range out.Addresses {
-		ip := out.Addresses[i]
-		if ip.AssociationId != nil {
-			_, err := s.EC2Client.DisassociateAddress(&ec2.DisassociateAddressInput{
-				AssociationId: ip.AssociationId,
-			})
-			if err != nil {
-				record.Warnf(s.scope.InfraCluster(), "FailedDisassociateEIP", "Failed to disassociate Elastic IP %q: %v", *ip.AllocationId, err)
-				return errors.Errorf("failed to disassociate Elastic IP %q with allocation ID %q: Still associated with association ID %q", *ip.PublicIp, *ip.AllocationId, *ip.AssociationId)
-			}
+		if err := s.releaseAddress(out.Addresses[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) releaseAddress(ip *ec2.Address) error {
+	if ip.AssociationId != nil {
+		_, err := s.EC2Client.DisassociateAddress(&ec2.DisassociateAddressInput{
+			AssociationId: ip.AssociationId,
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDisassociateEIP", "Failed to disassociate Elastic IP %q: %v", *ip.AllocationId, err)
+			return errors.Errorf("failed to disassociate Elastic IP %q with allocation ID %q: Still associated with association ID %q", *ip.PublicIp, *ip.AllocationId, *ip.AssociationId)
 		}
+	}
 
-		err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-			_, err := s.EC2Client.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: ip.AllocationId})
-			if err != nil {
-				if ip.AssociationId != nil {
-					if s.disassociateAddress(ip) != nil {
-						return false, err
-					}
+	err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		_, err := s.EC2Client.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: ip.AllocationId})
+		if err != nil {
+			if ip.AssociationId != nil {
+				if s.disassociateAddress(ip) != nil {
+					return false, err
 				}
-				return false, err
 			}
+			return false, err
+		}
+
+		return true, nil
+	}, awserrors.AuthFailure, awserrors.InUseIPAddress)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedReleaseEIP", "Failed to disassociate Elastic IP %q: %v", *ip.AllocationId, err)
+		return errors.Wrapf(err, "failed to release ElasticIP %q", *ip.AllocationId)
+	}
+
+	s.scope.Info("released ElasticIP", "eip", *ip.PublicIp, "allocation-id", *ip.AllocationId)
+	return nil
+}
+
+// ReconcileElasticIP makes sure that an Elastic IP is associated with the instance, claiming
+// or allocating one as described by eip if needed, and returns its public IP address.
+func (s *Service) ReconcileElasticIP(instanceID, machineName, role string, eip *infrav1.ElasticIP) (string, error) {
+	out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{filter.EC2.InstanceID(instanceID)},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe Elastic IPs of instance %q", instanceID)
+	}
+
+	if len(out.Addresses) > 0 {
+		return aws.StringValue(out.Addresses[0].PublicIp), nil
+	}
+
+	var allocationID string
+	if len(eip.Filters) > 0 {
+		allocationID, err = s.claimAddress(eip.Filters)
+	} else {
+		allocationID, err = s.getOrAllocateNamedAddress(machineName, role, eip.PublicIPv4Pool)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := s.EC2Client.AssociateAddress(&ec2.AssociateAddressInput{
+		AllocationId: aws.String(allocationID),
+		InstanceId:   aws.String(instanceID),
+	}); err != nil {
+		return "", errors.Wrapf(err, "failed to associate Elastic IP %q with instance %q", allocationID, instanceID)
+	}
+
+	out, err = s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		AllocationIds: aws.StringSlice([]string{allocationID}),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe Elastic IP %q", allocationID)
+	}
+	if len(out.Addresses) == 0 {
+		return "", errors.Errorf("no Elastic IP returned for allocation ID %q", allocationID)
+	}
 
-			return true, nil
-		}, awserrors.AuthFailure, awserrors.InUseIPAddress)
+	s.scope.Info("associated ElasticIP", "eip", aws.StringValue(out.Addresses[0].PublicIp), "allocation-id", allocationID, "instance-id", instanceID)
+	return aws.StringValue(out.Addresses[0].PublicIp), nil
+}
+
+// ReleaseElasticIP disassociates the Elastic IPs of the instance, and releases the ones that were
+// allocated for the machine. Claimed Elastic IPs are left allocated. The instance ID may be empty
+// if the instance no longer exists, in which case only the Elastic IPs of the machine are released.
+func (s *Service) ReleaseElasticIP(instanceID, machineName string) error {
+	if instanceID != "" {
+		out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+			Filters: []*ec2.Filter{filter.EC2.InstanceID(instanceID)},
+		})
 		if err != nil {
-			record.Warnf(s.scope.InfraCluster(), "FailedReleaseEIP", "Failed to disassociate Elastic IP %q: %v", *ip.AllocationId, err)
-			return errors.Wrapf(err, "failed to release ElasticIP %q", *ip.AllocationId)
+			return errors.Wrapf(err, "failed to describe Elastic IPs of instance %q", instanceID)
 		}
 
-		s.scope.Info("released ElasticIP", "eip", *ip.PublicIp, "allocation-id", *ip.AllocationId)
+		for i := range out.Addresses {
+			if out.Addresses[i].AssociationId != nil {
+				if err := s.disassociateAddress(out.Addresses[i]); err != nil {
+					return err
+				}
+			}
+		}
 	}
+
+	out, err := s.describeNamedAddresses(machineName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe Elastic IPs of machine %q", machineName)
+	}
+
+	for i := range out.Addresses {
+		if err := s.releaseAddress(out.Addresses[i]); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (s *Service) getEIPTagParams(allocationID, role string) infrav1.BuildParams {
-	name := fmt.Sprintf("%s-eip-%s", s.scope.Name(), role)
+func (s *Service) getOrAllocateNamedAddress(name, role string, publicIPv4Pool *string) (string, error) {
+	out, err := s.describeNamedAddresses(name)
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeAddresses", "Failed to query addresses for %q: %v", name, err)
+		return "", errors.Wrap(err, "failed to query addresses")
+	}
+
+	for _, address := range out.Addresses {
+		if address.AssociationId == nil {
+			return aws.StringValue(address.AllocationId), nil
+		}
+	}
+
+	return s.allocateNamedAddress(name, role, publicIPv4Pool)
+}
+
+func (s *Service) claimAddress(filters []infrav1.Filter) (string, error) {
+	x := make([]*ec2.Filter, 0, len(filters))
+	for _, f := range filters {
+		x = append(x, &ec2.Filter{Name: aws.String(f.Name), Values: aws.StringSlice(f.Values)})
+	}
+
+	out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: x,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to query addresses")
+	}
+
+	for _, address := range out.Addresses {
+		if address.AssociationId == nil {
+			return aws.StringValue(address.AllocationId), nil
+		}
+	}
+
+	return "", awserrors.NewFailedDependency(fmt.Sprintf("no unassociated Elastic IP available matching filters %q", filters))
+}
+
+func (s *Service) describeNamedAddresses(name string) (*ec2.DescribeAddressesOutput, error) {
+	return s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Cluster(s.scope.Name()),
+			filter.EC2.Name(name),
+		},
+	})
+}
 
+func (s *Service) getEIPTagParams(allocationID, name, role string) infrav1.BuildParams {
 	return infrav1.BuildParams{
 		ClusterName: s.scope.Name(),
 		ResourceID:  allocationID,
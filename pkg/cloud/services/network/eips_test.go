@@ -0,0 +1,316 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileElasticIP(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	instanceFilter := &ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{filter.EC2.InstanceID("i-1")},
+	}
+
+	testCases := []struct {
+		name       string
+		eip        *infrav1.ElasticIP
+		expect     func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectedIP string
+		expectErr  func(err error) bool
+	}{
+		{
+			name: "Elastic IP already associated with the instance",
+			eip:  &infrav1.ElasticIP{},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Eq(instanceFilter)).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{
+								AllocationId:  aws.String("eipalloc-1"),
+								AssociationId: aws.String("eipassoc-1"),
+								PublicIp:      aws.String("1.2.3.4"),
+							},
+						},
+					}, nil)
+			},
+			expectedIP: "1.2.3.4",
+		},
+		{
+			name: "Elastic IP allocated from a BYOIP pool",
+			eip: &infrav1.ElasticIP{
+				PublicIPv4Pool: aws.String("ipv4pool-ec2-1"),
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Eq(instanceFilter)).
+					Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+					Filters: []*ec2.Filter{
+						filter.EC2.Cluster("test-cluster"),
+						filter.EC2.Name("machine-1"),
+					},
+				})).
+					Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.AllocateAddress(gomock.Eq(&ec2.AllocateAddressInput{
+					Domain:         aws.String("vpc"),
+					PublicIpv4Pool: aws.String("ipv4pool-ec2-1"),
+				})).
+					Return(&ec2.AllocateAddressOutput{
+						AllocationId: aws.String("eipalloc-1"),
+					}, nil)
+				m.CreateTags(gomock.Any()).
+					Return(&ec2.CreateTagsOutput{}, nil)
+				m.AssociateAddress(gomock.Eq(&ec2.AssociateAddressInput{
+					AllocationId: aws.String("eipalloc-1"),
+					InstanceId:   aws.String("i-1"),
+				})).
+					Return(&ec2.AssociateAddressOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+					AllocationIds: aws.StringSlice([]string{"eipalloc-1"}),
+				})).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{
+								AllocationId: aws.String("eipalloc-1"),
+								PublicIp:     aws.String("1.2.3.4"),
+							},
+						},
+					}, nil)
+			},
+			expectedIP: "1.2.3.4",
+		},
+		{
+			name: "Elastic IP claimed from a tagged pool",
+			eip: &infrav1.ElasticIP{
+				Filters: []infrav1.Filter{
+					{
+						Name:   "tag:pool",
+						Values: []string{"partners"},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Eq(instanceFilter)).
+					Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("tag:pool"),
+							Values: aws.StringSlice([]string{"partners"}),
+						},
+					},
+				})).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{
+								AllocationId:  aws.String("eipalloc-1"),
+								AssociationId: aws.String("eipassoc-1"),
+								PublicIp:      aws.String("1.2.3.4"),
+							},
+							{
+								AllocationId: aws.String("eipalloc-2"),
+								PublicIp:     aws.String("5.6.7.8"),
+							},
+						},
+					}, nil)
+				m.AssociateAddress(gomock.Eq(&ec2.AssociateAddressInput{
+					AllocationId: aws.String("eipalloc-2"),
+					InstanceId:   aws.String("i-1"),
+				})).
+					Return(&ec2.AssociateAddressOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+					AllocationIds: aws.StringSlice([]string{"eipalloc-2"}),
+				})).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{
+								AllocationId: aws.String("eipalloc-2"),
+								PublicIp:     aws.String("5.6.7.8"),
+							},
+						},
+					}, nil)
+			},
+			expectedIP: "5.6.7.8",
+		},
+		{
+			name: "no unassociated Elastic IP left in the tagged pool",
+			eip: &infrav1.ElasticIP{
+				Filters: []infrav1.Filter{
+					{
+						Name:   "tag:pool",
+						Values: []string{"partners"},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Eq(instanceFilter)).
+					Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddresses(gomock.Any()).
+					Return(&ec2.DescribeAddressesOutput{}, nil)
+			},
+			expectErr: func(err error) bool {
+				return awserrors.IsFailedDependency(errors.Cause(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(newEIPTestClusterScope(t))
+			s.EC2Client = ec2Mock
+
+			ip, err := s.ReconcileElasticIP("i-1", "machine-1", "node", tc.eip)
+			if tc.expectErr != nil {
+				if !tc.expectErr(err) {
+					t.Fatalf("got an unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if ip != tc.expectedIP {
+				t.Fatalf("expected public IP %q, got %q", tc.expectedIP, ip)
+			}
+		})
+	}
+}
+
+func TestReleaseElasticIP(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	m := ec2Mock.EXPECT()
+
+	// The claimed Elastic IP is disassociated, the one allocated for the machine is released.
+	m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{filter.EC2.InstanceID("i-1")},
+	})).
+		Return(&ec2.DescribeAddressesOutput{
+			Addresses: []*ec2.Address{
+				{
+					AllocationId:  aws.String("eipalloc-claimed"),
+					AssociationId: aws.String("eipassoc-1"),
+					PublicIp:      aws.String("1.2.3.4"),
+				},
+			},
+		}, nil)
+	m.DisassociateAddress(gomock.Eq(&ec2.DisassociateAddressInput{
+		AssociationId: aws.String("eipassoc-1"),
+	})).
+		Return(&ec2.DisassociateAddressOutput{}, nil)
+	m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Cluster("test-cluster"),
+			filter.EC2.Name("machine-1"),
+		},
+	})).
+		Return(&ec2.DescribeAddressesOutput{
+			Addresses: []*ec2.Address{
+				{
+					AllocationId: aws.String("eipalloc-owned"),
+					PublicIp:     aws.String("5.6.7.8"),
+				},
+			},
+		}, nil)
+	m.ReleaseAddress(gomock.Eq(&ec2.ReleaseAddressInput{
+		AllocationId: aws.String("eipalloc-owned"),
+	})).
+		Return(&ec2.ReleaseAddressOutput{}, nil)
+
+	s := NewService(newEIPTestClusterScope(t))
+	s.EC2Client = ec2Mock
+
+	if err := s.ReleaseElasticIP("i-1", "machine-1"); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+}
+
+func TestReleaseElasticIPWithoutInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	m := ec2Mock.EXPECT()
+
+	// Only the Elastic IP allocated for the machine is looked up and released.
+	m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Cluster("test-cluster"),
+			filter.EC2.Name("machine-1"),
+		},
+	})).
+		Return(&ec2.DescribeAddressesOutput{
+			Addresses: []*ec2.Address{
+				{
+					AllocationId: aws.String("eipalloc-owned"),
+					PublicIp:     aws.String("5.6.7.8"),
+				},
+			},
+		}, nil)
+	m.ReleaseAddress(gomock.Eq(&ec2.ReleaseAddressInput{
+		AllocationId: aws.String("eipalloc-owned"),
+	})).
+		Return(&ec2.ReleaseAddressOutput{}, nil)
+
+	s := NewService(newEIPTestClusterScope(t))
+	s.EC2Client = ec2Mock
+
+	if err := s.ReleaseElasticIP("", "machine-1"); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+}
+
+func newEIPTestClusterScope(t *testing.T) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewFakeClientWithScheme(scheme)
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{},
+		Client:     client,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	return clusterScope
+}
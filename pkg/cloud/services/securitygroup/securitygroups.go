@@ -505,6 +505,19 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 
 	switch role {
 	case infrav1.SecurityGroupBastion:
+		// With session manager, the bastion security group is attached to the VPC endpoints the SSM agent of the
+		// instances connects to instead of a bastion host instance.
+		if s.scope.Bastion().Mode == infrav1.BastionModeSessionManager {
+			return infrav1.IngressRules{
+				{
+					Description: "Session Manager VPC endpoints",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    443,
+					ToPort:      443,
+					CidrBlocks:  []string{s.scope.VPC().CidrBlock},
+				},
+			}, nil
+		}
 		return infrav1.IngressRules{
 			{
 				Description: "SSH",
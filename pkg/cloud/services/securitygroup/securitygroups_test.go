@@ -22,15 +22,19 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestReconcileSecurityGroups(t *testing.T) {
@@ -385,3 +389,101 @@ func TestControlPlaneSecurityGroupNotOpenToAnyCIDR(t *testing.T) {
 		}
 	}
 }
+
+func TestSessionManagerBastionSecurityGroupOpenToVPCOnly(t *testing.T) {
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{
+						CidrBlock: "10.0.0.0/16",
+					},
+				},
+				Bastion: infrav1.Bastion{
+					Enabled:           true,
+					Mode:              infrav1.BastionModeSessionManager,
+					AllowedCIDRBlocks: []string{services.AnyIPv4CidrBlock},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	s := NewService(scope)
+	rules, err := s.getSecurityGroupIngressRules(infrav1.SecurityGroupBastion)
+	if err != nil {
+		t.Fatalf("Failed to lookup bastion security group ingress rules: %v", err)
+	}
+
+	if len(rules) != 1 {
+		t.Fatalf("Expected a single ingress rule, got %d", len(rules))
+	}
+	if rules[0].FromPort != 443 || rules[0].ToPort != 443 {
+		t.Fatalf("Expected an HTTPS ingress rule, got ports %d-%d", rules[0].FromPort, rules[0].ToPort)
+	}
+	if !sets.NewString(rules[0].CidrBlocks...).Equal(sets.NewString("10.0.0.0/16")) {
+		t.Fatalf("Expected the ingress rule to only allow the VPC CIDR block, got %v", rules[0].CidrBlocks)
+	}
+}
+
+func TestDeleteSecurityGroupsWithRetainedInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", ResourceVersion: "1"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{ID: "vpc-securitygroups"},
+			},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Network: infrav1.Network{
+				SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupNode: {ID: "sg-node", Name: "test-cluster-node"},
+				},
+			},
+		},
+	}
+	scheme := runtime.NewScheme()
+	if err := infrav1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to register the infrastructure types: %v", err)
+	}
+
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewFakeClientWithScheme(scheme, awsCluster),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: awsCluster,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	ec2Mock.EXPECT().DescribeSecurityGroups(gomock.Any()).
+		Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: []*ec2.SecurityGroup{{GroupId: aws.String("sg-node")}}}, nil).AnyTimes()
+	// An instance retained by its deletion policy keeps the node security group.
+	ec2Mock.EXPECT().DeleteSecurityGroup(gomock.Eq(&ec2.DeleteSecurityGroupInput{GroupId: aws.String("sg-node")})).
+		Return(nil, awserr.New("DependencyViolation", "resource sg-node has a dependent object", nil))
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	if err := s.DeleteSecurityGroups(); err == nil {
+		t.Fatal("Expected the deletion of a security group used by a retained instance to fail")
+	}
+
+	if !conditions.IsFalse(awsCluster, infrav1.ClusterSecurityGroupsReadyCondition) ||
+		conditions.GetReason(awsCluster, infrav1.ClusterSecurityGroupsReadyCondition) != "DeletingFailed" {
+		t.Fatalf("Expected the security groups to be marked as failing to delete, got %v",
+			conditions.Get(awsCluster, infrav1.ClusterSecurityGroupsReadyCondition))
+	}
+}